@@ -0,0 +1,100 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+// drift-report reads the cloudavenue resources of a Terraform state file
+// with the provider and reports the attributes changed outside of Terraform,
+// the resources deleted outside of Terraform and the unmanaged resources
+// created next to the managed ones.
+//
+// The provider is configured from the CLOUDAVENUE_* environment variables
+// or the profiles, like with an empty provider block.
+//
+//	terraform state pull | go run ./cmd/drift-report -state - -format json
+//
+// The exit code is 0 without drift, 2 if a drift is found and 1 on error.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"time"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/drift"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/metrics"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/standalone"
+)
+
+const (
+	metricsFlushTimeout = 1500 * time.Millisecond
+
+	exitDrift = 2
+)
+
+func main() {
+	var (
+		statePath string
+		format    string
+	)
+
+	flag.StringVar(&statePath, "state", "terraform.tfstate", "path of the state file, - to read it from the standard input")
+	flag.StringVar(&format, "format", "text", "format of the report: text or json")
+	flag.Parse()
+
+	report, err := run(context.Background(), statePath, format)
+
+	ctx, cancel := context.WithTimeout(context.Background(), metricsFlushTimeout)
+	_ = metrics.Flush(ctx)
+	cancel()
+
+	switch {
+	case err != nil:
+		log.Fatal(err)
+	case report.HasDrift():
+		os.Exit(exitDrift)
+	case len(report.Errors) > 0:
+		os.Exit(1)
+	}
+}
+
+func run(ctx context.Context, statePath, format string) (*drift.Report, error) {
+	if format != "text" && format != "json" {
+		return nil, fmt.Errorf("unknown format %q, expected text or json", format)
+	}
+
+	var r io.Reader = os.Stdin
+	if statePath != "-" {
+		f, err := os.Open(statePath)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+		r = f
+	}
+
+	resources, err := drift.ReadStateFile(r)
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := standalone.New(ctx, provider.New("drift-report")())
+	if err != nil {
+		return nil, err
+	}
+
+	report := drift.Check(ctx, p, resources)
+	if format == "json" {
+		return report, report.WriteJSON(os.Stdout)
+	}
+	return report, report.WriteText(os.Stdout)
+}
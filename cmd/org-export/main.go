@@ -0,0 +1,127 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+// org-export writes the Terraform configuration of the resources of an
+// organization, with the import blocks bringing them under management.
+//
+// The provider is configured from the CLOUDAVENUE_* environment variables
+// or the profiles, like with an empty provider block.
+//
+//	go run ./cmd/org-export -output ./imported
+//	terraform -chdir=imported plan
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/metrics"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/orgexport"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/standalone"
+)
+
+const metricsFlushTimeout = 1500 * time.Millisecond
+
+func main() {
+	var (
+		output string
+		types  string
+		force  bool
+	)
+
+	flag.StringVar(&output, "output", "export", "directory of the generated files")
+	flag.StringVar(&types, "types", "", "comma separated resource types to export (default: "+strings.Join(orgexport.Types(), ", ")+")")
+	flag.BoolVar(&force, "force", false, "overwrite the existing files of the output directory")
+	flag.Parse()
+
+	err := run(context.Background(), output, types, force)
+
+	ctx, cancel := context.WithTimeout(context.Background(), metricsFlushTimeout)
+	_ = metrics.Flush(ctx)
+	cancel()
+
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+func run(ctx context.Context, output, types string, force bool) error {
+	var selected []string
+	if types != "" {
+		selected = strings.Split(types, ",")
+		for i := range selected {
+			selected[i] = strings.TrimSpace(selected[i])
+		}
+	}
+
+	p, err := standalone.New(ctx, provider.New("org-export")())
+	if err != nil {
+		return err
+	}
+
+	resources, exportErr := orgexport.Export(ctx, p, selected...)
+	if len(resources) == 0 && exportErr != nil {
+		return exportErr
+	}
+
+	files, err := orgexport.Render(resources)
+	if err != nil {
+		return err
+	}
+
+	if err := writeFiles(output, files, force); err != nil {
+		return err
+	}
+
+	log.Printf("%d resources of the organization %s exported to %s", len(resources), p.OrgName(), output)
+	if exportErr != nil {
+		return fmt.Errorf("some resources have not been exported:\n%w", exportErr)
+	}
+	return nil
+}
+
+// writeFiles writes the files in the output directory. The existing files
+// are only overwritten if force is set.
+func writeFiles(output string, files map[string][]byte, force bool) error {
+	if err := os.MkdirAll(output, 0o750); err != nil {
+		return err
+	}
+
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	if !force {
+		for _, name := range names {
+			if _, err := os.Stat(filepath.Join(output, name)); err == nil {
+				return fmt.Errorf("the file %s already exists, use -force to overwrite it", filepath.Join(output, name))
+			} else if !errors.Is(err, os.ErrNotExist) {
+				return err
+			}
+		}
+	}
+
+	for _, name := range names {
+		if err := os.WriteFile(filepath.Join(output, name), files[name], 0o600); err != nil { //nolint:gosec // G703: the output directory is chosen by the user
+			return err
+		}
+	}
+	return nil
+}
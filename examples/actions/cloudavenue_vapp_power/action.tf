@@ -0,0 +1,10 @@
+action "cloudavenue_vapp_power" "shutdown" {
+  config {
+    vdc       = "my-vdc"
+    name      = "my-vapp"
+    operation = "shutdown_guest"
+  }
+}
+
+# Run the action manually with:
+# terraform apply -invoke=action.cloudavenue_vapp_power.shutdown
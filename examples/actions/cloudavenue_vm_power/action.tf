@@ -0,0 +1,20 @@
+action "cloudavenue_vm_power" "reboot" {
+  config {
+    vdc       = "my-vdc"
+    vapp_name = "my-vapp"
+    name      = "my-vm"
+    operation = "reboot"
+  }
+}
+
+# Reboot the VM each time its guest properties are updated.
+resource "cloudavenue_vm" "example" {
+  # ...
+
+  lifecycle {
+    action_trigger {
+      events  = [after_update]
+      actions = [action.cloudavenue_vm_power.reboot]
+    }
+  }
+}
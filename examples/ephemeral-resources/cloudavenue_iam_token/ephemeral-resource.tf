@@ -0,0 +1,10 @@
+ephemeral "cloudavenue_iam_token" "example" {
+  name = "example"
+}
+
+provider "vcd" {
+  org       = var.org
+  url       = var.url
+  auth_type = "api_token"
+  api_token = ephemeral.cloudavenue_iam_token.example.token
+}
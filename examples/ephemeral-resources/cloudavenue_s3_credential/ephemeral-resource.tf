@@ -0,0 +1,15 @@
+ephemeral "cloudavenue_s3_credential" "example" {}
+
+provider "aws" {
+  access_key = ephemeral.cloudavenue_s3_credential.example.access_key
+  secret_key = ephemeral.cloudavenue_s3_credential.example.secret_key
+  region     = "region01"
+
+  skip_credentials_validation = true
+  skip_region_validation      = true
+  skip_requesting_account_id  = true
+
+  endpoints {
+    s3 = "https://s3-region01.cloudavenue.orange-business.com"
+  }
+}
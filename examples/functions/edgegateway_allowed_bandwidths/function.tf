@@ -0,0 +1,17 @@
+data "cloudavenue_tier0_vrfs" "example" {}
+
+data "cloudavenue_tier0_vrf" "example" {
+  name = data.cloudavenue_tier0_vrfs.example.names.0
+}
+
+resource "cloudavenue_edgegateway" "example" {
+  owner_name = cloudavenue_vdc.example.name
+  bandwidth  = var.bandwidth
+
+  lifecycle {
+    precondition {
+      condition     = contains(provider::cloudavenue::edgegateway_allowed_bandwidths(data.cloudavenue_tier0_vrf.example.class_service), var.bandwidth)
+      error_message = "The bandwidth is not allowed for this Tier-0 VRF class service."
+    }
+  }
+}
@@ -0,0 +1,4 @@
+output "max_bandwidth" {
+  # 300
+  value = provider::cloudavenue::edgegateway_max_bandwidth("VRF_STANDARD")
+}
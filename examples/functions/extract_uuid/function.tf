@@ -0,0 +1,4 @@
+output "vm_uuid" {
+  # f5b44e4f-bbca-4c76-9a4c-1d8a9f5d2e11
+  value = provider::cloudavenue::extract_uuid("urn:vcloud:vm:f5b44e4f-bbca-4c76-9a4c-1d8a9f5d2e11")
+}
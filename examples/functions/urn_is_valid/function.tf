@@ -0,0 +1,8 @@
+variable "edge_gateway_id" {
+  type = string
+
+  validation {
+    condition     = provider::cloudavenue::urn_is_valid(var.edge_gateway_id, "gateway")
+    error_message = "The edge_gateway_id must be an edge gateway URN (urn:vcloud:gateway:<uuid>)."
+  }
+}
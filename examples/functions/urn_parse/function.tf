@@ -0,0 +1,4 @@
+output "vm" {
+  # { type = "vm", uuid = "f5b44e4f-bbca-4c76-9a4c-1d8a9f5d2e11" }
+  value = provider::cloudavenue::urn_parse(cloudavenue_vm.example.id)
+}
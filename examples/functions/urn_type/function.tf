@@ -0,0 +1,4 @@
+output "type" {
+  # gateway
+  value = provider::cloudavenue::urn_type(cloudavenue_edgegateway.example.id)
+}
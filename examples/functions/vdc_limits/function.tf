@@ -0,0 +1,26 @@
+locals {
+  limits = provider::cloudavenue::vdc_limits("STD", "PAYG")
+}
+
+resource "cloudavenue_vdc" "example" {
+  name                  = "MyVDC"
+  service_class         = "STD"
+  disponibility_class   = local.limits.disponibility_classes[0]
+  billing_model         = "PAYG"
+  cpu_speed_in_mhz      = local.limits.cpu_speed_in_mhz.max
+  cpu_allocated         = max(local.limits.cpu_allocated.min, var.cpu_allocated)
+  memory_allocated      = var.memory_allocated
+  storage_billing_model = "PAYG"
+  storage_profiles = [{
+    class   = "gold"
+    limit   = 500
+    default = true
+  }]
+
+  lifecycle {
+    precondition {
+      condition     = var.memory_allocated >= local.limits.memory_allocated.min && var.memory_allocated <= local.limits.memory_allocated.max
+      error_message = "memory_allocated must be between ${local.limits.memory_allocated.min} and ${local.limits.memory_allocated.max} GB."
+    }
+  }
+}
@@ -0,0 +1,35 @@
+locals {
+  vdc = {
+    service_class         = "STD"
+    disponibility_class   = "ONE-ROOM"
+    billing_model         = "PAYG"
+    cpu_speed_in_mhz      = 2200
+    cpu_allocated         = 22000
+    memory_allocated      = 30
+    storage_billing_model = "PAYG"
+    storage_profiles = [{
+      class   = "gold"
+      limit   = 500
+      default = true
+    }]
+  }
+}
+
+resource "cloudavenue_vdc" "example" {
+  name                  = "MyVDC"
+  service_class         = local.vdc.service_class
+  disponibility_class   = local.vdc.disponibility_class
+  billing_model         = local.vdc.billing_model
+  cpu_speed_in_mhz      = local.vdc.cpu_speed_in_mhz
+  cpu_allocated         = local.vdc.cpu_allocated
+  memory_allocated      = local.vdc.memory_allocated
+  storage_billing_model = local.vdc.storage_billing_model
+  storage_profiles      = local.vdc.storage_profiles
+
+  lifecycle {
+    precondition {
+      condition     = provider::cloudavenue::vdc_validate(local.vdc) == ""
+      error_message = provider::cloudavenue::vdc_validate(local.vdc)
+    }
+  }
+}
@@ -0,0 +1,3 @@
+list "cloudavenue_catalog" "all" {
+  provider = cloudavenue
+}
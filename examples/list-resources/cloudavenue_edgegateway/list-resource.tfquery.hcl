@@ -0,0 +1,3 @@
+list "cloudavenue_edgegateway" "all" {
+  provider = cloudavenue
+}
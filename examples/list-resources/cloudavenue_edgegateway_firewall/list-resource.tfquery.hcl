@@ -0,0 +1,3 @@
+list "cloudavenue_edgegateway_firewall" "all" {
+  provider = cloudavenue
+}
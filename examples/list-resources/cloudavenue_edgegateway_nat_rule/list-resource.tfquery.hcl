@@ -0,0 +1,7 @@
+list "cloudavenue_edgegateway_nat_rule" "example" {
+  provider = cloudavenue
+
+  config {
+    edge_gateway_name = "my-edge-gateway"
+  }
+}
@@ -0,0 +1,7 @@
+list "cloudavenue_network_routed" "example" {
+  provider = cloudavenue
+
+  config {
+    edge_gateway_name = "my-edge-gateway"
+  }
+}
@@ -0,0 +1,3 @@
+list "cloudavenue_s3_bucket" "all" {
+  provider = cloudavenue
+}
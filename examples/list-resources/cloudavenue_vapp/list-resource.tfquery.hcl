@@ -0,0 +1,7 @@
+list "cloudavenue_vapp" "example" {
+  provider = cloudavenue
+
+  config {
+    vdc = "my-vdc"
+  }
+}
@@ -0,0 +1,3 @@
+list "cloudavenue_vdc" "all" {
+  provider = cloudavenue
+}
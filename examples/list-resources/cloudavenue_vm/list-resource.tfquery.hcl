@@ -0,0 +1,8 @@
+list "cloudavenue_vm" "example" {
+  provider = cloudavenue
+
+  config {
+    vdc       = "my-vdc"
+    vapp_name = "my-vapp"
+  }
+}
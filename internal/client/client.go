@@ -20,9 +20,13 @@
 package client
 
 import (
+	"crypto/tls"
+	"sync"
+
 	"github.com/vmware/go-vcloud-director/v2/govcd"
 
 	clientca "github.com/orange-cloudavenue/cloudavenue-sdk-go"
+	v1 "github.com/orange-cloudavenue/cloudavenue-sdk-go/v1"
 )
 
 // CloudAvenue is the main struct for the CloudAvenue client.
@@ -36,6 +40,39 @@ type CloudAvenue struct {
 	// SDK CLOUDAVENUE
 	CAVSDK     *clientca.Client
 	CAVSDKOpts *clientca.ClientOpts
+
+	// RetryPolicy defines how the transient API errors are retried.
+	// DefaultRetryPolicy is used if nil.
+	RetryPolicy *RetryPolicy
+
+	// ThrottlePolicy limits the calls made to the API. No limit is applied
+	// if nil.
+	ThrottlePolicy *ThrottlePolicy
+
+	// HTTPLogger logs the HTTP exchanges with the API. Nothing is logged if
+	// nil or if its subsystem does not log at the TRACE level.
+	HTTPLogger *HTTPLogger
+
+	// S3Endpoint overrides the endpoint of the S3 API, which is then
+	// addressed in path style. The endpoint of the SDK is used if empty.
+	S3Endpoint string
+
+	// TLSConfig is the TLS configuration of the HTTP clients of the SDK,
+	// e.g. to trust a private CA. The system configuration is used if nil.
+	TLSConfig *tls.Config
+
+	// ReadOnly rejects the changes of the resources and the actions. The
+	// resources and the data sources can still be read.
+	ReadOnly bool
+
+	// hooksMu serializes the installation of the transport chain on the
+	// HTTP clients of the SDK.
+	hooksMu sync.Mutex
+
+	// throttle enforces the ThrottlePolicy. It is shared by the HTTP clients
+	// of the SDK.
+	throttle     *throttle
+	throttleOnce sync.Once
 }
 
 // New creates a new CloudAvenue client.
@@ -57,9 +94,24 @@ func (c *CloudAvenue) New() (*CloudAvenue, error) {
 		return nil, err
 	}
 
+	// The VMware client is shared with the SDK, retrying and rate limiting
+	// at the transport level covers the calls made by both.
+	c.Vmware.Client.Http.Transport = c.transport(c.Vmware.Client.Http.Transport)
+
 	return c, nil
 }
 
+// S3 returns the S3 client of the SDK. The S3 API is not called through the
+// VMware client, the transport chain of the provider and the S3Endpoint are
+// installed on the S3 client before it is returned.
+func (c *CloudAvenue) S3() v1.S3Client {
+	s3Client := c.CAVSDK.V1.S3()
+	if s3Client.S3 != nil {
+		c.hookS3(s3Client.S3)
+	}
+	return s3Client
+}
+
 // DefaultVDCExist returns true if the default VDC exists.
 func (c *CloudAvenue) DefaultVDCExist() bool {
 	return c.CAVSDKOpts.CloudAvenue.VDC != ""
@@ -81,6 +133,11 @@ func (c *CloudAvenue) GetURL() string {
 	return v
 }
 
+// IsReadOnly reports whether the provider is read-only.
+func (c *CloudAvenue) IsReadOnly() bool {
+	return c.ReadOnly
+}
+
 // GetOrgName returns the name of the organization.
 func (c *CloudAvenue) GetOrgName() string {
 	// Error is not returned for maintein compatibility with the previous version
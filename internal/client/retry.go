@@ -0,0 +1,290 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	cerrs "github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/errors"
+)
+
+// RetryPolicy defines how transient API errors are retried.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts, including the first one.
+	MaxAttempts int
+	// MinBackoff is the wait time before the first retry.
+	MinBackoff time.Duration
+	// MaxBackoff is the maximum wait time between two attempts.
+	MaxBackoff time.Duration
+	// RetryableStatusCodes is the list of HTTP status codes that are retried.
+	RetryableStatusCodes []int
+	// RetryOnBusyEntity retries the requests rejected because the targeted
+	// entity is busy completing another operation.
+	RetryOnBusyEntity bool
+}
+
+// DefaultRetryPolicy returns the retry policy used when none is configured.
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts: 5,
+		MinBackoff:  1 * time.Second,
+		MaxBackoff:  30 * time.Second,
+		RetryableStatusCodes: []int{
+			http.StatusTooManyRequests,
+			http.StatusInternalServerError,
+			http.StatusBadGateway,
+			http.StatusServiceUnavailable,
+			http.StatusGatewayTimeout,
+		},
+		RetryOnBusyEntity: true,
+	}
+}
+
+// backoff returns the wait time before the given retry (starting at 1).
+// The wait time grows exponentially and a jitter is applied to avoid
+// concurrent calls retrying at the same time.
+func (p *RetryPolicy) backoff(retry int) time.Duration {
+	d := p.MinBackoff
+	for i := 1; i < retry && d < p.MaxBackoff; i++ {
+		d *= 2
+	}
+	d = min(d, p.MaxBackoff)
+	if d <= 0 {
+		return 0
+	}
+
+	half := d / 2
+	return half + rand.N(d-half+1) //nolint:gosec // jitter does not need a secure random source
+}
+
+// isRetryableStatus reports whether a response with the given status code
+// must be retried for the given method. Only 429 and 503 guarantee that the
+// request has not been processed, other codes are retried for idempotent
+// methods only.
+func (p *RetryPolicy) isRetryableStatus(method string, statusCode int) bool {
+	if !slices.Contains(p.RetryableStatusCodes, statusCode) {
+		return false
+	}
+
+	switch statusCode {
+	case http.StatusTooManyRequests, http.StatusServiceUnavailable:
+		return true
+	}
+
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// retryTransport is an http.RoundTripper retrying the transient API errors
+// according to a RetryPolicy.
+type retryTransport struct {
+	next   http.RoundTripper
+	policy *RetryPolicy
+}
+
+func newRetryTransport(next http.RoundTripper, policy *RetryPolicy) http.RoundTripper {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	return &retryTransport{
+		next:   next,
+		policy: policy,
+	}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+
+	// The caller's request must not be modified, the retries are sent with
+	// a clone holding a fresh body.
+	attemptReq := req
+	for attempt := 1; ; attempt++ {
+		resp, err := t.next.RoundTrip(attemptReq)
+		if err != nil || attempt >= t.policy.MaxAttempts {
+			return resp, err
+		}
+
+		reason, retryable := t.shouldRetry(req, resp)
+		if !retryable {
+			return resp, nil
+		}
+
+		// The body of the request must be sent again.
+		next := req.Clone(ctx)
+		if req.Body != nil && req.Body != http.NoBody {
+			if req.GetBody == nil {
+				return resp, nil
+			}
+			body, errBody := req.GetBody()
+			if errBody != nil {
+				return resp, nil //nolint:nilerr // the original response is returned to the caller
+			}
+			next.Body = body
+		}
+
+		wait := t.policy.backoff(attempt)
+		if ra := retryAfter(resp); ra > wait {
+			wait = min(ra, t.policy.MaxBackoff)
+		}
+
+		tflog.Debug(ctx, "Retrying Cloud Avenue API request", map[string]any{
+			"method":      req.Method,
+			"url":         req.URL.Redacted(),
+			"status_code": resp.StatusCode,
+			"reason":      reason,
+			"attempt":     attempt,
+			"wait":        wait.String(),
+		})
+
+		// Drain and close the body to reuse the connection.
+		_, _ = io.Copy(io.Discard, resp.Body)
+		resp.Body.Close()
+
+		if err := sleep(ctx, wait); err != nil {
+			return nil, err
+		}
+		attemptReq = next
+	}
+}
+
+// shouldRetry reports whether the response must be retried and the reason.
+func (t *retryTransport) shouldRetry(req *http.Request, resp *http.Response) (string, bool) {
+	if resp.StatusCode < http.StatusBadRequest {
+		return "", false
+	}
+
+	if t.policy.isRetryableStatus(req.Method, resp.StatusCode) {
+		return "status code " + strconv.Itoa(resp.StatusCode), true
+	}
+
+	if !t.policy.RetryOnBusyEntity || resp.Body == nil {
+		return "", false
+	}
+
+	// Error bodies are small, read it entirely and restore it for the caller.
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	if err != nil {
+		return "", false
+	}
+
+	if cerrs.IsEntityBusy(errors.New(string(body))) {
+		return "busy entity", true
+	}
+	return "", false
+}
+
+// retryAfter returns the wait time requested by the Retry-After header.
+func retryAfter(resp *http.Response) time.Duration {
+	v := resp.Header.Get("Retry-After")
+	if v == "" {
+		return 0
+	}
+
+	if seconds, err := strconv.Atoi(v); err == nil {
+		return time.Duration(seconds) * time.Second
+	}
+
+	if date, err := http.ParseTime(v); err == nil {
+		return time.Until(date)
+	}
+	return 0
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// retryableError marks an error as transient.
+type retryableError struct {
+	err error
+}
+
+func (e *retryableError) Error() string { return e.err.Error() }
+func (e *retryableError) Unwrap() error { return e.err }
+
+// RetryableError marks the error as transient, Retry calls the function
+// again when it returns such an error.
+func RetryableError(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &retryableError{err: err}
+}
+
+// IsRetryableError reports whether the error is transient. An error is
+// transient when it has been marked with RetryableError or when the API
+// reported that the entity is busy, as classified by cerrs.IsEntityBusy.
+func IsRetryableError(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	var rErr *retryableError
+	if errors.As(err, &rErr) {
+		return true
+	}
+	return cerrs.IsEntityBusy(err)
+}
+
+// Retry calls fn until it succeeds, returns a non transient error or the
+// retry policy of the client is exhausted. It is used to retry the calls
+// that are not made through the HTTP client of the provider.
+func (c *CloudAvenue) Retry(ctx context.Context, fn func() error) error {
+	policy := c.retryPolicy()
+
+	for attempt := 1; ; attempt++ {
+		err := fn()
+		if err == nil || attempt >= policy.MaxAttempts || !IsRetryableError(err) {
+			return err
+		}
+
+		wait := policy.backoff(attempt)
+		tflog.Debug(ctx, "Retrying Cloud Avenue operation", map[string]any{
+			"error":   err.Error(),
+			"attempt": attempt,
+			"wait":    wait.String(),
+		})
+
+		if errSleep := sleep(ctx, wait); errSleep != nil {
+			return err
+		}
+	}
+}
+
+func (c *CloudAvenue) retryPolicy() *RetryPolicy {
+	if c.RetryPolicy == nil {
+		return DefaultRetryPolicy()
+	}
+	return c.RetryPolicy
+}
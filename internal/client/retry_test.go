@@ -0,0 +1,223 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package client
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func testRetryPolicy() *RetryPolicy {
+	p := DefaultRetryPolicy()
+	p.MinBackoff = time.Millisecond
+	p.MaxBackoff = 5 * time.Millisecond
+	return p
+}
+
+func TestRetryTransport(t *testing.T) {
+	tests := []struct {
+		name         string
+		method       string
+		body         string
+		failures     int
+		status       int
+		errorBody    string
+		policy       func(*RetryPolicy)
+		wantStatus   int
+		wantAttempts int32
+	}{
+		{
+			name:         "Retry 503 on POST",
+			method:       http.MethodPost,
+			body:         `{"name":"test"}`,
+			failures:     2,
+			status:       http.StatusServiceUnavailable,
+			wantStatus:   http.StatusOK,
+			wantAttempts: 3,
+		},
+		{
+			name:         "Retry 502 on GET",
+			method:       http.MethodGet,
+			failures:     1,
+			status:       http.StatusBadGateway,
+			wantStatus:   http.StatusOK,
+			wantAttempts: 2,
+		},
+		{
+			name:         "Do not retry 502 on POST",
+			method:       http.MethodPost,
+			body:         `{"name":"test"}`,
+			failures:     1,
+			status:       http.StatusBadGateway,
+			wantStatus:   http.StatusBadGateway,
+			wantAttempts: 1,
+		},
+		{
+			name:         "Retry busy entity",
+			method:       http.MethodPost,
+			body:         `{"name":"test"}`,
+			failures:     1,
+			status:       http.StatusBadRequest,
+			errorBody:    `{"minorErrorCode":"BUSY_ENTITY","message":"The entity is busy"}`,
+			wantStatus:   http.StatusOK,
+			wantAttempts: 2,
+		},
+		{
+			name:         "Do not retry busy entity when disabled",
+			method:       http.MethodPut,
+			body:         `{"name":"test"}`,
+			failures:     1,
+			status:       http.StatusBadRequest,
+			errorBody:    `{"minorErrorCode":"BUSY_ENTITY","message":"The entity is busy"}`,
+			policy:       func(p *RetryPolicy) { p.RetryOnBusyEntity = false },
+			wantStatus:   http.StatusBadRequest,
+			wantAttempts: 1,
+		},
+		{
+			name:         "Do not retry client error",
+			method:       http.MethodGet,
+			failures:     1,
+			status:       http.StatusNotFound,
+			wantStatus:   http.StatusNotFound,
+			wantAttempts: 1,
+		},
+		{
+			name:         "Stop after max attempts",
+			method:       http.MethodGet,
+			failures:     10,
+			status:       http.StatusTooManyRequests,
+			wantStatus:   http.StatusTooManyRequests,
+			wantAttempts: 5,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var attempts atomic.Int32
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				n := attempts.Add(1)
+
+				body, _ := io.ReadAll(r.Body)
+				if string(body) != tt.body {
+					t.Errorf("attempt %d: body = %q, want %q", n, body, tt.body)
+				}
+
+				if int(n) <= tt.failures {
+					w.WriteHeader(tt.status)
+					_, _ = w.Write([]byte(tt.errorBody))
+					return
+				}
+				w.WriteHeader(http.StatusOK)
+			}))
+			defer server.Close()
+
+			policy := testRetryPolicy()
+			if tt.policy != nil {
+				tt.policy(policy)
+			}
+
+			req, err := http.NewRequestWithContext(t.Context(), tt.method, server.URL, strings.NewReader(tt.body))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			body := req.Body
+			resp, err := newRetryTransport(nil, policy).RoundTrip(req)
+			if err != nil {
+				t.Fatalf("RoundTrip() error = %v", err)
+			}
+			defer resp.Body.Close()
+
+			// The request of the caller must not be modified.
+			if req.Body != body {
+				t.Error("RoundTrip() replaced the body of the request")
+			}
+
+			if resp.StatusCode != tt.wantStatus {
+				t.Errorf("status = %d, want %d", resp.StatusCode, tt.wantStatus)
+			}
+			if got := attempts.Load(); got != tt.wantAttempts {
+				t.Errorf("attempts = %d, want %d", got, tt.wantAttempts)
+			}
+
+			// The error body must still be readable by the caller.
+			if tt.wantStatus != http.StatusOK {
+				body, _ := io.ReadAll(resp.Body)
+				if string(body) != tt.errorBody {
+					t.Errorf("response body = %q, want %q", body, tt.errorBody)
+				}
+			}
+		})
+	}
+}
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	p := &RetryPolicy{
+		MinBackoff: time.Second,
+		MaxBackoff: 10 * time.Second,
+	}
+
+	for retry := 1; retry <= 10; retry++ {
+		d := p.backoff(retry)
+		if d < p.MinBackoff/2 || d > p.MaxBackoff {
+			t.Errorf("backoff(%d) = %s, out of bounds", retry, d)
+		}
+	}
+}
+
+func TestCloudAvenueRetry(t *testing.T) {
+	errTransient := errors.New("transient")
+	errFatal := errors.New("fatal")
+
+	c := &CloudAvenue{RetryPolicy: testRetryPolicy()}
+
+	t.Run("Retry transient error", func(t *testing.T) {
+		calls := 0
+		err := c.Retry(t.Context(), func() error {
+			calls++
+			if calls < 3 {
+				return RetryableError(errTransient)
+			}
+			return nil
+		})
+		if err != nil || calls != 3 {
+			t.Errorf("Retry() error = %v, calls = %d", err, calls)
+		}
+	})
+
+	t.Run("Do not retry fatal error", func(t *testing.T) {
+		calls := 0
+		err := c.Retry(t.Context(), func() error {
+			calls++
+			return errFatal
+		})
+		if !errors.Is(err, errFatal) || calls != 1 {
+			t.Errorf("Retry() error = %v, calls = %d", err, calls)
+		}
+	})
+
+	t.Run("Stop after max attempts", func(t *testing.T) {
+		calls := 0
+		err := c.Retry(t.Context(), func() error {
+			calls++
+			return RetryableError(errTransient)
+		})
+		if !errors.Is(err, errTransient) || calls != c.RetryPolicy.MaxAttempts {
+			t.Errorf("Retry() error = %v, calls = %d", err, calls)
+		}
+	})
+}
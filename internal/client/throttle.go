@@ -0,0 +1,167 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package client
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// ThrottlePolicy limits the calls made to the Cloud Avenue API.
+// A zero value disables the corresponding limit.
+type ThrottlePolicy struct {
+	// MaxConcurrentRequests is the maximum number of requests in flight.
+	MaxConcurrentRequests int
+	// RequestsPerSecond is the maximum number of requests started per second.
+	RequestsPerSecond float64
+}
+
+// enabled reports whether at least one limit is set.
+func (p *ThrottlePolicy) enabled() bool {
+	return p != nil && (p.MaxConcurrentRequests > 0 || p.RequestsPerSecond > 0)
+}
+
+// throttle enforces a ThrottlePolicy. It is shared by all the requests of
+// the provider.
+type throttle struct {
+	slots chan struct{}
+
+	mu       sync.Mutex
+	interval time.Duration
+	next     time.Time
+}
+
+func newThrottle(policy *ThrottlePolicy) *throttle {
+	t := &throttle{}
+	if policy.MaxConcurrentRequests > 0 {
+		t.slots = make(chan struct{}, policy.MaxConcurrentRequests)
+	}
+	if policy.RequestsPerSecond > 0 {
+		t.interval = time.Duration(float64(time.Second) / policy.RequestsPerSecond)
+	}
+	return t
+}
+
+// acquire waits until the request is allowed to start and returns the
+// function releasing its concurrency slot.
+func (t *throttle) acquire(ctx context.Context) (release func(), err error) {
+	release = func() {}
+
+	if t.slots != nil {
+		select {
+		case t.slots <- struct{}{}:
+			release = func() { <-t.slots }
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	if t.interval > 0 {
+		if err := sleep(ctx, t.reserve()); err != nil {
+			release()
+			return nil, err
+		}
+	}
+
+	return release, nil
+}
+
+// reserve books the next request start time and returns the wait time
+// before it.
+func (t *throttle) reserve() time.Duration {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	now := time.Now()
+	if t.next.Before(now) {
+		t.next = now
+	}
+	wait := t.next.Sub(now)
+	t.next = t.next.Add(t.interval)
+
+	return wait
+}
+
+// throttleTransport is an http.RoundTripper queuing the requests according
+// to a ThrottlePolicy.
+type throttleTransport struct {
+	next     http.RoundTripper
+	throttle *throttle
+}
+
+// newThrottleTransport returns a transport queuing the requests with the
+// given throttle. The throttle is shared by the transports of all the HTTP
+// clients of the provider, so the limits apply to all the APIs together.
+func newThrottleTransport(next http.RoundTripper, th *throttle) http.RoundTripper {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	return &throttleTransport{
+		next:     next,
+		throttle: th,
+	}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *throttleTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	start := time.Now()
+
+	release, err := t.throttle.acquire(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if wait := time.Since(start); wait > time.Millisecond {
+		tflog.Debug(ctx, "Cloud Avenue API request queued by the client-side rate limiter", map[string]any{
+			"method": req.Method,
+			"url":    req.URL.Redacted(),
+			"wait":   wait.String(),
+		})
+	}
+
+	resp, err := t.next.RoundTrip(req)
+	if err != nil || resp.Body == nil {
+		release()
+		return resp, err
+	}
+
+	// The slot is held until the response body has been consumed. A caller
+	// which reads the body entirely without closing it does not leak the slot.
+	resp.Body = &releaseOnClose{ReadCloser: resp.Body, release: release}
+	return resp, nil
+}
+
+// releaseOnClose releases a concurrency slot when the body is closed, or
+// when reading it reaches EOF or fails.
+type releaseOnClose struct {
+	io.ReadCloser
+	once    sync.Once
+	release func()
+}
+
+func (b *releaseOnClose) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	if err != nil {
+		b.once.Do(b.release)
+	}
+	return n, err
+}
+
+func (b *releaseOnClose) Close() error {
+	err := b.ReadCloser.Close()
+	b.once.Do(b.release)
+	return err
+}
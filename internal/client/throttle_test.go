@@ -0,0 +1,138 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package client
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestThrottleTransportMaxConcurrentRequests(t *testing.T) {
+	var inFlight, maxInFlight atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		n := inFlight.Add(1)
+		defer inFlight.Add(-1)
+
+		for {
+			m := maxInFlight.Load()
+			if n <= m || maxInFlight.CompareAndSwap(m, n) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	transport := newThrottleTransport(nil, newThrottle(&ThrottlePolicy{MaxConcurrentRequests: 2}))
+
+	var wg sync.WaitGroup
+	for range 8 {
+		wg.Go(func() {
+			req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, server.URL, nil)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			resp, err := transport.RoundTrip(req)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			_, _ = io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		})
+	}
+	wg.Wait()
+
+	if got := maxInFlight.Load(); got > 2 {
+		t.Errorf("max in flight requests = %d, want <= 2", got)
+	}
+}
+
+func TestThrottleRequestsPerSecond(t *testing.T) {
+	th := newThrottle(&ThrottlePolicy{RequestsPerSecond: 100})
+
+	start := time.Now()
+	for range 5 {
+		release, err := th.acquire(t.Context())
+		if err != nil {
+			t.Fatal(err)
+		}
+		release()
+	}
+
+	// 5 requests at 100 req/s: the last one starts after 40ms.
+	if elapsed := time.Since(start); elapsed < 40*time.Millisecond {
+		t.Errorf("elapsed = %s, want >= 40ms", elapsed)
+	}
+}
+
+func TestThrottleAcquireCanceled(t *testing.T) {
+	th := newThrottle(&ThrottlePolicy{MaxConcurrentRequests: 1})
+
+	release, err := th.acquire(t.Context())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer release()
+
+	ctx, cancel := context.WithTimeout(t.Context(), 10*time.Millisecond)
+	defer cancel()
+
+	if _, err := th.acquire(ctx); err == nil {
+		t.Error("acquire() expected an error when the context is canceled")
+	}
+}
+
+func TestThrottleTransportReleaseOnEOF(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = io.WriteString(w, "ok")
+	}))
+	defer server.Close()
+
+	th := newThrottle(&ThrottlePolicy{MaxConcurrentRequests: 1})
+	transport := newThrottleTransport(nil, th)
+
+	for range 2 {
+		ctx, cancel := context.WithTimeout(t.Context(), time.Second)
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp, err := transport.RoundTrip(req)
+		cancel()
+		if err != nil {
+			t.Fatalf("RoundTrip() error = %v, the slot of the previous request was not released", err)
+		}
+
+		// The body is read until EOF but never closed.
+		if _, err := io.ReadAll(resp.Body); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestCloudAvenueTransportSharedThrottle(t *testing.T) {
+	c := &CloudAvenue{ThrottlePolicy: &ThrottlePolicy{MaxConcurrentRequests: 1}}
+
+	first := c.transport(nil).(*retryTransport).next.(*throttleTransport)
+	second := c.transport(nil).(*retryTransport).next.(*throttleTransport)
+	if first.throttle != second.throttle {
+		t.Error("transport() must share the throttle between the HTTP clients")
+	}
+}
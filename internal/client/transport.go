@@ -0,0 +1,89 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package client
+
+import (
+	"crypto/tls"
+	"net/http"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+)
+
+// transport returns the transport chain of the provider around next. Each
+// attempt of a retried request goes through the rate limiter and is logged.
+func (c *CloudAvenue) transport(next http.RoundTripper) http.RoundTripper {
+	if c.TLSConfig != nil {
+		next = withTLSConfig(next, c.TLSConfig)
+	}
+	if c.HTTPLogger.enabled() {
+		next = newWireLogTransport(next, c.HTTPLogger)
+	}
+	if c.ThrottlePolicy.enabled() {
+		c.throttleOnce.Do(func() {
+			c.throttle = newThrottle(c.ThrottlePolicy)
+		})
+		next = newThrottleTransport(next, c.throttle)
+	}
+	return newRetryTransport(next, c.retryPolicy())
+}
+
+// hookHTTPClient installs the transport chain of the provider on the HTTP
+// client referenced by hc. The client is copied, a client shared with other
+// programs such as http.DefaultClient is never modified. A client already
+// hooked is kept as is.
+func (c *CloudAvenue) hookHTTPClient(hc **http.Client) {
+	c.hooksMu.Lock()
+	defer c.hooksMu.Unlock()
+
+	current := *hc
+	if current == nil {
+		current = http.DefaultClient
+	}
+	if _, ok := current.Transport.(*retryTransport); ok {
+		return
+	}
+
+	hooked := *current
+	hooked.Transport = c.transport(current.Transport)
+	*hc = &hooked
+}
+
+// hookS3 installs the transport chain of the provider and the S3Endpoint on
+// the S3 client.
+func (c *CloudAvenue) hookS3(svc *s3.S3) {
+	c.hookHTTPClient(&svc.Config.HTTPClient)
+
+	if c.S3Endpoint != "" {
+		c.hooksMu.Lock()
+		defer c.hooksMu.Unlock()
+
+		svc.Endpoint = c.S3Endpoint
+		svc.Config.Endpoint = aws.String(c.S3Endpoint)
+		svc.Config.S3ForcePathStyle = aws.Bool(true)
+	}
+}
+
+// withTLSConfig returns a copy of the transport using the TLS configuration.
+// Only the *http.Transport can be configured, other transports are returned
+// as is.
+func withTLSConfig(next http.RoundTripper, config *tls.Config) http.RoundTripper {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	t, ok := next.(*http.Transport)
+	if !ok {
+		return next
+	}
+
+	t = t.Clone()
+	t.TLSClientConfig = config.Clone()
+	return t
+}
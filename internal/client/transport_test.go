@@ -0,0 +1,174 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package client
+
+import (
+	"bytes"
+	"crypto/tls"
+	"crypto/x509"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+)
+
+func TestHookHTTPClient(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		if calls.Add(1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	c := &CloudAvenue{RetryPolicy: &RetryPolicy{
+		MaxAttempts:          3,
+		MinBackoff:           time.Millisecond,
+		MaxBackoff:           time.Millisecond,
+		RetryableStatusCodes: []int{http.StatusServiceUnavailable},
+	}}
+
+	defaultTransport := http.DefaultClient.Transport
+
+	var hc *http.Client
+	c.hookHTTPClient(&hc)
+	if hc == nil || hc == http.DefaultClient {
+		t.Fatal("hookHTTPClient() must install a copy of the default client")
+	}
+	if http.DefaultClient.Transport != defaultTransport {
+		t.Error("hookHTTPClient() modified http.DefaultClient")
+	}
+
+	hooked := hc
+	c.hookHTTPClient(&hc)
+	if hc != hooked {
+		t.Error("hookHTTPClient() hooked the client twice")
+	}
+
+	resp, err := hc.Get(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK || calls.Load() != 2 {
+		t.Errorf("status = %d after %d calls, want %d after 2 calls", resp.StatusCode, calls.Load(), http.StatusOK)
+	}
+}
+
+func TestHookHTTPClientWireLog(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/xml")
+		_, _ = w.Write([]byte(`<Credentials><AccessKeyId>AKID</AccessKeyId><SecretAccessKey>from-server</SecretAccessKey></Credentials>`))
+	}))
+	defer server.Close()
+
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(t.Context(), &output)
+	ctx = tflog.NewSubsystem(ctx, "http")
+
+	c := &CloudAvenue{HTTPLogger: &HTTPLogger{Context: ctx, Subsystem: "http"}}
+
+	// The S3 client of the SDK uses http.DefaultClient when none is set.
+	var hc *http.Client
+	c.hookHTTPClient(&hc)
+
+	req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, server.URL+"/bucket?X-Amz-Signature=signature-value&X-Amz-Credential=credential-value", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("X-Amz-Security-Token", "session-token")
+
+	resp, err := hc.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	logged := output.String()
+	if !strings.Contains(logged, "AKID") {
+		t.Errorf("the S3 exchange is not logged: %s", logged)
+	}
+	for _, secret := range []string{"from-server", "session-token", "signature-value", "credential-value"} {
+		if strings.Contains(logged, secret) {
+			t.Errorf("log contains the secret %q: %s", secret, logged)
+		}
+	}
+}
+
+func TestHookHTTPClientTLSConfig(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	roots := x509.NewCertPool()
+	roots.AddCert(server.Certificate())
+	c := &CloudAvenue{
+		RetryPolicy: &RetryPolicy{MaxAttempts: 1},
+		TLSConfig:   &tls.Config{RootCAs: roots, MinVersion: tls.VersionTLS12},
+	}
+
+	var hc *http.Client
+	c.hookHTTPClient(&hc)
+
+	resp, err := hc.Get(server.URL)
+	if err != nil {
+		t.Fatalf("Get() error = %v, want the CA of the TLS configuration trusted", err)
+	}
+	resp.Body.Close()
+
+	// The CA is only trusted by the hooked client.
+	if resp, err := http.DefaultClient.Get(server.URL); err == nil {
+		resp.Body.Close()
+		t.Error("hookHTTPClient() modified the TLS configuration of http.DefaultTransport")
+	}
+}
+
+func TestHookS3Endpoint(t *testing.T) {
+	var path string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	sess, err := session.NewSession(&aws.Config{
+		Endpoint:    aws.String("https://s3.invalid"),
+		Region:      aws.String("region01"),
+		Credentials: credentials.NewStaticCredentials("access", "secret", ""),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	svc := s3.New(sess)
+
+	c := &CloudAvenue{RetryPolicy: &RetryPolicy{MaxAttempts: 1}, S3Endpoint: server.URL + "/s3"}
+	c.hookS3(svc)
+
+	if _, err := svc.HeadBucketWithContext(t.Context(), &s3.HeadBucketInput{Bucket: aws.String("bucket01")}); err != nil {
+		t.Fatalf("HeadBucket() error = %v", err)
+	}
+	if path != "/s3/bucket01" {
+		t.Errorf("path = %s, want the S3 endpoint in path style", path)
+	}
+}
@@ -0,0 +1,188 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/helpers/redact"
+)
+
+// redacted replaces the sensitive values in the logs.
+const redacted = redact.Placeholder
+
+// maxLoggedBodySize is the maximum size of a logged body. The bodies are
+// truncated above this size.
+const maxLoggedBodySize = 64 * 1024
+
+// HTTPLogger logs the HTTP exchanges with the API at the TRACE level of a
+// tflog subsystem. The secrets are redacted from the logged headers, URLs
+// and bodies.
+type HTTPLogger struct {
+	// Context holds the subsystem logger.
+	Context context.Context
+	// Subsystem is the name of the tflog subsystem.
+	Subsystem string
+}
+
+// enabled reports whether the subsystem logs at the TRACE level.
+func (l *HTTPLogger) enabled() bool {
+	return l != nil && l.Context != nil && tflog.SubsystemIsTrace(l.Context, l.Subsystem)
+}
+
+// wireLogTransport is an http.RoundTripper logging the HTTP exchanges.
+type wireLogTransport struct {
+	next   http.RoundTripper
+	logger *HTTPLogger
+}
+
+func newWireLogTransport(next http.RoundTripper, logger *HTTPLogger) http.RoundTripper {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	return &wireLogTransport{
+		next:   next,
+		logger: logger,
+	}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *wireLogTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	fields := map[string]any{
+		"method":          req.Method,
+		"url":             redact.URL(req.URL),
+		"request_headers": redactHeaders(req.Header),
+	}
+	if body, ok := requestBody(req); ok {
+		fields["request_body"] = body
+	}
+
+	start := time.Now()
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		fields["duration"] = time.Since(start).String()
+		fields["error"] = err.Error()
+		tflog.SubsystemTrace(t.logger.Context, t.logger.Subsystem, "HTTP request failed", fields)
+		return resp, err
+	}
+
+	fields["status_code"] = resp.StatusCode
+	fields["response_headers"] = redactHeaders(resp.Header)
+	if body, ok := responseBody(resp); ok {
+		fields["response_body"] = body
+	}
+	fields["duration"] = time.Since(start).String()
+
+	tflog.SubsystemTrace(t.logger.Context, t.logger.Subsystem, "HTTP exchange", fields)
+	return resp, nil
+}
+
+// requestBody returns the redacted body of the request, restoring the body
+// so that it can still be sent.
+func requestBody(req *http.Request) (string, bool) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return "", false
+	}
+	if !redact.IsTextContent(req.Header.Get("Content-Type")) {
+		return skippedBody(req.Header.Get("Content-Type"), req.ContentLength), true
+	}
+
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return "", false
+		}
+		defer body.Close()
+		data, _ := io.ReadAll(io.LimitReader(body, maxLoggedBodySize+1))
+		return redactBody(data), true
+	}
+
+	data, err := io.ReadAll(req.Body)
+	req.Body.Close()
+	req.Body = io.NopCloser(bytes.NewReader(data))
+	req.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(data)), nil
+	}
+	if err != nil {
+		return "", false
+	}
+	return redactBody(data), true
+}
+
+// responseBody returns the redacted body of the response, replacing the
+// body so that it can still be read by the caller.
+func responseBody(resp *http.Response) (string, bool) {
+	if resp.Body == nil || resp.Body == http.NoBody {
+		return "", false
+	}
+	if !redact.IsTextContent(resp.Header.Get("Content-Type")) {
+		return skippedBody(resp.Header.Get("Content-Type"), resp.ContentLength), true
+	}
+
+	data, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		// The caller gets the read error after the data already read.
+		resp.Body = io.NopCloser(io.MultiReader(bytes.NewReader(data), errReader{err}))
+	} else {
+		resp.Body = io.NopCloser(bytes.NewReader(data))
+	}
+	return redactBody(data), true
+}
+
+// errReader is an io.Reader returning an error.
+type errReader struct{ err error }
+
+func (r errReader) Read([]byte) (int, error) { return 0, r.err }
+
+// skippedBody describes a body that is not logged.
+func skippedBody(contentType string, size int64) string {
+	if size < 0 {
+		return "<" + contentType + " body not logged>"
+	}
+	return "<" + strconv.FormatInt(size, 10) + " bytes of " + contentType + " not logged>"
+}
+
+// redactBody returns the body with the secrets redacted, truncated to
+// maxLoggedBodySize.
+func redactBody(data []byte) string {
+	truncated := len(data) > maxLoggedBodySize
+	if truncated {
+		data = data[:maxLoggedBodySize]
+	}
+
+	body := redact.Body(string(data))
+
+	if truncated {
+		body += "... (truncated)"
+	}
+	return body
+}
+
+// redactHeaders returns the headers with the secrets redacted.
+func redactHeaders(header http.Header) map[string]string {
+	headers := make(map[string]string, len(header))
+	for name, values := range header {
+		if redact.IsSensitiveHeader(name) {
+			headers[name] = redacted
+			continue
+		}
+		headers[name] = strings.Join(values, ", ")
+	}
+	return headers
+}
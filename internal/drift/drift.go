@@ -0,0 +1,308 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+// Package drift compares the resources of a Terraform state file with the
+// resources of the organization, read with the Read methods of the provider.
+package drift
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"sort"
+
+	schemaR "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/standalone"
+)
+
+// sensitiveValue replaces the values of the sensitive attributes.
+const sensitiveValue = "(sensitive)"
+
+// skippedAttributes are the attributes never compared.
+var skippedAttributes = map[string]bool{
+	"timeouts": true,
+}
+
+// sibling describes the resources created next to a managed parent, e.g.
+// the NAT rules of an edge gateway, that are reported when they are not
+// managed.
+type sibling struct {
+	parentType string
+	typeName   string
+	// parentAttribute is the attribute of the sibling holding the ID of
+	// its parent.
+	parentAttribute string
+	// config maps the attributes of the list resource configuration to the
+	// attributes of the parent. The siblings are listed once if it is nil.
+	config map[string]string
+	// emptyAttribute is a list attribute of the sibling. The sibling is not
+	// reported if the list is empty, e.g. a firewall without rules.
+	emptyAttribute string
+}
+
+var siblings = []sibling{
+	{
+		parentType:      "cloudavenue_edgegateway",
+		typeName:        "cloudavenue_edgegateway_firewall",
+		parentAttribute: "edge_gateway_id",
+		emptyAttribute:  "rules",
+	},
+	{
+		parentType:      "cloudavenue_edgegateway",
+		typeName:        "cloudavenue_edgegateway_nat_rule",
+		parentAttribute: "edge_gateway_id",
+		config:          map[string]string{"edge_gateway_id": "id"},
+	},
+	{
+		parentType:      "cloudavenue_vapp",
+		typeName:        "cloudavenue_vm",
+		parentAttribute: "vapp_id",
+		config:          map[string]string{"vdc": "vdc", "vapp_name": "name"},
+	},
+}
+
+// managedResource is a managed resource read from the API.
+type managedResource struct {
+	address string
+	standalone.Resource
+}
+
+// Check reads the managed resources of a state file and reports the drifted
+// attributes, the deleted resources and the unmanaged siblings of the
+// managed resources.
+func Check(ctx context.Context, p *standalone.Provider, resources []ManagedResource) *Report {
+	report := &Report{
+		Org:       p.OrgName(),
+		Drifted:   []DriftedResource{},
+		Deleted:   []DeletedResource{},
+		Unmanaged: []UnmanagedResource{},
+		Errors:    []ResourceError{},
+	}
+
+	managed := map[string][]managedResource{}
+	for _, m := range resources {
+		report.Checked++
+
+		prior, err := p.StateFromJSON(ctx, m.Type, m.SchemaVersion, m.Attributes)
+		if err != nil {
+			report.Errors = append(report.Errors, ResourceError{Address: m.Address, Error: err.Error()})
+			continue
+		}
+
+		current, found, err := p.Read(ctx, m.Type, prior)
+		if err != nil {
+			report.Errors = append(report.Errors, ResourceError{Address: m.Address, Error: err.Error()})
+			continue
+		}
+
+		if !found {
+			id, _ := standalone.Resource{State: prior}.StringAttribute("id")
+			report.Deleted = append(report.Deleted, DeletedResource{Address: m.Address, Type: m.Type, ID: id})
+			continue
+		}
+		managed[m.Type] = append(managed[m.Type], managedResource{address: m.Address, Resource: current})
+
+		s, ok := prior.Schema.(schemaR.Schema)
+		if !ok {
+			continue
+		}
+		if attributes := diffObject("", s.Attributes, prior.Raw, current.State.Raw); len(attributes) > 0 {
+			report.Drifted = append(report.Drifted, DriftedResource{Address: m.Address, Type: m.Type, Attributes: attributes})
+		}
+	}
+
+	for _, s := range siblings {
+		unmanaged, errs := s.unmanaged(ctx, p, managed)
+		report.Unmanaged = append(report.Unmanaged, unmanaged...)
+		report.Errors = append(report.Errors, errs...)
+	}
+
+	return report
+}
+
+// unmanaged returns the siblings of the managed parents that are not
+// managed.
+func (s sibling) unmanaged(ctx context.Context, p *standalone.Provider, managed map[string][]managedResource) ([]UnmanagedResource, []ResourceError) {
+	parents := map[string]string{}
+	for _, parent := range managed[s.parentType] {
+		if id, ok := parent.StringAttribute("id"); ok {
+			parents[id] = parent.address
+		}
+	}
+	if len(parents) == 0 {
+		return nil, nil
+	}
+
+	managedIDs := map[string]bool{}
+	for _, r := range managed[s.typeName] {
+		if id, ok := r.StringAttribute("id"); ok {
+			managedIDs[id] = true
+		}
+	}
+
+	var configs []map[string]string
+	if s.config == nil {
+		configs = append(configs, nil)
+	} else {
+		for _, parent := range managed[s.parentType] {
+			config := map[string]string{}
+			for name, parentAttribute := range s.config {
+				if v, ok := parent.StringAttribute(parentAttribute); ok {
+					config[name] = v
+				}
+			}
+			configs = append(configs, config)
+		}
+	}
+
+	var (
+		unmanaged []UnmanagedResource
+		errs      []ResourceError
+		reported  = map[string]bool{}
+	)
+	for _, config := range configs {
+		found, err := p.List(ctx, s.typeName, config)
+		if err != nil {
+			errs = append(errs, ResourceError{Address: s.typeName, Error: err.Error()})
+		}
+
+		for _, r := range found {
+			parentID, _ := r.StringAttribute(s.parentAttribute)
+			parent, ok := parents[parentID]
+			if !ok {
+				continue
+			}
+
+			id, _ := r.StringAttribute("id")
+			if managedIDs[id] || reported[id] || (s.emptyAttribute != "" && isEmpty(r, s.emptyAttribute)) {
+				continue
+			}
+			reported[id] = true
+
+			unmanaged = append(unmanaged, UnmanagedResource{
+				Type:        s.typeName,
+				DisplayName: r.DisplayName,
+				ImportID:    r.ImportID,
+				Parent:      parent,
+			})
+		}
+	}
+
+	return unmanaged, errs
+}
+
+// isEmpty reports whether a collection attribute of a resource is null or
+// empty.
+func isEmpty(r standalone.Resource, name string) bool {
+	values := map[string]tftypes.Value{}
+	if err := r.State.Raw.As(&values); err != nil {
+		return true
+	}
+
+	var elements []tftypes.Value
+	if err := values[name].As(&elements); err != nil {
+		return true
+	}
+	return len(elements) == 0
+}
+
+// diffObject returns the drifted configurable attributes of an object. The
+// single nested attributes are compared attribute by attribute, the other
+// attributes as a whole.
+func diffObject(prefix string, attributes map[string]schemaR.Attribute, prior, current tftypes.Value) []AttributeDrift {
+	priorValues := map[string]tftypes.Value{}
+	currentValues := map[string]tftypes.Value{}
+	if err := prior.As(&priorValues); err != nil {
+		return nil
+	}
+	if err := current.As(&currentValues); err != nil {
+		return nil
+	}
+
+	names := make([]string, 0, len(attributes))
+	for name := range attributes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var drifts []AttributeDrift
+	for _, name := range names {
+		a := attributes[name]
+		if skippedAttributes[name] || (!a.IsRequired() && !a.IsOptional()) {
+			continue
+		}
+
+		p, c := priorValues[name], currentValues[name]
+		if p.Equal(c) {
+			continue
+		}
+
+		if na, ok := a.(schemaR.SingleNestedAttribute); ok && !p.IsNull() && !c.IsNull() {
+			drifts = append(drifts, diffObject(prefix+name+".", na.Attributes, p, c)...)
+			continue
+		}
+
+		drift := AttributeDrift{
+			Path:   prefix + name,
+			State:  jsonValue(p),
+			Remote: jsonValue(c),
+		}
+		if a.IsSensitive() {
+			drift.State, drift.Remote, drift.Sensitive = sensitiveValue, sensitiveValue, true
+		}
+		drifts = append(drifts, drift)
+	}
+
+	return drifts
+}
+
+// jsonValue returns a value that can be encoded in JSON.
+func jsonValue(v tftypes.Value) any {
+	if v.IsNull() || !v.IsKnown() {
+		return nil
+	}
+
+	t := v.Type()
+	switch {
+	case t.Is(tftypes.String):
+		var s string
+		_ = v.As(&s)
+		return s
+	case t.Is(tftypes.Number):
+		n := new(big.Float)
+		_ = v.As(&n)
+		return json.Number(n.Text('g', -1))
+	case t.Is(tftypes.Bool):
+		var b bool
+		_ = v.As(&b)
+		return b
+	case t.Is(tftypes.List{}), t.Is(tftypes.Set{}), t.Is(tftypes.Tuple{}):
+		var elements []tftypes.Value
+		_ = v.As(&elements)
+		values := make([]any, 0, len(elements))
+		for _, element := range elements {
+			values = append(values, jsonValue(element))
+		}
+		return values
+	case t.Is(tftypes.Map{}), t.Is(tftypes.Object{}):
+		elements := map[string]tftypes.Value{}
+		_ = v.As(&elements)
+		values := make(map[string]any, len(elements))
+		for key, element := range elements {
+			if !element.IsNull() {
+				values[key] = jsonValue(element)
+			}
+		}
+		return values
+	default:
+		return v.String()
+	}
+}
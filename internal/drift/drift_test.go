@@ -0,0 +1,163 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package drift
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	schemaR "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var testSchema = schemaR.Schema{
+	Attributes: map[string]schemaR.Attribute{
+		"id":       schemaR.StringAttribute{Computed: true},
+		"name":     schemaR.StringAttribute{Required: true},
+		"status":   schemaR.StringAttribute{Computed: true},
+		"memory":   schemaR.Int64Attribute{Optional: true, Computed: true},
+		"password": schemaR.StringAttribute{Optional: true, Sensitive: true},
+		"tags":     schemaR.SetAttribute{Optional: true, ElementType: types.StringType},
+		"settings": schemaR.SingleNestedAttribute{
+			Optional: true,
+			Attributes: map[string]schemaR.Attribute{
+				"customer_ip": schemaR.StringAttribute{Optional: true},
+				"checked_at":  schemaR.StringAttribute{Computed: true},
+			},
+		},
+	},
+}
+
+func testValue(t *testing.T, values map[string]tftypes.Value) tftypes.Value {
+	t.Helper()
+
+	objectType := testSchema.Type().TerraformType(context.Background()).(tftypes.Object)
+	for name, attributeType := range objectType.AttributeTypes {
+		if _, ok := values[name]; !ok {
+			values[name] = tftypes.NewValue(attributeType, nil)
+		}
+	}
+	return tftypes.NewValue(objectType, values)
+}
+
+func TestDiffObject(t *testing.T) {
+	settingsType := testSchema.Attributes["settings"].GetType().TerraformType(context.Background())
+	tagsType := tftypes.Set{ElementType: tftypes.String}
+	tags := func(values ...string) tftypes.Value {
+		elements := make([]tftypes.Value, 0, len(values))
+		for _, v := range values {
+			elements = append(elements, tftypes.NewValue(tftypes.String, v))
+		}
+		return tftypes.NewValue(tagsType, elements)
+	}
+	settings := func(customerIP, checkedAt string) tftypes.Value {
+		return tftypes.NewValue(settingsType, map[string]tftypes.Value{
+			"customer_ip": tftypes.NewValue(tftypes.String, customerIP),
+			"checked_at":  tftypes.NewValue(tftypes.String, checkedAt),
+		})
+	}
+
+	prior := testValue(t, map[string]tftypes.Value{
+		"id":       tftypes.NewValue(tftypes.String, "urn:vcloud:vm:1"),
+		"name":     tftypes.NewValue(tftypes.String, "web"),
+		"status":   tftypes.NewValue(tftypes.String, "POWERED_ON"),
+		"memory":   tftypes.NewValue(tftypes.Number, 2048),
+		"password": tftypes.NewValue(tftypes.String, "old"),
+		"tags":     tags("a", "b"),
+		"settings": settings("10.0.0.1", "monday"),
+	})
+	current := testValue(t, map[string]tftypes.Value{
+		"id":       tftypes.NewValue(tftypes.String, "urn:vcloud:vm:1"),
+		"name":     tftypes.NewValue(tftypes.String, "web"),
+		"status":   tftypes.NewValue(tftypes.String, "POWERED_OFF"),
+		"memory":   tftypes.NewValue(tftypes.Number, 4096),
+		"password": tftypes.NewValue(tftypes.String, "new"),
+		"tags":     tags("b", "a"),
+		"settings": settings("10.0.0.2", "tuesday"),
+	})
+
+	got, err := json.Marshal(diffObject("", testSchema.Attributes, prior, current))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The computed attributes are not compared, the order of a set does not
+	// matter and the sensitive values are not reported.
+	want := `[{"path":"memory","state":2048,"remote":4096},` +
+		`{"path":"password","state":"(sensitive)","remote":"(sensitive)","sensitive":true},` +
+		`{"path":"settings.customer_ip","state":"10.0.0.1","remote":"10.0.0.2"}]`
+	if string(got) != want {
+		t.Errorf("diffObject() = %s, want %s", got, want)
+	}
+
+	if drifts := diffObject("", testSchema.Attributes, prior, prior); len(drifts) != 0 {
+		t.Errorf("diffObject() of the same values = %v, want none", drifts)
+	}
+}
+
+func TestReportWriteText(t *testing.T) {
+	report := &Report{
+		Org:     "cav01ev01ocb0001234",
+		Checked: 3,
+		Drifted: []DriftedResource{{
+			Address: "cloudavenue_vm.web",
+			Type:    "cloudavenue_vm",
+			Attributes: []AttributeDrift{
+				{Path: "memory", State: json.Number("2048"), Remote: json.Number("4096")},
+				{Path: "password", State: sensitiveValue, Remote: sensitiveValue, Sensitive: true},
+			},
+		}},
+		Deleted: []DeletedResource{{Address: "cloudavenue_vapp.old", Type: "cloudavenue_vapp"}},
+		Unmanaged: []UnmanagedResource{{
+			Type:        "cloudavenue_edgegateway_nat_rule",
+			DisplayName: "edge/portal",
+			ImportID:    "edge.portal",
+			Parent:      "cloudavenue_edgegateway.main",
+		}},
+		Errors: []ResourceError{{Address: "cloudavenue_vdc.main", Error: "access denied"}},
+	}
+
+	var b bytes.Buffer
+	if err := report.WriteText(&b); err != nil {
+		t.Fatal(err)
+	}
+
+	want := `Drift report of the organization cav01ev01ocb0001234: 3 resources checked.
+
+Drifted resources (1):
+  ~ cloudavenue_vm.web
+      memory: 2048 => 4096
+      password: (sensitive) => (sensitive)
+
+Deleted resources (1):
+  - cloudavenue_vapp.old
+
+Unmanaged resources (1):
+  + cloudavenue_edgegateway_nat_rule "edge/portal" on cloudavenue_edgegateway.main (import ID "edge.portal")
+
+Errors (1):
+  ! cloudavenue_vdc.main: access denied
+`
+	if b.String() != want {
+		t.Errorf("WriteText() =\n%s\nwant\n%s", b.String(), want)
+	}
+
+	b.Reset()
+	if err := (&Report{Org: "org"}).WriteText(&b); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasSuffix(b.String(), "No drift detected.\n") {
+		t.Errorf("WriteText() without drift =\n%s", b.String())
+	}
+}
@@ -0,0 +1,151 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package drift
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Report is the drift report of a state file.
+type Report struct {
+	// Org is the organization of the provider.
+	Org string `json:"org"`
+	// Checked is the number of managed resources checked.
+	Checked int `json:"checked"`
+
+	Drifted   []DriftedResource   `json:"drifted"`
+	Deleted   []DeletedResource   `json:"deleted"`
+	Unmanaged []UnmanagedResource `json:"unmanaged"`
+	Errors    []ResourceError     `json:"errors"`
+}
+
+// DriftedResource is a managed resource whose attributes changed outside
+// of Terraform.
+type DriftedResource struct {
+	Address    string           `json:"address"`
+	Type       string           `json:"type"`
+	Attributes []AttributeDrift `json:"attributes"`
+}
+
+// AttributeDrift is an attribute that changed outside of Terraform.
+type AttributeDrift struct {
+	// Path is the path of the attribute, e.g. settings.customer_ip.
+	Path string `json:"path"`
+	// State is the value in the state file.
+	State any `json:"state"`
+	// Remote is the value read from the API.
+	Remote any `json:"remote"`
+	// Sensitive is set if the values are not reported.
+	Sensitive bool `json:"sensitive,omitempty"`
+}
+
+// DeletedResource is a managed resource deleted outside of Terraform.
+type DeletedResource struct {
+	Address string `json:"address"`
+	Type    string `json:"type"`
+	ID      string `json:"id,omitempty"`
+}
+
+// UnmanagedResource is a resource created outside of Terraform next to a
+// managed resource, e.g. a NAT rule created in the portal on a managed edge
+// gateway.
+type UnmanagedResource struct {
+	Type        string `json:"type"`
+	DisplayName string `json:"display_name"`
+	// ImportID is the ID importing the resource in the state.
+	ImportID string `json:"import_id,omitempty"`
+	// Parent is the address of the managed resource.
+	Parent string `json:"parent"`
+}
+
+// ResourceError is a resource that could not be checked.
+type ResourceError struct {
+	// Address is the address of the resource, or the resource type when
+	// the resources could not be listed.
+	Address string `json:"address"`
+	Error   string `json:"error"`
+}
+
+// HasDrift reports whether a drift has been found.
+func (r *Report) HasDrift() bool {
+	return len(r.Drifted) > 0 || len(r.Deleted) > 0 || len(r.Unmanaged) > 0
+}
+
+// WriteJSON writes the report in JSON.
+func (r *Report) WriteJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(r)
+}
+
+// WriteText writes the report for a human reader.
+func (r *Report) WriteText(w io.Writer) error {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "Drift report of the organization %s: %d resources checked.\n", r.Org, r.Checked)
+
+	if len(r.Drifted) > 0 {
+		fmt.Fprintf(&b, "\nDrifted resources (%d):\n", len(r.Drifted))
+		for _, d := range r.Drifted {
+			fmt.Fprintf(&b, "  ~ %s\n", d.Address)
+			for _, a := range d.Attributes {
+				fmt.Fprintf(&b, "      %s: %s => %s\n", a.Path, textValue(a.State), textValue(a.Remote))
+			}
+		}
+	}
+
+	if len(r.Deleted) > 0 {
+		fmt.Fprintf(&b, "\nDeleted resources (%d):\n", len(r.Deleted))
+		for _, d := range r.Deleted {
+			fmt.Fprintf(&b, "  - %s\n", d.Address)
+		}
+	}
+
+	if len(r.Unmanaged) > 0 {
+		fmt.Fprintf(&b, "\nUnmanaged resources (%d):\n", len(r.Unmanaged))
+		for _, u := range r.Unmanaged {
+			fmt.Fprintf(&b, "  + %s %q on %s", u.Type, u.DisplayName, u.Parent)
+			if u.ImportID != "" {
+				fmt.Fprintf(&b, " (import ID %q)", u.ImportID)
+			}
+			b.WriteString("\n")
+		}
+	}
+
+	if len(r.Errors) > 0 {
+		fmt.Fprintf(&b, "\nErrors (%d):\n", len(r.Errors))
+		for _, e := range r.Errors {
+			fmt.Fprintf(&b, "  ! %s: %s\n", e.Address, e.Error)
+		}
+	}
+
+	if !r.HasDrift() {
+		b.WriteString("\nNo drift detected.\n")
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// textValue formats a value of the report.
+func textValue(v any) string {
+	if s, ok := v.(string); ok && s == sensitiveValue {
+		return s
+	}
+
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+	return string(data)
+}
@@ -0,0 +1,100 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package drift
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// stateFileVersion is the only supported version of the state file format.
+const stateFileVersion = 4
+
+// ManagedResource is a cloudavenue resource instance of a state file.
+type ManagedResource struct {
+	// Address is the address of the instance, e.g. module.a.cloudavenue_vm.web[0].
+	Address string
+	// Type is the resource type, e.g. cloudavenue_vm.
+	Type string
+	// SchemaVersion is the version of the schema of the attributes.
+	SchemaVersion int64
+	// Attributes are the attributes of the instance in JSON.
+	Attributes json.RawMessage
+}
+
+type stateFile struct {
+	Version   int                 `json:"version"`
+	Resources []stateFileResource `json:"resources"`
+}
+
+type stateFileResource struct {
+	Module    string                      `json:"module"`
+	Mode      string                      `json:"mode"`
+	Type      string                      `json:"type"`
+	Name      string                      `json:"name"`
+	Provider  string                      `json:"provider"`
+	Instances []stateFileResourceInstance `json:"instances"`
+}
+
+type stateFileResourceInstance struct {
+	IndexKey      any             `json:"index_key"`
+	SchemaVersion int64           `json:"schema_version"`
+	Attributes    json.RawMessage `json:"attributes"`
+}
+
+// ReadStateFile returns the cloudavenue managed resources of a Terraform
+// state file, as written by `terraform state pull`.
+func ReadStateFile(r io.Reader) ([]ManagedResource, error) {
+	var s stateFile
+	if err := json.NewDecoder(r).Decode(&s); err != nil {
+		return nil, fmt.Errorf("invalid state file: %w", err)
+	}
+	if s.Version != stateFileVersion {
+		return nil, fmt.Errorf("unsupported state file version %d, expected %d", s.Version, stateFileVersion)
+	}
+
+	var resources []ManagedResource
+	for _, r := range s.Resources {
+		if r.Mode != "managed" || !strings.HasPrefix(r.Type, "cloudavenue_") || !strings.Contains(r.Provider, "/cloudavenue\"]") {
+			continue
+		}
+
+		address := r.Type + "." + r.Name
+		if r.Module != "" {
+			address = r.Module + "." + address
+		}
+
+		for _, instance := range r.Instances {
+			resources = append(resources, ManagedResource{
+				Address:       address + indexKey(instance.IndexKey),
+				Type:          r.Type,
+				SchemaVersion: instance.SchemaVersion,
+				Attributes:    instance.Attributes,
+			})
+		}
+	}
+
+	return resources, nil
+}
+
+// indexKey returns the index of a count or for_each instance.
+func indexKey(key any) string {
+	switch k := key.(type) {
+	case float64:
+		return "[" + strconv.FormatFloat(k, 'f', -1, 64) + "]"
+	case string:
+		return "[" + strconv.Quote(k) + "]"
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,91 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package drift
+
+import (
+	"strings"
+	"testing"
+)
+
+const testStateFile = `{
+  "version": 4,
+  "terraform_version": "1.9.5",
+  "resources": [
+    {
+      "mode": "managed",
+      "type": "cloudavenue_vm",
+      "name": "web",
+      "provider": "provider[\"registry.terraform.io/orange-cloudavenue/cloudavenue\"]",
+      "instances": [
+        {"index_key": 0, "schema_version": 0, "attributes": {"name": "web-0"}},
+        {"index_key": 1, "schema_version": 0, "attributes": {"name": "web-1"}}
+      ]
+    },
+    {
+      "module": "module.network",
+      "mode": "managed",
+      "type": "cloudavenue_edgegateway_nat_rule",
+      "name": "snat",
+      "provider": "provider[\"registry.terraform.io/orange-cloudavenue/cloudavenue\"].other",
+      "instances": [
+        {"index_key": "http", "schema_version": 1, "attributes": {"name": "snat"}}
+      ]
+    },
+    {
+      "mode": "data",
+      "type": "cloudavenue_vdc",
+      "name": "main",
+      "provider": "provider[\"registry.terraform.io/orange-cloudavenue/cloudavenue\"]",
+      "instances": [{"schema_version": 0, "attributes": {"name": "vdc"}}]
+    },
+    {
+      "mode": "managed",
+      "type": "random_id",
+      "name": "suffix",
+      "provider": "provider[\"registry.terraform.io/hashicorp/random\"]",
+      "instances": [{"schema_version": 0, "attributes": {"hex": "ab"}}]
+    }
+  ]
+}`
+
+func TestReadStateFile(t *testing.T) {
+	resources, err := ReadStateFile(strings.NewReader(testStateFile))
+	if err != nil {
+		t.Fatalf("ReadStateFile() error = %v", err)
+	}
+
+	want := []struct {
+		address       string
+		schemaVersion int64
+		attributes    string
+	}{
+		{address: "cloudavenue_vm.web[0]", attributes: `{"name": "web-0"}`},
+		{address: "cloudavenue_vm.web[1]", attributes: `{"name": "web-1"}`},
+		{address: `module.network.cloudavenue_edgegateway_nat_rule.snat["http"]`, schemaVersion: 1, attributes: `{"name": "snat"}`},
+	}
+	if len(resources) != len(want) {
+		t.Fatalf("ReadStateFile() = %d resources, want %d", len(resources), len(want))
+	}
+	for i, w := range want {
+		r := resources[i]
+		if r.Address != w.address || r.SchemaVersion != w.schemaVersion || string(r.Attributes) != w.attributes {
+			t.Errorf("resource %d = %s %d %s, want %s %d %s", i, r.Address, r.SchemaVersion, r.Attributes, w.address, w.schemaVersion, w.attributes)
+		}
+	}
+}
+
+func TestReadStateFileVersion(t *testing.T) {
+	if _, err := ReadStateFile(strings.NewReader(`{"version": 3}`)); err == nil {
+		t.Error("ReadStateFile() of a version 3 state: error = nil")
+	}
+	if _, err := ReadStateFile(strings.NewReader(`not json`)); err == nil {
+		t.Error("ReadStateFile() of an invalid state: error = nil")
+	}
+}
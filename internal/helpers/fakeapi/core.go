@@ -0,0 +1,414 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package fakeapi
+
+import (
+	"fmt"
+	"net/http"
+)
+
+const corePrefix = "/api/customers"
+
+// coreError is an error of the Cloud Avenue core API.
+type coreError struct {
+	Code    string `json:"code"`
+	Reason  string `json:"reason"`
+	Message string `json:"message"`
+}
+
+// job is an asynchronous operation of the core API.
+type job struct {
+	ID          string `json:"-"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Status      string `json:"status"`
+}
+
+type coreJobAction struct {
+	Name    string `json:"name"`
+	Status  string `json:"status"`
+	Details string `json:"details"`
+}
+
+type coreJobStatus struct {
+	job
+	Actions []coreJobAction `json:"actions"`
+}
+
+type coreJobResponse struct {
+	JobID string `json:"jobId"`
+}
+
+type coreStorageProfile struct {
+	Class   string `json:"class"`
+	Limit   int    `json:"limit"`
+	Default bool   `json:"default"`
+}
+
+type coreVDC struct {
+	Name                string               `json:"name"`
+	Description         string               `json:"description"`
+	ServiceClass        string               `json:"vdcServiceClass"`
+	DisponibilityClass  string               `json:"vdcDisponibilityClass"`
+	BillingModel        string               `json:"vdcBillingModel"`
+	VCPUInMhz           int                  `json:"vcpuInMhz2"`
+	CPUAllocated        int                  `json:"cpuAllocated"`
+	MemoryAllocated     int                  `json:"memoryAllocated"`
+	StorageBillingModel string               `json:"vdcStorageBillingModel"`
+	StorageProfiles     []coreStorageProfile `json:"vdcStorageProfiles"`
+}
+
+type coreVDCBody struct {
+	VDC      coreVDC `json:"vdc"`
+	VDCGroup string  `json:"vdcGroup,omitempty"`
+}
+
+type coreVDCListItem struct {
+	Name string `json:"vdc_name"`
+	UUID string `json:"vdc_uuid"`
+}
+
+type coreEdge struct {
+	ID          string `json:"edgeId"`
+	Name        string `json:"edgeName"`
+	OwnerType   string `json:"ownerType"`
+	OwnerName   string `json:"ownerName"`
+	RateLimit   int    `json:"rateLimit"`
+	Description string `json:"description"`
+	Tier0VrfID  string `json:"tier0VrfId"`
+}
+
+type coreEdgeCreate struct {
+	Tier0VrfID string `json:"tier0VrfId"`
+	RateLimit  int    `json:"rateLimit"`
+}
+
+type coreEdgeUpdate struct {
+	RateLimit int `json:"rateLimit"`
+}
+
+type coreTier0 struct {
+	Name         string   `json:"tier0_vrf_name"`
+	Provider     string   `json:"tier0_provider"`
+	ClassService string   `json:"tier0_class_service"`
+	Services     []string `json:"services"`
+}
+
+func (s *Server) coreRoutes() {
+	s.Handle("GET "+corePrefix+"/v1.0/jobs/{id}", s.authenticated(s.getJob))
+
+	s.Handle("GET "+corePrefix+"/v2.0/vdcs", s.authenticated(s.listCoreVDCs))
+	s.Handle("POST "+corePrefix+"/v2.0/vdcs", s.authenticated(s.createCoreVDC))
+	s.Handle("GET "+corePrefix+"/v2.0/vdcs/{name}", s.authenticated(s.getCoreVDC))
+	s.Handle("PUT "+corePrefix+"/v2.0/vdcs/{name}", s.authenticated(s.updateCoreVDC))
+	s.Handle("DELETE "+corePrefix+"/v2.0/vdcs/{name}", s.authenticated(s.deleteCoreVDC))
+
+	s.Handle("GET "+corePrefix+"/v2.0/edges", s.authenticated(s.listCoreEdges))
+	s.Handle("POST "+corePrefix+"/v2.0/vdcs/{name}/edges", s.authenticated(s.createCoreEdge))
+	s.Handle("GET "+corePrefix+"/v2.0/edges/{id}", s.authenticated(s.getCoreEdge))
+	s.Handle("PUT "+corePrefix+"/v2.0/edges/{id}", s.authenticated(s.updateCoreEdge))
+	s.Handle("DELETE "+corePrefix+"/v2.0/edges/{id}", s.authenticated(s.deleteCoreEdge))
+
+	s.Handle("GET "+corePrefix+"/v2.0/network/t0", s.authenticated(s.listTier0))
+}
+
+// tier0Name returns the name of the tier-0 VRF of the organization.
+func (s *Server) tier0Name() string {
+	return "prvrf01e" + s.config.Org + "std01"
+}
+
+// writeJob records a job completed successfully and writes its ID. The
+// caller must hold the lock.
+func (s *Server) writeJob(w http.ResponseWriter, name string) {
+	j := &job{ID: newID(), Name: name, Description: name, Status: "DONE"}
+	s.jobs[j.ID] = j
+
+	writeJSON(w, http.StatusAccepted, coreJobResponse{JobID: j.ID})
+}
+
+func (s *Server) getJob(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	j, ok := s.jobs[r.PathValue("id")]
+	if !ok {
+		notFound(w, r, "Job", r.PathValue("id"))
+		return
+	}
+
+	writeJSON(w, http.StatusOK, []coreJobStatus{{
+		job:     *j,
+		Actions: []coreJobAction{{Name: j.Name, Status: j.Status}},
+	}})
+}
+
+// coreVDCOf returns the VDC of the request, or writes the error if it does
+// not exist. The caller must hold the lock.
+func (s *Server) coreVDCOf(w http.ResponseWriter, r *http.Request) (*vdc, bool) {
+	v := s.vdcByName(r.PathValue("name"))
+	if v == nil {
+		notFound(w, r, "VDC", r.PathValue("name"))
+		return nil, false
+	}
+	return v, true
+}
+
+func (s *Server) listCoreVDCs(w http.ResponseWriter, _ *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	items := make([]coreVDCListItem, 0, len(s.vdcs))
+	for _, v := range s.sortedVDCs() {
+		items = append(items, coreVDCListItem{Name: v.Name, UUID: urn("vdc", v.ID)})
+	}
+
+	writeJSON(w, http.StatusOK, items)
+}
+
+func (s *Server) getCoreVDC(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	v, ok := s.coreVDCOf(w, r)
+	if !ok {
+		return
+	}
+
+	body := coreVDCBody{VDC: coreVDC{
+		Name:                v.Name,
+		Description:         v.Description,
+		ServiceClass:        v.ServiceClass,
+		DisponibilityClass:  v.DisponibilityClass,
+		BillingModel:        v.BillingModel,
+		VCPUInMhz:           v.VCPUInMhz,
+		CPUAllocated:        v.CPUAllocated,
+		MemoryAllocated:     v.MemoryAllocated,
+		StorageBillingModel: v.StorageBillingModel,
+		StorageProfiles:     make([]coreStorageProfile, 0, len(v.StorageProfiles)),
+	}}
+	for _, p := range v.StorageProfiles {
+		body.VDC.StorageProfiles = append(body.VDC.StorageProfiles, coreStorageProfile{Class: p.Class, Limit: p.Limit, Default: p.Default})
+	}
+
+	writeJSON(w, http.StatusOK, body)
+}
+
+// setCoreVDC sets the fields of a VDC from the body of the core API.
+func setCoreVDC(v *vdc, body coreVDC) {
+	v.Name = body.Name
+	v.Description = body.Description
+	v.ServiceClass = body.ServiceClass
+	v.DisponibilityClass = body.DisponibilityClass
+	v.BillingModel = body.BillingModel
+	v.VCPUInMhz = body.VCPUInMhz
+	v.CPUAllocated = body.CPUAllocated
+	v.MemoryAllocated = body.MemoryAllocated
+	v.StorageBillingModel = body.StorageBillingModel
+
+	profiles := make([]storageProfile, 0, len(body.StorageProfiles))
+	for _, p := range body.StorageProfiles {
+		id := newID()
+		for _, existing := range v.StorageProfiles {
+			if existing.Class == p.Class {
+				id = existing.ID
+			}
+		}
+		profiles = append(profiles, storageProfile{ID: id, Class: p.Class, Limit: p.Limit, Default: p.Default})
+	}
+	v.StorageProfiles = profiles
+}
+
+func (s *Server) createCoreVDC(w http.ResponseWriter, r *http.Request) {
+	var body coreVDCBody
+	if !readJSON(w, r, &body) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if body.VDC.Name == "" {
+		writeError(w, r, http.StatusBadRequest, "BAD_REQUEST", "The name of the VDC is required.")
+		return
+	}
+	if s.vdcByName(body.VDC.Name) != nil {
+		writeError(w, r, http.StatusConflict, "CONFLICT", "The VDC "+body.VDC.Name+" already exists.")
+		return
+	}
+
+	v := &vdc{ID: newID()}
+	setCoreVDC(v, body.VDC)
+	s.vdcs[v.ID] = v
+
+	s.writeJob(w, "Create VDC "+v.Name)
+}
+
+func (s *Server) updateCoreVDC(w http.ResponseWriter, r *http.Request) {
+	var body coreVDCBody
+	if !readJSON(w, r, &body) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	v, ok := s.coreVDCOf(w, r)
+	if !ok {
+		return
+	}
+	if body.VDC.Name == "" {
+		body.VDC.Name = v.Name
+	}
+	setCoreVDC(v, body.VDC)
+
+	s.writeJob(w, "Update VDC "+v.Name)
+}
+
+// deleteCoreVDC deletes a VDC. As the real API, a VDC with edge gateways or
+// vApps cannot be deleted.
+func (s *Server) deleteCoreVDC(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	v, ok := s.coreVDCOf(w, r)
+	if !ok {
+		return
+	}
+	for _, e := range s.edges {
+		if e.gateway.OwnerRef != nil && e.gateway.OwnerRef.ID == urn("vdc", v.ID) {
+			writeError(w, r, http.StatusConflict, "CONFLICT", "The VDC "+v.Name+" has edge gateways.")
+			return
+		}
+	}
+	for _, a := range s.vapps {
+		if a.VDCID == v.ID {
+			writeError(w, r, http.StatusConflict, "CONFLICT", "The VDC "+v.Name+" has vApps.")
+			return
+		}
+	}
+	delete(s.vdcs, v.ID)
+
+	s.writeJob(w, "Delete VDC "+v.Name)
+}
+
+// coreEdgeOf returns the core API representation of an edge gateway.
+func coreEdgeOf(e *edgeGateway) coreEdge {
+	result := coreEdge{
+		ID:          uuidOf(e.gateway.ID),
+		Name:        e.gateway.Name,
+		OwnerType:   "vdc",
+		RateLimit:   e.rateLimit,
+		Description: e.gateway.Description,
+		Tier0VrfID:  e.tier0,
+	}
+	if e.gateway.OwnerRef != nil {
+		result.OwnerName = e.gateway.OwnerRef.Name
+	}
+	return result
+}
+
+func (s *Server) listCoreEdges(w http.ResponseWriter, _ *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	edges := make([]coreEdge, 0, len(s.edges))
+	for _, e := range s.sortedEdgeGateways() {
+		edges = append(edges, coreEdgeOf(e))
+	}
+
+	writeJSON(w, http.StatusOK, edges)
+}
+
+// createCoreEdge creates an edge gateway in a VDC. The edge gateways are
+// named after the organization, as on the real API.
+func (s *Server) createCoreEdge(w http.ResponseWriter, r *http.Request) {
+	var body coreEdgeCreate
+	if !readJSON(w, r, &body) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	v, ok := s.coreVDCOf(w, r)
+	if !ok {
+		return
+	}
+	if body.Tier0VrfID == "" {
+		body.Tier0VrfID = s.tier0Name()
+	}
+	if body.RateLimit == 0 {
+		body.RateLimit = 5
+	}
+
+	name := fmt.Sprintf("tn01e02%sedg%02d", s.config.Org, len(s.edges)+1)
+	s.newEdgeGateway(name, "", v, body.Tier0VrfID, body.RateLimit)
+
+	s.writeJob(w, "Create edge gateway "+name)
+}
+
+// coreEdgeOfRequest returns the edge gateway of the request, or writes the
+// error if it does not exist. The caller must hold the lock.
+func (s *Server) coreEdgeOfRequest(w http.ResponseWriter, r *http.Request) (*edgeGateway, bool) {
+	e, ok := s.edges[uuidOf(r.PathValue("id"))]
+	if !ok {
+		notFound(w, r, "Edge Gateway", r.PathValue("id"))
+	}
+	return e, ok
+}
+
+func (s *Server) getCoreEdge(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if e, ok := s.coreEdgeOfRequest(w, r); ok {
+		writeJSON(w, http.StatusOK, coreEdgeOf(e))
+	}
+}
+
+func (s *Server) updateCoreEdge(w http.ResponseWriter, r *http.Request) {
+	var body coreEdgeUpdate
+	if !readJSON(w, r, &body) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e, ok := s.coreEdgeOfRequest(w, r)
+	if !ok {
+		return
+	}
+	e.rateLimit = body.RateLimit
+
+	s.writeJob(w, "Update edge gateway "+e.gateway.Name)
+}
+
+func (s *Server) deleteCoreEdge(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e, ok := s.coreEdgeOfRequest(w, r)
+	if !ok {
+		return
+	}
+	delete(s.edges, uuidOf(e.gateway.ID))
+
+	s.writeJob(w, "Delete edge gateway "+e.gateway.Name)
+}
+
+func (s *Server) listTier0(w http.ResponseWriter, _ *http.Request) {
+	writeJSON(w, http.StatusOK, []coreTier0{{
+		Name:         s.tier0Name(),
+		Provider:     "pr01e02t0sp16",
+		ClassService: "VRF_STANDARD",
+		Services:     []string{},
+	}})
+}
@@ -0,0 +1,178 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package fakeapi
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"strings"
+
+	govcdtypes "github.com/vmware/go-vcloud-director/v2/types/v56"
+)
+
+// apiVersion is the version of the VMware Cloud Director API returned in the
+// content types.
+const apiVersion = "38.1"
+
+// writeXML writes an XML response.
+func writeXML(w http.ResponseWriter, status int, v any) {
+	data, err := xml.Marshal(v)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/*+xml;version="+apiVersion)
+	w.WriteHeader(status)
+	_, _ = w.Write([]byte(xml.Header))
+	_, _ = w.Write(data)
+}
+
+// writeJSON writes a JSON response.
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_, _ = w.Write(data)
+}
+
+// writeError writes an error in the format of the API of the request: JSON
+// for the OpenAPI and the core API, XML for the XML API.
+func writeError(w http.ResponseWriter, r *http.Request, status int, minorCode, message string) {
+	if minorCode == "" {
+		minorCode = strings.ToUpper(strings.ReplaceAll(http.StatusText(status), " ", "_"))
+	}
+
+	switch {
+	case strings.HasPrefix(r.URL.Path, "/cloudapi/"):
+		writeJSON(w, status, govcdtypes.OpenApiError{
+			MinorErrorCode: minorCode,
+			Message:        message,
+		})
+	case strings.HasPrefix(r.URL.Path, corePrefix), strings.HasPrefix(r.URL.Path, netbackupPrefix):
+		writeJSON(w, status, coreError{
+			Code:    minorCode,
+			Reason:  http.StatusText(status),
+			Message: message,
+		})
+	default:
+		writeXML(w, status, govcdtypes.Error{
+			Message:        message,
+			MajorErrorCode: status,
+			MinorErrorCode: minorCode,
+		})
+	}
+}
+
+// notFound writes the error of an entity not found. As the real API, the
+// OpenAPI answers 403 for the entities that do not exist.
+func notFound(w http.ResponseWriter, r *http.Request, kind, id string) {
+	message := fmt.Sprintf("[ %s ] %s %s not found.", r.Header.Get("X-Vmware-Vcloud-Client-Request-Id"), kind, id)
+	if strings.HasPrefix(r.URL.Path, "/cloudapi/") {
+		writeError(w, r, http.StatusForbidden, "ACCESS_TO_RESOURCE_IS_FORBIDDEN", message)
+		return
+	}
+	writeError(w, r, http.StatusNotFound, "NOT_FOUND", message)
+}
+
+// readXML decodes the XML body of a request. It writes the error and returns
+// false if the body is invalid.
+func readXML(w http.ResponseWriter, r *http.Request, v any) bool {
+	if err := xml.NewDecoder(r.Body).Decode(v); err != nil {
+		writeError(w, r, http.StatusBadRequest, "BAD_REQUEST", fmt.Sprintf("Invalid request body: %s", err))
+		return false
+	}
+	return true
+}
+
+// readJSON decodes the JSON body of a request. It writes the error and
+// returns false if the body is invalid.
+func readJSON(w http.ResponseWriter, r *http.Request, v any) bool {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		writeError(w, r, http.StatusBadRequest, "BAD_REQUEST", fmt.Sprintf("Invalid request body: %s", err))
+		return false
+	}
+	return true
+}
+
+// writePage writes the values as the single page of an OpenAPI collection,
+// filtered by the FIQL filter of the request.
+func writePage[T any](w http.ResponseWriter, r *http.Request, values []T) {
+	filtered := make([]T, 0, len(values))
+	for _, v := range values {
+		if matchFilter(r.URL.Query().Get("filter"), v) {
+			filtered = append(filtered, v)
+		}
+	}
+
+	data, err := json.Marshal(filtered)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, govcdtypes.OpenApiPages{
+		ResultTotal: len(filtered),
+		PageCount:   1,
+		Page:        1,
+		PageSize:    len(filtered),
+		Values:      data,
+	})
+}
+
+// matchFilter reports whether the JSON representation of the value matches
+// a FIQL filter. Only the conjunctions (;) of equalities (==) are supported,
+// the fields are dotted paths and the values are compared as strings.
+func matchFilter(filter string, value any) bool {
+	if filter == "" {
+		return true
+	}
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		return false
+	}
+	fields := map[string]any{}
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return false
+	}
+
+	for _, condition := range strings.Split(strings.Trim(filter, "()"), ";") {
+		name, want, ok := strings.Cut(condition, "==")
+		if !ok {
+			continue
+		}
+		if fmt.Sprint(lookupField(fields, name)) != want {
+			return false
+		}
+	}
+
+	return true
+}
+
+// lookupField returns the value of the field at the dotted path.
+func lookupField(fields map[string]any, path string) any {
+	var current any = fields
+	for _, name := range strings.Split(path, ".") {
+		object, ok := current.(map[string]any)
+		if !ok {
+			return nil
+		}
+		current = object[name]
+	}
+	return current
+}
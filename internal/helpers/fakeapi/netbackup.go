@@ -0,0 +1,113 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package fakeapi
+
+import (
+	"net/http"
+)
+
+const netbackupPrefix = "/netbackup"
+
+// netbackupProtectionLevels are the protection levels offered by NetBackup.
+var netbackupProtectionLevels = []string{"GOLD", "SILVER", "BRONZE"}
+
+type netbackupLogin struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+}
+
+type netbackupToken struct {
+	Token string `json:"token"`
+}
+
+type netbackupItem struct {
+	ID         int    `json:"id"`
+	Name       string `json:"name"`
+	Identifier string `json:"identifier"`
+}
+
+type netbackupProtectionLevel struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+type netbackupList[T any] struct {
+	Data []T `json:"data"`
+}
+
+func (s *Server) netbackupRoutes() {
+	s.Handle("POST "+netbackupPrefix+"/v6/auth/token", s.createNetbackupToken)
+	s.Handle("GET "+netbackupPrefix+"/v6/vcloud/vdcs", s.authenticated(s.listNetbackupVDCs))
+	s.Handle("GET "+netbackupPrefix+"/v6/vcloud/vapps", s.authenticated(s.listNetbackupVApps))
+	s.Handle("GET "+netbackupPrefix+"/v6/vcloud/vms", s.authenticated(s.listNetbackupVMs))
+	s.Handle("GET "+netbackupPrefix+"/v6/protection-levels", s.authenticated(s.listNetbackupProtectionLevels))
+}
+
+// createNetbackupToken opens a NetBackup session with the credentials of the
+// server.
+func (s *Server) createNetbackupToken(w http.ResponseWriter, r *http.Request) {
+	var body netbackupLogin
+	if !readJSON(w, r, &body) {
+		return
+	}
+	if !s.validCredentials(body.Username, body.Password) {
+		writeError(w, r, http.StatusUnauthorized, "UNAUTHORIZED", "Invalid credentials.")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, netbackupToken{Token: s.newToken()})
+}
+
+func (s *Server) listNetbackupVDCs(w http.ResponseWriter, _ *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	items := make([]netbackupItem, 0, len(s.vdcs))
+	for i, v := range s.sortedVDCs() {
+		items = append(items, netbackupItem{ID: i + 1, Name: v.Name, Identifier: urn("vdc", v.ID)})
+	}
+
+	writeJSON(w, http.StatusOK, netbackupList[netbackupItem]{Data: items})
+}
+
+func (s *Server) listNetbackupVApps(w http.ResponseWriter, _ *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	items := make([]netbackupItem, 0, len(s.vapps))
+	for i, a := range s.sortedVApps() {
+		items = append(items, netbackupItem{ID: i + 1, Name: a.Name, Identifier: urn("vapp", a.ID)})
+	}
+
+	writeJSON(w, http.StatusOK, netbackupList[netbackupItem]{Data: items})
+}
+
+func (s *Server) listNetbackupVMs(w http.ResponseWriter, _ *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	items := make([]netbackupItem, 0, len(s.vms))
+	for _, a := range s.sortedVApps() {
+		for _, v := range s.vappVMs(a.ID) {
+			items = append(items, netbackupItem{ID: len(items) + 1, Name: v.VM.Name, Identifier: v.VM.ID})
+		}
+	}
+
+	writeJSON(w, http.StatusOK, netbackupList[netbackupItem]{Data: items})
+}
+
+func (s *Server) listNetbackupProtectionLevels(w http.ResponseWriter, _ *http.Request) {
+	levels := make([]netbackupProtectionLevel, 0, len(netbackupProtectionLevels))
+	for i, name := range netbackupProtectionLevels {
+		levels = append(levels, netbackupProtectionLevel{ID: i + 1, Name: name})
+	}
+
+	writeJSON(w, http.StatusOK, netbackupList[netbackupProtectionLevel]{Data: levels})
+}
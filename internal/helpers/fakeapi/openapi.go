@@ -0,0 +1,441 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package fakeapi
+
+import (
+	"net/http"
+	"sort"
+
+	govcdtypes "github.com/vmware/go-vcloud-director/v2/types/v56"
+)
+
+const openAPIPrefix = "/cloudapi/1.0.0"
+
+// edgeGateway is an NSX-T edge gateway with its NAT and firewall rules.
+type edgeGateway struct {
+	gateway   govcdtypes.OpenAPIEdgeGateway
+	tier0     string
+	rateLimit int
+	natRules  []*govcdtypes.NsxtNatRule
+	firewall  []*govcdtypes.NsxtFirewallRule
+}
+
+// network is an Org VDC network.
+type network = govcdtypes.OpenApiOrgVdcNetwork
+
+func (s *Server) openAPIRoutes() {
+	s.Handle("GET "+openAPIPrefix+"/edgeGateways/{$}", s.authenticated(s.listEdgeGateways))
+	s.Handle("GET "+openAPIPrefix+"/edgeGateways/{id}", s.authenticated(s.getEdgeGateway))
+	s.Handle("PUT "+openAPIPrefix+"/edgeGateways/{id}", s.authenticated(s.updateEdgeGateway))
+	s.Handle("DELETE "+openAPIPrefix+"/edgeGateways/{id}", s.authenticated(s.deleteEdgeGateway))
+
+	s.Handle("GET "+openAPIPrefix+"/edgeGateways/{id}/nat/rules/{$}", s.authenticated(s.listNATRules))
+	s.Handle("POST "+openAPIPrefix+"/edgeGateways/{id}/nat/rules/{$}", s.authenticated(s.createNATRule))
+	s.Handle("GET "+openAPIPrefix+"/edgeGateways/{id}/nat/rules/{ruleId}", s.authenticated(s.getNATRule))
+	s.Handle("PUT "+openAPIPrefix+"/edgeGateways/{id}/nat/rules/{ruleId}", s.authenticated(s.updateNATRule))
+	s.Handle("DELETE "+openAPIPrefix+"/edgeGateways/{id}/nat/rules/{ruleId}", s.authenticated(s.deleteNATRule))
+
+	s.Handle("GET "+openAPIPrefix+"/edgeGateways/{id}/firewall/rules", s.authenticated(s.getFirewall))
+	s.Handle("PUT "+openAPIPrefix+"/edgeGateways/{id}/firewall/rules", s.authenticated(s.updateFirewall))
+	s.Handle("DELETE "+openAPIPrefix+"/edgeGateways/{id}/firewall/rules", s.authenticated(s.deleteFirewall))
+	s.Handle("DELETE "+openAPIPrefix+"/edgeGateways/{id}/firewall/rules/{ruleId}", s.authenticated(s.deleteFirewallRule))
+
+	s.Handle("GET "+openAPIPrefix+"/orgVdcNetworks/{$}", s.authenticated(s.listNetworks))
+	s.Handle("POST "+openAPIPrefix+"/orgVdcNetworks/{$}", s.authenticated(s.createNetwork))
+	s.Handle("GET "+openAPIPrefix+"/orgVdcNetworks/{id}", s.authenticated(s.getNetwork))
+	s.Handle("PUT "+openAPIPrefix+"/orgVdcNetworks/{id}", s.authenticated(s.updateNetwork))
+	s.Handle("DELETE "+openAPIPrefix+"/orgVdcNetworks/{id}", s.authenticated(s.deleteNetwork))
+}
+
+// orgRef returns the OpenAPI reference of the organization.
+func (s *Server) orgRef() *govcdtypes.OpenApiReference {
+	return &govcdtypes.OpenApiReference{Name: s.config.Org, ID: urn("org", s.orgID)}
+}
+
+// vdcRef returns the OpenAPI reference of a VDC.
+func vdcRef(v *vdc) *govcdtypes.OpenApiReference {
+	return &govcdtypes.OpenApiReference{Name: v.Name, ID: urn("vdc", v.ID)}
+}
+
+// newEdgeGateway records an edge gateway owned by a VDC. The caller must
+// hold the lock.
+func (s *Server) newEdgeGateway(name, description string, owner *vdc, tier0 string, rateLimit int) *edgeGateway {
+	id := newID()
+	e := &edgeGateway{
+		gateway: govcdtypes.OpenAPIEdgeGateway{
+			Status:      "REALIZED",
+			ID:          urn("gateway", id),
+			Name:        name,
+			Description: description,
+			OwnerRef:    vdcRef(owner),
+			OrgVdc:      vdcRef(owner),
+			Org:         s.orgRef(),
+			EdgeGatewayUplinks: []govcdtypes.EdgeGatewayUplinks{{
+				UplinkID:   urn("network", newID()),
+				UplinkName: tier0,
+				Connected:  true,
+			}},
+			GatewayBacking: &govcdtypes.OpenAPIEdgeGatewayBacking{
+				BackingID:   newID(),
+				GatewayType: "NSXT_BACKED",
+				NetworkProvider: govcdtypes.NetworkProvider{
+					Name: "nsxt",
+					ID:   urn("nsxtmanager", newID()),
+				},
+			},
+		},
+		tier0:     tier0,
+		rateLimit: rateLimit,
+	}
+	s.edges[id] = e
+	return e
+}
+
+// sortedEdgeGateways returns the edge gateways sorted by name. The caller
+// must hold the lock.
+func (s *Server) sortedEdgeGateways() []*edgeGateway {
+	edges := make([]*edgeGateway, 0, len(s.edges))
+	for _, e := range s.edges {
+		edges = append(edges, e)
+	}
+	sort.Slice(edges, func(i, j int) bool { return edges[i].gateway.Name < edges[j].gateway.Name })
+	return edges
+}
+
+// edgeGatewayOf returns the edge gateway of the request, or writes the error
+// if it does not exist. The caller must hold the lock.
+func (s *Server) edgeGatewayOf(w http.ResponseWriter, r *http.Request) (*edgeGateway, bool) {
+	e, ok := s.edges[uuidOf(r.PathValue("id"))]
+	if !ok {
+		notFound(w, r, "Edge Gateway", r.PathValue("id"))
+	}
+	return e, ok
+}
+
+func (s *Server) listEdgeGateways(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	gateways := make([]govcdtypes.OpenAPIEdgeGateway, 0, len(s.edges))
+	for _, e := range s.sortedEdgeGateways() {
+		gateways = append(gateways, e.gateway)
+	}
+	writePage(w, r, gateways)
+}
+
+func (s *Server) getEdgeGateway(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if e, ok := s.edgeGatewayOf(w, r); ok {
+		writeJSON(w, http.StatusOK, e.gateway)
+	}
+}
+
+// updateEdgeGateway updates the name and the description of an edge
+// gateway, the other fields are managed by the core API.
+func (s *Server) updateEdgeGateway(w http.ResponseWriter, r *http.Request) {
+	var body govcdtypes.OpenAPIEdgeGateway
+	if !readJSON(w, r, &body) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e, ok := s.edgeGatewayOf(w, r)
+	if !ok {
+		return
+	}
+	e.gateway.Name = body.Name
+	e.gateway.Description = body.Description
+
+	writeJSON(w, http.StatusOK, e.gateway)
+}
+
+func (s *Server) deleteEdgeGateway(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.edgeGatewayOf(w, r); !ok {
+		return
+	}
+	delete(s.edges, uuidOf(r.PathValue("id")))
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) listNATRules(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if e, ok := s.edgeGatewayOf(w, r); ok {
+		writePage(w, r, e.natRules)
+	}
+}
+
+// createNATRule creates a NAT rule. As the real API, the response is a task
+// without the ID of the rule.
+func (s *Server) createNATRule(w http.ResponseWriter, r *http.Request) {
+	rule := &govcdtypes.NsxtNatRule{}
+	if !readJSON(w, r, rule) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e, ok := s.edgeGatewayOf(w, r)
+	if !ok {
+		return
+	}
+	rule.ID = newID()
+	e.natRules = append(e.natRules, rule)
+
+	s.acceptTask(w, "Create NAT rule", nil)
+}
+
+// natRuleOf returns the index of the NAT rule of the request, or writes the
+// error if it does not exist. The caller must hold the lock.
+func (s *Server) natRuleOf(w http.ResponseWriter, r *http.Request) (*edgeGateway, int) {
+	e, ok := s.edgeGatewayOf(w, r)
+	if !ok {
+		return nil, -1
+	}
+	for i, rule := range e.natRules {
+		if rule.ID == r.PathValue("ruleId") {
+			return e, i
+		}
+	}
+	notFound(w, r, "NAT rule", r.PathValue("ruleId"))
+	return nil, -1
+}
+
+func (s *Server) getNATRule(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if e, i := s.natRuleOf(w, r); i >= 0 {
+		writeJSON(w, http.StatusOK, e.natRules[i])
+	}
+}
+
+func (s *Server) updateNATRule(w http.ResponseWriter, r *http.Request) {
+	rule := &govcdtypes.NsxtNatRule{}
+	if !readJSON(w, r, rule) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e, i := s.natRuleOf(w, r)
+	if i < 0 {
+		return
+	}
+	rule.ID = e.natRules[i].ID
+	e.natRules[i] = rule
+
+	writeJSON(w, http.StatusOK, rule)
+}
+
+func (s *Server) deleteNATRule(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e, i := s.natRuleOf(w, r)
+	if i < 0 {
+		return
+	}
+	e.natRules = append(e.natRules[:i], e.natRules[i+1:]...)
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// firewallRules returns the firewall rules of an edge gateway. The default
+// rule is always present, as on the real API.
+func firewallRules(e *edgeGateway) govcdtypes.NsxtFirewallRuleContainer {
+	return govcdtypes.NsxtFirewallRuleContainer{
+		SystemRules: []*govcdtypes.NsxtFirewallRule{},
+		DefaultRules: []*govcdtypes.NsxtFirewallRule{{
+			ID:          "default-rule",
+			Name:        "Default Rule",
+			ActionValue: "DROP",
+			Enabled:     true,
+			IpProtocol:  "IPV4_IPV6",
+			Direction:   "IN_OUT",
+		}},
+		UserDefinedRules: e.firewall,
+	}
+}
+
+func (s *Server) getFirewall(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if e, ok := s.edgeGatewayOf(w, r); ok {
+		writeJSON(w, http.StatusOK, firewallRules(e))
+	}
+}
+
+// updateFirewall replaces the user defined rules of an edge gateway. The
+// rules without ID are created.
+func (s *Server) updateFirewall(w http.ResponseWriter, r *http.Request) {
+	var body govcdtypes.NsxtFirewallRuleContainer
+	if !readJSON(w, r, &body) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e, ok := s.edgeGatewayOf(w, r)
+	if !ok {
+		return
+	}
+	for _, rule := range body.UserDefinedRules {
+		if rule.ID == "" {
+			rule.ID = newID()
+		}
+	}
+	e.firewall = body.UserDefinedRules
+
+	writeJSON(w, http.StatusOK, firewallRules(e))
+}
+
+func (s *Server) deleteFirewall(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e, ok := s.edgeGatewayOf(w, r)
+	if !ok {
+		return
+	}
+	e.firewall = nil
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) deleteFirewallRule(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e, ok := s.edgeGatewayOf(w, r)
+	if !ok {
+		return
+	}
+	for i, rule := range e.firewall {
+		if rule.ID == r.PathValue("ruleId") {
+			e.firewall = append(e.firewall[:i], e.firewall[i+1:]...)
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+	}
+
+	notFound(w, r, "Firewall rule", r.PathValue("ruleId"))
+}
+
+// networkOf returns the network of the request, or writes the error if it
+// does not exist. The caller must hold the lock.
+func (s *Server) networkOf(w http.ResponseWriter, r *http.Request) (*network, bool) {
+	n, ok := s.networks[uuidOf(r.PathValue("id"))]
+	if !ok {
+		notFound(w, r, "Org VDC network", r.PathValue("id"))
+	}
+	return n, ok
+}
+
+func (s *Server) listNetworks(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	networks := make([]*network, 0, len(s.networks))
+	for _, n := range s.networks {
+		networks = append(networks, n)
+	}
+	sort.Slice(networks, func(i, j int) bool { return networks[i].Name < networks[j].Name })
+
+	writePage(w, r, networks)
+}
+
+// createNetwork creates an Org VDC network. The owner is either a VDC or,
+// for a routed network, the VDC of the edge gateway.
+func (s *Server) createNetwork(w http.ResponseWriter, r *http.Request) {
+	n := &network{}
+	if !readJSON(w, r, n) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if n.OwnerRef == nil && n.Connection != nil {
+		if e, ok := s.edges[uuidOf(n.Connection.RouterRef.ID)]; ok {
+			n.OwnerRef = e.gateway.OwnerRef
+		}
+	}
+	if n.OwnerRef == nil {
+		writeError(w, r, http.StatusBadRequest, "BAD_REQUEST", "The owner of the network is required.")
+		return
+	}
+
+	id := newID()
+	n.ID = urn("network", id)
+	n.Status = "REALIZED"
+	n.OrgVdcIsNsxTBacked = true
+	s.networks[id] = n
+
+	writeJSON(w, http.StatusCreated, n)
+}
+
+func (s *Server) getNetwork(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if n, ok := s.networkOf(w, r); ok {
+		writeJSON(w, http.StatusOK, n)
+	}
+}
+
+func (s *Server) updateNetwork(w http.ResponseWriter, r *http.Request) {
+	body := &network{}
+	if !readJSON(w, r, body) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	n, ok := s.networkOf(w, r)
+	if !ok {
+		return
+	}
+	body.ID = n.ID
+	body.Status = n.Status
+	body.OrgVdcIsNsxTBacked = true
+	if body.OwnerRef == nil {
+		body.OwnerRef = n.OwnerRef
+	}
+	s.networks[uuidOf(n.ID)] = body
+
+	writeJSON(w, http.StatusOK, body)
+}
+
+func (s *Server) deleteNetwork(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.networkOf(w, r); !ok {
+		return
+	}
+	delete(s.networks, uuidOf(r.PathValue("id")))
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,231 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package fakeapi
+
+import (
+	"encoding/xml"
+	"io"
+	"net/http"
+	"sort"
+	"time"
+)
+
+const s3Prefix = "/s3"
+
+// bucket is an S3 bucket. The objects are not stored.
+type bucket struct {
+	Name       string
+	Created    time.Time
+	ObjectLock bool
+	Versioning string
+	Tags       []s3Tag
+}
+
+type s3Error struct {
+	XMLName xml.Name `xml:"Error"`
+	Code    string   `xml:"Code"`
+	Message string   `xml:"Message"`
+}
+
+type s3Bucket struct {
+	Name         string    `xml:"Name"`
+	CreationDate time.Time `xml:"CreationDate"`
+}
+
+type s3ListBuckets struct {
+	XMLName xml.Name   `xml:"ListAllMyBucketsResult"`
+	Buckets []s3Bucket `xml:"Buckets>Bucket"`
+}
+
+type s3ListObjects struct {
+	XMLName     xml.Name `xml:"ListBucketResult"`
+	Name        string   `xml:"Name"`
+	IsTruncated bool     `xml:"IsTruncated"`
+}
+
+type s3Versioning struct {
+	XMLName xml.Name `xml:"VersioningConfiguration"`
+	Status  string   `xml:"Status,omitempty"`
+}
+
+type s3ObjectLock struct {
+	XMLName           xml.Name `xml:"ObjectLockConfiguration"`
+	ObjectLockEnabled string   `xml:"ObjectLockEnabled,omitempty"`
+}
+
+type s3Tag struct {
+	Key   string `xml:"Key"`
+	Value string `xml:"Value"`
+}
+
+type s3Tagging struct {
+	XMLName xml.Name `xml:"Tagging"`
+	Tags    []s3Tag  `xml:"TagSet>Tag"`
+}
+
+func (s *Server) s3Routes() {
+	s.Handle("GET "+s3Prefix+"/{$}", s.listBuckets)
+	s.Handle("PUT "+s3Prefix+"/{bucket}", s.putBucket)
+	s.Handle("HEAD "+s3Prefix+"/{bucket}", s.headBucket)
+	s.Handle("GET "+s3Prefix+"/{bucket}", s.getBucket)
+	s.Handle("DELETE "+s3Prefix+"/{bucket}", s.deleteBucket)
+}
+
+// writeS3Error writes an error of the S3 API.
+func writeS3Error(w http.ResponseWriter, status int, code, message string) {
+	writeXML(w, status, s3Error{Code: code, Message: message})
+}
+
+// bucketOf returns the bucket of the request, or writes the error if it does
+// not exist. The caller must hold the lock.
+func (s *Server) bucketOf(w http.ResponseWriter, r *http.Request) (*bucket, bool) {
+	b, ok := s.buckets[r.PathValue("bucket")]
+	if !ok {
+		writeS3Error(w, http.StatusNotFound, "NoSuchBucket", "The specified bucket does not exist")
+	}
+	return b, ok
+}
+
+func (s *Server) listBuckets(w http.ResponseWriter, _ *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	result := s3ListBuckets{Buckets: make([]s3Bucket, 0, len(s.buckets))}
+	for _, b := range s.buckets {
+		result.Buckets = append(result.Buckets, s3Bucket{Name: b.Name, CreationDate: b.Created})
+	}
+	sort.Slice(result.Buckets, func(i, j int) bool { return result.Buckets[i].Name < result.Buckets[j].Name })
+
+	writeXML(w, http.StatusOK, result)
+}
+
+// putBucket creates a bucket, or sets its versioning, object lock or tags
+// configuration.
+func (s *Server) putBucket(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !query.Has("versioning") && !query.Has("object-lock") && !query.Has("tagging") {
+		name := r.PathValue("bucket")
+		if _, exists := s.buckets[name]; exists {
+			writeS3Error(w, http.StatusConflict, "BucketAlreadyOwnedByYou", "Your previous request to create the named bucket succeeded and you already own it.")
+			return
+		}
+		_, _ = io.Copy(io.Discard, r.Body)
+		s.buckets[name] = &bucket{
+			Name:       name,
+			Created:    time.Now().UTC().Truncate(time.Second),
+			ObjectLock: r.Header.Get("X-Amz-Bucket-Object-Lock-Enabled") == "true",
+		}
+		w.Header().Set("Location", "/"+name)
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	b, ok := s.bucketOf(w, r)
+	if !ok {
+		return
+	}
+
+	switch {
+	case query.Has("versioning"):
+		var body s3Versioning
+		if err := xml.NewDecoder(r.Body).Decode(&body); err != nil {
+			writeS3Error(w, http.StatusBadRequest, "MalformedXML", err.Error())
+			return
+		}
+		b.Versioning = body.Status
+	case query.Has("object-lock"):
+		var body s3ObjectLock
+		if err := xml.NewDecoder(r.Body).Decode(&body); err != nil {
+			writeS3Error(w, http.StatusBadRequest, "MalformedXML", err.Error())
+			return
+		}
+		b.ObjectLock = body.ObjectLockEnabled == "Enabled"
+	case query.Has("tagging"):
+		var body s3Tagging
+		if err := xml.NewDecoder(r.Body).Decode(&body); err != nil {
+			writeS3Error(w, http.StatusBadRequest, "MalformedXML", err.Error())
+			return
+		}
+		b.Tags = body.Tags
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *Server) headBucket(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.buckets[r.PathValue("bucket")]; !ok {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// getBucket lists the objects of a bucket, or returns its versioning, object
+// lock or tags configuration.
+func (s *Server) getBucket(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	b, ok := s.bucketOf(w, r)
+	if !ok {
+		return
+	}
+
+	switch {
+	case query.Has("versioning"):
+		writeXML(w, http.StatusOK, s3Versioning{Status: b.Versioning})
+	case query.Has("object-lock"):
+		if !b.ObjectLock {
+			writeS3Error(w, http.StatusNotFound, "ObjectLockConfigurationNotFoundError", "Object Lock configuration does not exist for this bucket")
+			return
+		}
+		writeXML(w, http.StatusOK, s3ObjectLock{ObjectLockEnabled: "Enabled"})
+	case query.Has("tagging"):
+		if len(b.Tags) == 0 {
+			writeS3Error(w, http.StatusNotFound, "NoSuchTagSet", "The TagSet does not exist")
+			return
+		}
+		writeXML(w, http.StatusOK, s3Tagging{Tags: b.Tags})
+	case query.Has("location"):
+		writeXML(w, http.StatusOK, struct {
+			XMLName xml.Name `xml:"LocationConstraint"`
+		}{})
+	default:
+		writeXML(w, http.StatusOK, s3ListObjects{Name: b.Name})
+	}
+}
+
+func (s *Server) deleteBucket(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	b, ok := s.bucketOf(w, r)
+	if !ok {
+		return
+	}
+
+	if r.URL.Query().Has("tagging") {
+		b.Tags = nil
+	} else {
+		delete(s.buckets, b.Name)
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,239 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+// Package fakeapi provides an in-process fake of the Cloud Avenue APIs, to
+// run the tests of the provider without network access.
+//
+// The server implements a stateful subset of:
+//   - the VMware Cloud Director XML API (/api) and OpenAPI (/cloudapi),
+//   - the Cloud Avenue core API (/api/customers),
+//   - the S3 API (/s3),
+//   - the NetBackup API (/netbackup).
+//
+// The APIs share the same entities: a VDC created with the core API is
+// returned by the VMware Cloud Director API, an edge gateway created with the
+// core API has its NAT and firewall rules managed with the OpenAPI, etc. The
+// asynchronous operations (tasks and jobs) are completed immediately.
+//
+// The server only serves HTTPS. Env returns the environment variables
+// pointing the provider to the server, including CLOUDAVENUE_CA_FILE to
+// trust its certificate.
+package fakeapi
+
+import (
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/google/uuid"
+)
+
+// Default credentials of the server.
+const (
+	DefaultOrg      = "cav01ev01ocb0001234"
+	DefaultUsername = "fakeapi"
+	DefaultPassword = "fakeapi"
+)
+
+// Config is the configuration of the server. The empty fields are set to
+// their default value.
+type Config struct {
+	// Org is the name of the organization.
+	Org string
+	// Username and Password are the credentials accepted by the server, for
+	// the Cloud Avenue APIs and NetBackup.
+	Username string
+	Password string
+}
+
+// Server is the fake Cloud Avenue API server.
+type Server struct {
+	*httptest.Server
+
+	config   Config
+	mux      *http.ServeMux
+	certFile string
+
+	mu     sync.Mutex
+	tokens map[string]bool
+	orgID  string
+
+	tasks    map[string]*task
+	vdcs     map[string]*vdc
+	edges    map[string]*edgeGateway
+	networks map[string]*network
+	vapps    map[string]*vapp
+	vms      map[string]*vm
+	jobs     map[string]*job
+	buckets  map[string]*bucket
+}
+
+// New starts a server. The server must be closed with Close.
+func New(config Config) (*Server, error) {
+	if config.Org == "" {
+		config.Org = DefaultOrg
+	}
+	if config.Username == "" {
+		config.Username = DefaultUsername
+	}
+	if config.Password == "" {
+		config.Password = DefaultPassword
+	}
+
+	s := &Server{
+		config:   config,
+		mux:      http.NewServeMux(),
+		tokens:   make(map[string]bool),
+		orgID:    uuid.NewString(),
+		tasks:    make(map[string]*task),
+		vdcs:     make(map[string]*vdc),
+		edges:    make(map[string]*edgeGateway),
+		networks: make(map[string]*network),
+		vapps:    make(map[string]*vapp),
+		vms:      make(map[string]*vm),
+		jobs:     make(map[string]*job),
+		buckets:  make(map[string]*bucket),
+	}
+
+	s.vcdRoutes()
+	s.openAPIRoutes()
+	s.vappRoutes()
+	s.coreRoutes()
+	s.s3Routes()
+	s.netbackupRoutes()
+
+	s.Server = httptest.NewTLSServer(s.mux)
+
+	if err := s.writeCertFile(); err != nil {
+		s.Server.Close()
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// Close shuts down the server and removes its certificate file.
+func (s *Server) Close() {
+	s.Server.Close()
+	os.RemoveAll(filepath.Dir(s.certFile))
+}
+
+// Handle registers the handler of a route, with the pattern syntax of
+// http.ServeMux. It adds the routes missing from the server.
+func (s *Server) Handle(pattern string, handler http.HandlerFunc) {
+	s.mux.HandleFunc(pattern, handler)
+}
+
+// Env returns the environment variables configuring the provider to use the
+// server.
+func (s *Server) Env() map[string]string {
+	return map[string]string{
+		"CLOUDAVENUE_URL":      s.URL,
+		"CLOUDAVENUE_CORE_API": s.URL,
+		"CLOUDAVENUE_ORG":      s.config.Org,
+		"CLOUDAVENUE_USERNAME": s.config.Username,
+		"CLOUDAVENUE_PASSWORD": s.config.Password,
+		"NETBACKUP_URL":        s.URL + netbackupPrefix,
+		"NETBACKUP_USERNAME":   s.config.Username,
+		"NETBACKUP_PASSWORD":   s.config.Password,
+		"CLOUDAVENUE_S3_URL":   s.S3URL(),
+		"CLOUDAVENUE_CA_FILE":  s.certFile,
+	}
+}
+
+// S3URL returns the endpoint of the S3 API, in path style.
+func (s *Server) S3URL() string {
+	return s.URL + s3Prefix
+}
+
+// writeCertFile writes the certificate of the server to a PEM file, alone
+// in its directory so that the directory can be used as SSL_CERT_DIR.
+func (s *Server) writeCertFile() error {
+	dir, err := os.MkdirTemp("", "fakeapi-")
+	if err != nil {
+		return fmt.Errorf("error creating the certificate directory: %w", err)
+	}
+
+	s.certFile = filepath.Join(dir, "fakeapi.pem")
+	if err := os.WriteFile(s.certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: s.Certificate().Raw}), 0o600); err != nil {
+		os.RemoveAll(dir)
+		return fmt.Errorf("error writing the certificate file: %w", err)
+	}
+
+	return nil
+}
+
+// newToken returns a new session token.
+func (s *Server) newToken() string {
+	token := uuid.NewString()
+
+	s.mu.Lock()
+	s.tokens[token] = true
+	s.mu.Unlock()
+
+	return token
+}
+
+// authenticated rejects the requests without a session token issued by the
+// server.
+func (s *Server) authenticated(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		token := r.Header.Get("X-Vcloud-Authorization")
+		if access := r.Header.Get("X-Vmware-Vcloud-Access-Token"); access != "" {
+			token = access
+		}
+		if bearer, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer "); ok {
+			token = bearer
+		}
+
+		s.mu.Lock()
+		valid := s.tokens[token]
+		s.mu.Unlock()
+
+		if !valid {
+			writeError(w, r, http.StatusUnauthorized, "", "This operation is denied.")
+			return
+		}
+
+		next(w, r)
+	}
+}
+
+// validCredentials reports whether the credentials match the configuration
+// of the server. The username may be qualified with the organization.
+func (s *Server) validCredentials(username, password string) bool {
+	username = strings.TrimSuffix(username, "@"+s.config.Org)
+	return username == s.config.Username && password == s.config.Password
+}
+
+// newID returns a new entity UUID.
+func newID() string {
+	return uuid.NewString()
+}
+
+// urn returns the URN of an entity.
+func urn(kind, id string) string {
+	return "urn:vcloud:" + kind + ":" + id
+}
+
+// uuidOf returns the UUID of an entity from its URN, HREF or UUID.
+func uuidOf(ref string) string {
+	if i := strings.LastIndexAny(ref, ":/"); i >= 0 {
+		ref = ref[i+1:]
+	}
+	for _, prefix := range []string{"vapp-", "vm-"} {
+		ref = strings.TrimPrefix(ref, prefix)
+	}
+	return ref
+}
@@ -0,0 +1,363 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package fakeapi_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+	govcdtypes "github.com/vmware/go-vcloud-director/v2/types/v56"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/helpers/fakeapi"
+)
+
+// newServer starts a server and returns it with an authenticated VMware
+// Cloud Director client.
+func newServer(t *testing.T) (*fakeapi.Server, *govcd.VCDClient) {
+	t.Helper()
+
+	s, err := fakeapi.New(fakeapi.Config{})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	t.Cleanup(s.Close)
+
+	u, err := url.Parse(s.URL + "/api")
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := govcd.NewVCDClient(*u, true)
+	if err := client.Authenticate(fakeapi.DefaultUsername, fakeapi.DefaultPassword, fakeapi.DefaultOrg); err != nil {
+		t.Fatalf("Authenticate() error = %v", err)
+	}
+
+	return s, client
+}
+
+// coreRequest sends a request to the core API and decodes the response.
+func coreRequest(t *testing.T, s *fakeapi.Server, client *govcd.VCDClient, method, path string, body, out any) {
+	t.Helper()
+
+	var reader bytes.Buffer
+	if body != nil {
+		if err := json.NewEncoder(&reader).Encode(body); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	req, err := http.NewRequest(method, s.URL+path, &reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Authorization", "Bearer "+client.Client.VCDToken)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := s.Client().Do(req)
+	if err != nil {
+		t.Fatalf("%s %s error = %v", method, path, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		t.Fatalf("%s %s status = %d", method, path, resp.StatusCode)
+	}
+	if out != nil {
+		if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+			t.Fatalf("%s %s decoding error = %v", method, path, err)
+		}
+	}
+}
+
+// createVDC creates a VDC and an edge gateway with the core API.
+func createVDC(t *testing.T, s *fakeapi.Server, client *govcd.VCDClient, name string) {
+	t.Helper()
+
+	var job struct {
+		JobID string `json:"jobId"`
+	}
+	coreRequest(t, s, client, http.MethodPost, "/api/customers/v2.0/vdcs", map[string]any{
+		"vdc": map[string]any{
+			"name":                   name,
+			"vdcServiceClass":        "STD",
+			"vdcDisponibilityClass":  "ONE-ROOM",
+			"vdcBillingModel":        "PAYG",
+			"vcpuInMhz2":             2200,
+			"cpuAllocated":           22000,
+			"memoryAllocated":        30,
+			"vdcStorageBillingModel": "PAYG",
+			"vdcStorageProfiles": []map[string]any{
+				{"class": "gold", "limit": 500, "default": true},
+			},
+		},
+	}, &job)
+
+	var status []struct {
+		Status string `json:"status"`
+	}
+	coreRequest(t, s, client, http.MethodGet, "/api/customers/v1.0/jobs/"+job.JobID, nil, &status)
+	if len(status) != 1 || status[0].Status != "DONE" {
+		t.Fatalf("job status = %v, want DONE", status)
+	}
+
+	coreRequest(t, s, client, http.MethodPost, "/api/customers/v2.0/vdcs/"+name+"/edges", map[string]any{}, nil)
+}
+
+func TestAuthentication(t *testing.T) {
+	s, err := fakeapi.New(fakeapi.Config{})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	defer s.Close()
+
+	u, _ := url.Parse(s.URL + "/api")
+	client := govcd.NewVCDClient(*u, true)
+	if err := client.Authenticate("invalid", "invalid", fakeapi.DefaultOrg); err == nil {
+		t.Error("Authenticate() with invalid credentials succeeded")
+	}
+
+	resp, err := s.Client().Get(s.URL + "/api/customers/v2.0/vdcs")
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusUnauthorized {
+		t.Errorf("status without token = %d, want %d", resp.StatusCode, http.StatusUnauthorized)
+	}
+}
+
+func TestVDCAndEdgeGateway(t *testing.T) {
+	s, client := newServer(t)
+	createVDC(t, s, client, "vdc01")
+
+	org, err := client.GetOrgByName(fakeapi.DefaultOrg)
+	if err != nil {
+		t.Fatalf("GetOrgByName() error = %v", err)
+	}
+	vdc, err := org.GetVDCByName("vdc01", false)
+	if err != nil {
+		t.Fatalf("GetVDCByName() error = %v", err)
+	}
+	if len(vdc.Vdc.VdcStorageProfiles.VdcStorageProfile) != 1 {
+		t.Errorf("storage profiles = %d, want 1", len(vdc.Vdc.VdcStorageProfiles.VdcStorageProfile))
+	}
+
+	edges, err := vdc.GetAllNsxtEdgeGateways(nil)
+	if err != nil {
+		t.Fatalf("GetAllNsxtEdgeGateways() error = %v", err)
+	}
+	if len(edges) != 1 {
+		t.Fatalf("edge gateways = %d, want 1", len(edges))
+	}
+	edge, err := vdc.GetNsxtEdgeGatewayById(edges[0].EdgeGateway.ID)
+	if err != nil {
+		t.Fatalf("GetNsxtEdgeGatewayById() error = %v", err)
+	}
+
+	// NAT rules.
+	rule, err := edge.CreateNatRule(&govcdtypes.NsxtNatRule{
+		Name:              "dnat",
+		Enabled:           true,
+		RuleType:          "DNAT",
+		ExternalAddresses: "203.0.113.10",
+		InternalAddresses: "192.168.0.10",
+	})
+	if err != nil {
+		t.Fatalf("CreateNatRule() error = %v", err)
+	}
+	if _, err := edge.GetNatRuleByName("dnat"); err != nil {
+		t.Errorf("GetNatRuleByName() error = %v", err)
+	}
+	if err := rule.Delete(); err != nil {
+		t.Errorf("Delete() NAT rule error = %v", err)
+	}
+	if _, err := edge.GetNatRuleById(rule.NsxtNatRule.ID); !govcd.ContainsNotFound(err) {
+		t.Errorf("GetNatRuleById() after delete error = %v, want not found", err)
+	}
+
+	// Firewall rules.
+	if _, err := edge.UpdateNsxtFirewall(&govcdtypes.NsxtFirewallRuleContainer{
+		UserDefinedRules: []*govcdtypes.NsxtFirewallRule{{
+			Name:        "allow",
+			ActionValue: "ALLOW",
+			Enabled:     true,
+			IpProtocol:  "IPV4",
+			Direction:   "IN_OUT",
+		}},
+	}); err != nil {
+		t.Fatalf("UpdateNsxtFirewall() error = %v", err)
+	}
+	firewall, err := edge.GetNsxtFirewall()
+	if err != nil {
+		t.Fatalf("GetNsxtFirewall() error = %v", err)
+	}
+	if len(firewall.NsxtFirewallRuleContainer.UserDefinedRules) != 1 || firewall.NsxtFirewallRuleContainer.UserDefinedRules[0].ID == "" {
+		t.Errorf("user defined rules = %v, want one rule with an ID", firewall.NsxtFirewallRuleContainer.UserDefinedRules)
+	}
+
+	// Networks.
+	network, err := vdc.CreateOpenApiOrgVdcNetwork(&govcdtypes.OpenApiOrgVdcNetwork{
+		Name:       "net01",
+		Connection: &govcdtypes.Connection{RouterRef: govcdtypes.OpenApiReference{ID: edge.EdgeGateway.ID}},
+		Subnets: govcdtypes.OrgVdcNetworkSubnets{Values: []govcdtypes.OrgVdcNetworkSubnetValues{{
+			Gateway:      "192.168.0.1",
+			PrefixLength: 24,
+		}}},
+	})
+	if err != nil {
+		t.Fatalf("CreateOpenApiOrgVdcNetwork() error = %v", err)
+	}
+	if network.OpenApiOrgVdcNetwork.OwnerRef == nil || network.OpenApiOrgVdcNetwork.OwnerRef.ID != vdc.Vdc.ID {
+		t.Errorf("network owner = %v, want %s", network.OpenApiOrgVdcNetwork.OwnerRef, vdc.Vdc.ID)
+	}
+	if err := network.Delete(); err != nil {
+		t.Errorf("Delete() network error = %v", err)
+	}
+
+	// The edge gateway deleted with the core API is removed from the OpenAPI.
+	coreRequest(t, s, client, http.MethodDelete, "/api/customers/v2.0/edges/"+edge.EdgeGateway.ID, nil, nil)
+	if _, err := vdc.GetNsxtEdgeGatewayById(edge.EdgeGateway.ID); !govcd.ContainsNotFound(err) {
+		t.Errorf("GetNsxtEdgeGatewayById() after delete error = %v, want not found", err)
+	}
+}
+
+func TestVAppAndVM(t *testing.T) {
+	s, client := newServer(t)
+	createVDC(t, s, client, "vdc01")
+
+	org, err := client.GetOrgByName(fakeapi.DefaultOrg)
+	if err != nil {
+		t.Fatalf("GetOrgByName() error = %v", err)
+	}
+	vdc, err := org.GetVDCByName("vdc01", false)
+	if err != nil {
+		t.Fatalf("GetVDCByName() error = %v", err)
+	}
+
+	vapp, err := vdc.CreateRawVApp("vapp01", "description")
+	if err != nil {
+		t.Fatalf("CreateRawVApp() error = %v", err)
+	}
+	if _, err := vdc.GetVAppByName("vapp01", true); err != nil {
+		t.Fatalf("GetVAppByName() error = %v", err)
+	}
+
+	cpus := 2
+	vm, err := vapp.AddEmptyVm(&govcdtypes.RecomposeVAppParamsForEmptyVm{
+		CreateItem: &govcdtypes.CreateItem{
+			Name: "vm01",
+			VmSpecSection: &govcdtypes.VmSpecSection{
+				OsType:           "debian10_64Guest",
+				NumCpus:          &cpus,
+				MemoryResourceMb: &govcdtypes.MemoryResourceMb{Configured: 2048},
+				HardwareVersion:  &govcdtypes.HardwareVersion{Value: "vmx-19"},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("AddEmptyVm() error = %v", err)
+	}
+	if got := *vm.VM.VmSpecSection.NumCpus; got != cpus {
+		t.Errorf("NumCpus = %d, want %d", got, cpus)
+	}
+
+	task, err := vm.PowerOn()
+	if err != nil {
+		t.Fatalf("PowerOn() error = %v", err)
+	}
+	if err := task.WaitTaskCompletion(); err != nil {
+		t.Fatalf("WaitTaskCompletion() error = %v", err)
+	}
+	status, err := vm.GetStatus()
+	if err != nil {
+		t.Fatalf("GetStatus() error = %v", err)
+	}
+	if status != "POWERED_ON" {
+		t.Errorf("status = %s, want POWERED_ON", status)
+	}
+
+	if err := vapp.RemoveVM(*vm); err != nil {
+		t.Fatalf("RemoveVM() error = %v", err)
+	}
+	if _, err := vapp.GetVMByName("vm01", true); !govcd.ContainsNotFound(err) {
+		t.Errorf("GetVMByName() after remove error = %v, want not found", err)
+	}
+
+	task, err = vapp.Delete()
+	if err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	if err := task.WaitTaskCompletion(); err != nil {
+		t.Fatalf("WaitTaskCompletion() error = %v", err)
+	}
+	if _, err := vdc.GetVAppByName("vapp01", true); !govcd.ContainsNotFound(err) {
+		t.Errorf("GetVAppByName() after delete error = %v, want not found", err)
+	}
+}
+
+func TestS3(t *testing.T) {
+	// The CA bundle of the environment would replace the trusted
+	// certificates of the client of the server.
+	t.Setenv("AWS_CA_BUNDLE", "")
+
+	s, err := fakeapi.New(fakeapi.Config{})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	defer s.Close()
+
+	client := s3.New(session.Must(session.NewSession(&aws.Config{
+		Endpoint:         aws.String(s.S3URL()),
+		Region:           aws.String("region01"),
+		S3ForcePathStyle: aws.Bool(true),
+		HTTPClient:       s.Client(),
+		Credentials:      credentials.NewStaticCredentials("access", "secret", ""),
+	})))
+
+	if _, err := client.CreateBucket(&s3.CreateBucketInput{
+		Bucket:                     aws.String("bucket01"),
+		ObjectLockEnabledForBucket: aws.Bool(true),
+	}); err != nil {
+		t.Fatalf("CreateBucket() error = %v", err)
+	}
+	if _, err := client.HeadBucket(&s3.HeadBucketInput{Bucket: aws.String("bucket01")}); err != nil {
+		t.Errorf("HeadBucket() error = %v", err)
+	}
+
+	lock, err := client.GetObjectLockConfiguration(&s3.GetObjectLockConfigurationInput{Bucket: aws.String("bucket01")})
+	if err != nil {
+		t.Fatalf("GetObjectLockConfiguration() error = %v", err)
+	}
+	if aws.StringValue(lock.ObjectLockConfiguration.ObjectLockEnabled) != "Enabled" {
+		t.Errorf("ObjectLockEnabled = %s, want Enabled", aws.StringValue(lock.ObjectLockConfiguration.ObjectLockEnabled))
+	}
+
+	list, err := client.ListBuckets(&s3.ListBucketsInput{})
+	if err != nil {
+		t.Fatalf("ListBuckets() error = %v", err)
+	}
+	if len(list.Buckets) != 1 {
+		t.Errorf("buckets = %d, want 1", len(list.Buckets))
+	}
+
+	if _, err := client.DeleteBucket(&s3.DeleteBucketInput{Bucket: aws.String("bucket01")}); err != nil {
+		t.Fatalf("DeleteBucket() error = %v", err)
+	}
+	if _, err := client.HeadBucket(&s3.HeadBucketInput{Bucket: aws.String("bucket01")}); err == nil {
+		t.Error("HeadBucket() after delete succeeded")
+	}
+}
@@ -0,0 +1,567 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package fakeapi
+
+import (
+	"encoding/xml"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	govcdtypes "github.com/vmware/go-vcloud-director/v2/types/v56"
+)
+
+// Status of the vApps and VMs.
+const (
+	statusSuspended  = 3
+	statusPoweredOn  = 4
+	statusPoweredOff = 8
+)
+
+// vapp is a vApp of a VDC.
+type vapp struct {
+	ID          string
+	Name        string
+	Description string
+	VDCID       string
+	Created     string
+}
+
+// vm is a VM of a vApp.
+type vm struct {
+	VAppID string
+	VM     govcdtypes.Vm
+}
+
+// recomposeParams are the parameters of the recomposition of a vApp. It
+// merges the parameters used to create a VM from a template or an empty VM
+// and to delete a VM.
+type recomposeParams struct {
+	XMLName     xml.Name                                  `xml:"RecomposeVAppParams"`
+	PowerOn     bool                                      `xml:"powerOn,attr"`
+	CreateItem  *govcdtypes.CreateItem                    `xml:"CreateItem"`
+	SourcedItem []*govcdtypes.SourcedCompositionItemParam `xml:"SourcedItem"`
+	DeleteItem  []*govcdtypes.DeleteItem                  `xml:"DeleteItem"`
+}
+
+func (s *Server) vappRoutes() {
+	s.Handle("POST /api/vdc/{id}/action/composeVApp", s.authenticated(s.composeVApp))
+	s.Handle("GET /api/vApp/{id}", s.authenticated(s.getVAppOrVM))
+	s.Handle("DELETE /api/vApp/{id}", s.authenticated(s.deleteVAppOrVM))
+	s.Handle("POST /api/vApp/{id}/power/action/{action}", s.authenticated(s.powerAction))
+	s.Handle("POST /api/vApp/{id}/action/recomposeVApp", s.authenticated(s.recomposeVApp))
+	s.Handle("POST /api/vApp/{id}/action/reconfigureVm", s.authenticated(s.reconfigureVM))
+	s.Handle("POST /api/vApp/{id}/action/{action}", s.authenticated(s.powerAction))
+	s.Handle("PUT /api/vApp/{id}/networkConnectionSection/{$}", s.authenticated(s.updateVMSection))
+	s.Handle("PUT /api/vApp/{id}/guestCustomizationSection/{$}", s.authenticated(s.updateVMSection))
+}
+
+func (s *Server) vappHREF(id string) string {
+	return s.URL + "/api/vApp/vapp-" + id
+}
+
+func (s *Server) vmHREF(id string) string {
+	return s.URL + "/api/vApp/vm-" + id
+}
+
+// sortedVApps returns the vApps sorted by name. The caller must hold the
+// lock.
+func (s *Server) sortedVApps() []*vapp {
+	vapps := make([]*vapp, 0, len(s.vapps))
+	for _, a := range s.vapps {
+		vapps = append(vapps, a)
+	}
+	sort.Slice(vapps, func(i, j int) bool { return vapps[i].Name < vapps[j].Name })
+	return vapps
+}
+
+// vappVMs returns the VMs of a vApp sorted by name. The caller must hold the
+// lock.
+func (s *Server) vappVMs(vappID string) []*vm {
+	vms := make([]*vm, 0)
+	for _, v := range s.vms {
+		if v.VAppID == vappID {
+			vms = append(vms, v)
+		}
+	}
+	sort.Slice(vms, func(i, j int) bool { return vms[i].VM.Name < vms[j].VM.Name })
+	return vms
+}
+
+// vappStatus returns the status of a vApp from the status of its VMs. The
+// caller must hold the lock.
+func (s *Server) vappStatus(vappID string) int {
+	for _, v := range s.vappVMs(vappID) {
+		if v.VM.Status == statusPoweredOn {
+			return statusPoweredOn
+		}
+	}
+	return statusPoweredOff
+}
+
+// vcdVApp returns the VMware Cloud Director representation of a vApp. The
+// caller must hold the lock.
+func (s *Server) vcdVApp(a *vapp) *govcdtypes.VApp {
+	result := &govcdtypes.VApp{
+		HREF:        s.vappHREF(a.ID),
+		Type:        "application/vnd.vmware.vcloud.vApp+xml",
+		ID:          urn("vapp", a.ID),
+		Name:        a.Name,
+		Status:      s.vappStatus(a.ID),
+		Deployed:    s.vappStatus(a.ID) == statusPoweredOn,
+		Description: a.Description,
+		DateCreated: a.Created,
+		Link: govcdtypes.LinkList{
+			{Rel: "up", Type: "application/vnd.vmware.vcloud.vdc+xml", HREF: s.vdcHREF(a.VDCID)},
+			{Rel: "recompose", Type: "application/vnd.vmware.vcloud.recomposeVAppParams+xml", HREF: s.vappHREF(a.ID) + "/action/recomposeVApp"},
+		},
+	}
+
+	if vms := s.vappVMs(a.ID); len(vms) > 0 {
+		result.Children = &govcdtypes.VAppChildren{}
+		for _, v := range vms {
+			child := v.VM
+			result.Children.VM = append(result.Children.VM, &child)
+		}
+	}
+
+	return result
+}
+
+// getVAppOrVM returns a vApp or a VM, which share the same path.
+func (s *Server) getVAppOrVM(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := r.PathValue("id")
+	if strings.HasPrefix(id, "vm-") {
+		if v, ok := s.vmOf(w, r); ok {
+			result := v.VM
+			writeXML(w, http.StatusOK, &result)
+		}
+		return
+	}
+
+	a, ok := s.vapps[uuidOf(id)]
+	if !ok {
+		notFound(w, r, "vApp", id)
+		return
+	}
+
+	writeXML(w, http.StatusOK, s.vcdVApp(a))
+}
+
+// vmOf returns the VM of the request, or writes the error if it does not
+// exist. The caller must hold the lock.
+func (s *Server) vmOf(w http.ResponseWriter, r *http.Request) (*vm, bool) {
+	v, ok := s.vms[uuidOf(r.PathValue("id"))]
+	if !ok {
+		notFound(w, r, "VM", r.PathValue("id"))
+	}
+	return v, ok
+}
+
+// composeVApp creates an empty vApp in a VDC.
+func (s *Server) composeVApp(w http.ResponseWriter, r *http.Request) {
+	var params govcdtypes.ComposeVAppParams
+	if !readXML(w, r, &params) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	v, ok := s.vdcs[r.PathValue("id")]
+	if !ok {
+		notFound(w, r, "VDC", r.PathValue("id"))
+		return
+	}
+	for _, a := range s.vapps {
+		if a.VDCID == v.ID && a.Name == params.Name {
+			writeError(w, r, http.StatusBadRequest, "DUPLICATE_NAME", "The vApp name "+params.Name+" is already used.")
+			return
+		}
+	}
+
+	a := &vapp{
+		ID:          newID(),
+		Name:        params.Name,
+		Description: params.Description,
+		VDCID:       v.ID,
+		Created:     time.Now().UTC().Format(time.RFC3339),
+	}
+	s.vapps[a.ID] = a
+
+	result := s.vcdVApp(a)
+	href := s.newTask("vdcComposeVapp", &govcdtypes.Reference{HREF: result.HREF, ID: result.ID, Name: a.Name, Type: result.Type})
+	result.Tasks = &govcdtypes.TasksInProgress{Task: []*govcdtypes.Task{s.tasks[uuidOf(href)]}}
+
+	writeXML(w, http.StatusCreated, result)
+}
+
+// recomposeVApp adds the VMs created from a template or from scratch and
+// removes the deleted VMs.
+func (s *Server) recomposeVApp(w http.ResponseWriter, r *http.Request) {
+	var params recomposeParams
+	if !readXML(w, r, &params) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	a, ok := s.vapps[uuidOf(r.PathValue("id"))]
+	if !ok {
+		notFound(w, r, "vApp", r.PathValue("id"))
+		return
+	}
+
+	status := statusPoweredOff
+	if params.PowerOn {
+		status = statusPoweredOn
+	}
+
+	if item := params.CreateItem; item != nil {
+		s.newVM(a, govcdtypes.Vm{
+			Name:                      item.Name,
+			Description:               item.Description,
+			Status:                    status,
+			VmSpecSection:             item.VmSpecSection,
+			NetworkConnectionSection:  item.NetworkConnectionSection,
+			GuestCustomizationSection: item.GuestCustomizationSection,
+			StorageProfile:            item.StorageProfile,
+			ComputePolicy:             item.ComputePolicy,
+			BootOptions:               item.BootOptions,
+		})
+	}
+
+	for _, item := range params.SourcedItem {
+		spec := &govcdtypes.VmSpecSection{
+			OsType:           "otherGuest64",
+			NumCpus:          intPtr(1),
+			MemoryResourceMb: &govcdtypes.MemoryResourceMb{Configured: 1024},
+			HardwareVersion:  &govcdtypes.HardwareVersion{Value: "vmx-19"},
+		}
+		v := govcdtypes.Vm{Status: status, VmSpecSection: spec, StorageProfile: item.StorageProfile}
+		if item.VMGeneralParams != nil {
+			v.Name = item.VMGeneralParams.Name
+			v.Description = item.VMGeneralParams.Description
+		}
+		if v.Name == "" && item.Source != nil {
+			v.Name = item.Source.Name
+		}
+		s.newVM(a, v)
+	}
+
+	for _, item := range params.DeleteItem {
+		delete(s.vms, uuidOf(item.HREF))
+	}
+
+	s.writeTask(w, "vappUpdateVm", &govcdtypes.Reference{HREF: s.vappHREF(a.ID), ID: urn("vapp", a.ID), Name: a.Name})
+}
+
+// newVM records a VM of a vApp. The caller must hold the lock.
+func (s *Server) newVM(a *vapp, v govcdtypes.Vm) {
+	id := newID()
+	v.HREF = s.vmHREF(id)
+	v.Type = "application/vnd.vmware.vcloud.vm+xml"
+	v.ID = urn("vm", id)
+	v.Deployed = v.Status == statusPoweredOn
+	v.DateCreated = time.Now().UTC().Format(time.RFC3339)
+	v.VAppParent = &govcdtypes.Reference{HREF: s.vappHREF(a.ID), Name: a.Name}
+	v.Link = govcdtypes.LinkList{
+		{Rel: "up", Type: "application/vnd.vmware.vcloud.vApp+xml", HREF: s.vappHREF(a.ID)},
+	}
+	if v.StorageProfile == nil {
+		if profile := s.defaultStorageProfile(a.VDCID); profile != nil {
+			v.StorageProfile = profile
+		}
+	}
+
+	s.vms[id] = &vm{VAppID: a.ID, VM: v}
+}
+
+// defaultStorageProfile returns the reference of the default storage profile
+// of a VDC. The caller must hold the lock.
+func (s *Server) defaultStorageProfile(vdcID string) *govcdtypes.Reference {
+	v, ok := s.vdcs[vdcID]
+	if !ok {
+		return nil
+	}
+	for _, p := range v.StorageProfiles {
+		if p.Default {
+			return &govcdtypes.Reference{
+				HREF: s.URL + "/api/vdcStorageProfile/" + p.ID,
+				ID:   urn("vdcstorageProfile", p.ID),
+				Name: p.Class,
+			}
+		}
+	}
+	return nil
+}
+
+// reconfigureVM updates the name, the description and the sections of a VM.
+func (s *Server) reconfigureVM(w http.ResponseWriter, r *http.Request) {
+	var body govcdtypes.Vm
+	if !readXML(w, r, &body) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	v, ok := s.vmOf(w, r)
+	if !ok {
+		return
+	}
+
+	if body.Name != "" {
+		v.VM.Name = body.Name
+	}
+	v.VM.Description = body.Description
+	if body.VmSpecSection != nil {
+		v.VM.VmSpecSection = body.VmSpecSection
+	}
+	if body.NetworkConnectionSection != nil {
+		v.VM.NetworkConnectionSection = body.NetworkConnectionSection
+	}
+	if body.GuestCustomizationSection != nil {
+		v.VM.GuestCustomizationSection = body.GuestCustomizationSection
+	}
+	if body.StorageProfile != nil {
+		v.VM.StorageProfile = body.StorageProfile
+	}
+	if body.BootOptions != nil {
+		v.VM.BootOptions = body.BootOptions
+	}
+
+	s.writeTask(w, "vappUpdateVm", &govcdtypes.Reference{HREF: v.VM.HREF, ID: v.VM.ID, Name: v.VM.Name})
+}
+
+// updateVMSection updates the network connection or the guest customization
+// section of a VM.
+func (s *Server) updateVMSection(w http.ResponseWriter, r *http.Request) {
+	isNetwork := strings.Contains(r.URL.Path, "/networkConnectionSection/")
+
+	var network govcdtypes.NetworkConnectionSection
+	var customization govcdtypes.GuestCustomizationSection
+	if isNetwork && !readXML(w, r, &network) || !isNetwork && !readXML(w, r, &customization) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	v, ok := s.vmOf(w, r)
+	if !ok {
+		return
+	}
+
+	if isNetwork {
+		v.VM.NetworkConnectionSection = &network
+	} else {
+		v.VM.GuestCustomizationSection = &customization
+	}
+
+	s.writeTask(w, "vappUpdateVm", &govcdtypes.Reference{HREF: v.VM.HREF, ID: v.VM.ID, Name: v.VM.Name})
+}
+
+// powerAction changes the power state of a vApp and its VMs, or of a VM.
+func (s *Server) powerAction(w http.ResponseWriter, r *http.Request) {
+	action := r.PathValue("action")
+
+	var deploy govcdtypes.DeployVAppParams
+	if action == "deploy" && r.ContentLength != 0 {
+		if !readXML(w, r, &deploy) {
+			return
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var vms []*vm
+	var owner *govcdtypes.Reference
+	if strings.HasPrefix(r.PathValue("id"), "vm-") {
+		v, ok := s.vmOf(w, r)
+		if !ok {
+			return
+		}
+		vms = []*vm{v}
+		owner = &govcdtypes.Reference{HREF: v.VM.HREF, ID: v.VM.ID, Name: v.VM.Name}
+	} else {
+		a, ok := s.vapps[uuidOf(r.PathValue("id"))]
+		if !ok {
+			notFound(w, r, "vApp", r.PathValue("id"))
+			return
+		}
+		vms = s.vappVMs(a.ID)
+		owner = &govcdtypes.Reference{HREF: s.vappHREF(a.ID), ID: urn("vapp", a.ID), Name: a.Name}
+	}
+
+	for _, v := range vms {
+		switch action {
+		case "powerOn":
+			v.VM.Status = statusPoweredOn
+		case "powerOff", "shutdown", "undeploy":
+			v.VM.Status = statusPoweredOff
+		case "suspend":
+			v.VM.Status = statusSuspended
+		case "discardSuspendedState":
+			v.VM.Status = statusPoweredOff
+		case "deploy":
+			if deploy.PowerOn {
+				v.VM.Status = statusPoweredOn
+			}
+		case "reboot", "reset":
+		default:
+			writeError(w, r, http.StatusBadRequest, "BAD_REQUEST", "The action "+action+" is not supported.")
+			return
+		}
+		v.VM.Deployed = v.VM.Status == statusPoweredOn || action == "deploy"
+	}
+
+	s.writeTask(w, action, owner)
+}
+
+// deleteVAppOrVM deletes a vApp with its VMs, or a VM.
+func (s *Server) deleteVAppOrVM(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := r.PathValue("id")
+	if strings.HasPrefix(id, "vm-") {
+		v, ok := s.vmOf(w, r)
+		if !ok {
+			return
+		}
+		delete(s.vms, uuidOf(id))
+		s.writeTask(w, "vappDeleteVm", &govcdtypes.Reference{HREF: v.VM.HREF, ID: v.VM.ID, Name: v.VM.Name})
+		return
+	}
+
+	a, ok := s.vapps[uuidOf(id)]
+	if !ok {
+		notFound(w, r, "vApp", id)
+		return
+	}
+	for _, v := range s.vappVMs(a.ID) {
+		delete(s.vms, uuidOf(v.VM.ID))
+	}
+	delete(s.vapps, a.ID)
+
+	s.writeTask(w, "vdcDeleteVapp", &govcdtypes.Reference{HREF: s.vappHREF(a.ID), ID: urn("vapp", a.ID), Name: a.Name})
+}
+
+// powerState returns the name of a status in the query records.
+func powerState(status int) string {
+	switch status {
+	case statusPoweredOn:
+		return "POWERED_ON"
+	case statusSuspended:
+		return "SUSPENDED"
+	default:
+		return "POWERED_OFF"
+	}
+}
+
+func (s *Server) vappRecords(admin bool) []queryRecord {
+	records := make([]queryRecord, 0, len(s.vapps))
+	for _, a := range s.sortedVApps() {
+		v := s.vdcs[a.VDCID]
+		if v == nil {
+			continue
+		}
+		record := &govcdtypes.QueryResultVAppRecordType{
+			HREF:         s.vappHREF(a.ID),
+			Name:         a.Name,
+			CreationDate: a.Created,
+			Deployed:     s.vappStatus(a.ID) == statusPoweredOn,
+			Enabled:      true,
+			Status:       powerState(s.vappStatus(a.ID)),
+			VdcHREF:      s.vdcHREF(v.ID),
+			VdcName:      v.Name,
+			NumberOfVMs:  len(s.vappVMs(a.ID)),
+		}
+
+		records = append(records, queryRecord{
+			attributes: map[string]string{
+				"id":      urn("vapp", a.ID),
+				"name":    a.Name,
+				"vdc":     s.vdcHREF(v.ID),
+				"vdcName": v.Name,
+			},
+			add: func(result *govcdtypes.QueryResultRecordsType) {
+				if admin {
+					result.AdminVAppRecord = append(result.AdminVAppRecord, record)
+					return
+				}
+				result.VAppRecord = append(result.VAppRecord, record)
+			},
+		})
+	}
+	return records
+}
+
+func (s *Server) vmRecords(admin bool) []queryRecord {
+	records := make([]queryRecord, 0, len(s.vms))
+	for _, a := range s.sortedVApps() {
+		v := s.vdcs[a.VDCID]
+		if v == nil {
+			continue
+		}
+		for _, m := range s.vappVMs(a.ID) {
+			record := &govcdtypes.QueryResultVMRecordType{
+				HREF:          m.VM.HREF,
+				ID:            m.VM.ID,
+				Name:          m.VM.Name,
+				Type:          m.VM.Type,
+				ContainerName: a.Name,
+				ContainerID:   s.vappHREF(a.ID),
+				VdcHREF:       s.vdcHREF(v.ID),
+				VdcName:       v.Name,
+				Status:        powerState(m.VM.Status),
+				Deployed:      m.VM.Deployed,
+			}
+			if spec := m.VM.VmSpecSection; spec != nil {
+				record.GuestOS = spec.OsType
+				if spec.NumCpus != nil {
+					record.Cpus = *spec.NumCpus
+				}
+				if spec.MemoryResourceMb != nil {
+					record.MemoryMB = int(spec.MemoryResourceMb.Configured)
+				}
+			}
+
+			records = append(records, queryRecord{
+				attributes: map[string]string{
+					"id":            m.VM.ID,
+					"name":          m.VM.Name,
+					"containerName": a.Name,
+					"container":     s.vappHREF(a.ID),
+					"vdc":           s.vdcHREF(v.ID),
+				},
+				add: func(result *govcdtypes.QueryResultRecordsType) {
+					if admin {
+						result.AdminVMRecord = append(result.AdminVMRecord, record)
+						return
+					}
+					result.VMRecord = append(result.VMRecord, record)
+				},
+			})
+		}
+	}
+	return records
+}
+
+func intPtr(i int) *int {
+	return &i
+}
@@ -0,0 +1,542 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package fakeapi
+
+import (
+	"encoding/xml"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	govcdtypes "github.com/vmware/go-vcloud-director/v2/types/v56"
+)
+
+// supportedVersions are the versions of the VMware Cloud Director API
+// returned by /api/versions.
+var supportedVersions = []string{"37.0", "37.1", "37.2", "38.0", "38.1"}
+
+// vdc is a VDC of the organization.
+type vdc struct {
+	ID                  string
+	Name                string
+	Description         string
+	ServiceClass        string
+	DisponibilityClass  string
+	BillingModel        string
+	StorageBillingModel string
+	CPUAllocated        int
+	MemoryAllocated     int
+	VCPUInMhz           int
+	StorageProfiles     []storageProfile
+}
+
+// storageProfile is a storage profile of a VDC.
+type storageProfile struct {
+	ID      string
+	Class   string
+	Limit   int
+	Default bool
+}
+
+type versionInfo struct {
+	Version  string `xml:"Version"`
+	LoginURL string `xml:"LoginUrl"`
+}
+
+type supportedVersionsResponse struct {
+	XMLName     xml.Name      `xml:"SupportedVersions"`
+	VersionInfo []versionInfo `xml:"VersionInfo"`
+}
+
+type session struct {
+	XMLName xml.Name `xml:"Session"`
+	User    string   `xml:"user,attr"`
+	Org     string   `xml:"org,attr"`
+	Link    []*govcdtypes.Link
+}
+
+type cloudAPISession struct {
+	ID   string                      `json:"id"`
+	User govcdtypes.OpenApiReference `json:"user"`
+	Org  govcdtypes.OpenApiReference `json:"org"`
+}
+
+func (s *Server) vcdRoutes() {
+	s.Handle("GET /api/versions", s.getVersions)
+	s.Handle("POST /api/sessions", s.createSession)
+	s.Handle("POST /cloudapi/1.0.0/sessions", s.createSession)
+	s.Handle("POST /oauth/tenant/{org}/token", s.createOAuthToken)
+	s.Handle("GET /cloudapi/1.0.0/sessions/current", s.authenticated(s.getCurrentSession))
+	s.Handle("DELETE /cloudapi/1.0.0/sessions/current", s.authenticated(s.deleteSession))
+	s.Handle("DELETE /api/session", s.authenticated(s.deleteSession))
+
+	s.Handle("GET /api/org", s.authenticated(s.getOrgList))
+	s.Handle("GET /api/org/{id}", s.authenticated(s.getOrg))
+	s.Handle("GET /api/admin/org/{id}", s.authenticated(s.getAdminOrg))
+	s.Handle("GET /api/vdc/{id}", s.authenticated(s.getVDC))
+	s.Handle("GET /api/admin/vdc/{id}", s.authenticated(s.getAdminVDC))
+	s.Handle("GET /api/query", s.authenticated(s.query))
+	s.Handle("GET /api/task/{id}", s.authenticated(s.getTask))
+}
+
+func (s *Server) getVersions(w http.ResponseWriter, _ *http.Request) {
+	resp := supportedVersionsResponse{}
+	for _, v := range supportedVersions {
+		resp.VersionInfo = append(resp.VersionInfo, versionInfo{
+			Version:  v,
+			LoginURL: s.URL + "/api/sessions",
+		})
+	}
+	writeXML(w, http.StatusOK, resp)
+}
+
+// createSession opens a session with the basic authentication, for both the
+// XML API and the OpenAPI.
+func (s *Server) createSession(w http.ResponseWriter, r *http.Request) {
+	username, password, ok := r.BasicAuth()
+	if !ok || !s.validCredentials(username, password) {
+		writeError(w, r, http.StatusUnauthorized, "", "Invalid credentials.")
+		return
+	}
+
+	token := s.newToken()
+	w.Header().Set("X-Vcloud-Authorization", token)
+	w.Header().Set("X-Vmware-Vcloud-Access-Token", token)
+	w.Header().Set("X-Vmware-Vcloud-Token-Type", "Bearer")
+
+	if strings.HasPrefix(r.URL.Path, "/cloudapi/") {
+		writeJSON(w, http.StatusOK, s.cloudAPISession())
+		return
+	}
+
+	writeXML(w, http.StatusOK, session{
+		User: s.config.Username,
+		Org:  s.config.Org,
+		Link: []*govcdtypes.Link{{Rel: "down", Type: "application/vnd.vmware.vcloud.orgList+xml", HREF: s.URL + "/api/org/"}},
+	})
+}
+
+// createOAuthToken opens a session with an API token. The API token of the
+// server is its password.
+func (s *Server) createOAuthToken(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil || r.PathValue("org") != s.config.Org || r.PostForm.Get("refresh_token") != s.config.Password {
+		writeError(w, r, http.StatusUnauthorized, "", "Invalid API token.")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]any{
+		"access_token": s.newToken(),
+		"token_type":   "Bearer",
+		"expires_in":   3600,
+	})
+}
+
+func (s *Server) getCurrentSession(w http.ResponseWriter, _ *http.Request) {
+	writeJSON(w, http.StatusOK, s.cloudAPISession())
+}
+
+func (s *Server) deleteSession(w http.ResponseWriter, r *http.Request) {
+	token := r.Header.Get("X-Vcloud-Authorization")
+	if bearer, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer "); ok {
+		token = bearer
+	}
+
+	s.mu.Lock()
+	delete(s.tokens, token)
+	s.mu.Unlock()
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) cloudAPISession() cloudAPISession {
+	return cloudAPISession{
+		ID:   urn("session", newID()),
+		User: govcdtypes.OpenApiReference{Name: s.config.Username, ID: urn("user", s.orgID)},
+		Org:  govcdtypes.OpenApiReference{Name: s.config.Org, ID: urn("org", s.orgID)},
+	}
+}
+
+func (s *Server) orgHREF() string {
+	return s.URL + "/api/org/" + s.orgID
+}
+
+func (s *Server) getOrgList(w http.ResponseWriter, _ *http.Request) {
+	writeXML(w, http.StatusOK, govcdtypes.OrgList{
+		Org: []*govcdtypes.Org{{
+			HREF: s.orgHREF(),
+			Type: "application/vnd.vmware.vcloud.org+xml",
+			Name: s.config.Org,
+		}},
+	})
+}
+
+func (s *Server) getOrg(w http.ResponseWriter, r *http.Request) {
+	if r.PathValue("id") != s.orgID {
+		notFound(w, r, "Org", r.PathValue("id"))
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	writeXML(w, http.StatusOK, s.org())
+}
+
+// org returns the organization with the links to its VDCs. The caller must
+// hold the lock.
+func (s *Server) org() *govcdtypes.Org {
+	org := &govcdtypes.Org{
+		HREF:      s.orgHREF(),
+		Type:      "application/vnd.vmware.vcloud.org+xml",
+		ID:        urn("org", s.orgID),
+		Name:      s.config.Org,
+		FullName:  s.config.Org,
+		IsEnabled: true,
+	}
+
+	for _, v := range s.sortedVDCs() {
+		org.Link = append(org.Link, &govcdtypes.Link{
+			Rel:  "down",
+			Type: "application/vnd.vmware.vcloud.vdc+xml",
+			Name: v.Name,
+			HREF: s.vdcHREF(v.ID),
+		})
+	}
+
+	return org
+}
+
+func (s *Server) getAdminOrg(w http.ResponseWriter, r *http.Request) {
+	if r.PathValue("id") != s.orgID {
+		notFound(w, r, "Org", r.PathValue("id"))
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	org := s.org()
+	adminOrg := govcdtypes.AdminOrg{
+		Xmlns:       govcdtypes.XMLNamespaceVCloud,
+		HREF:        s.URL + "/api/admin/org/" + s.orgID,
+		Type:        "application/vnd.vmware.admin.organization+xml",
+		ID:          org.ID,
+		Name:        org.Name,
+		FullName:    org.FullName,
+		IsEnabled:   true,
+		OrgSettings: &govcdtypes.OrgSettings{},
+	}
+	adminOrg.Vdcs = &govcdtypes.VDCList{}
+	for _, v := range s.sortedVDCs() {
+		adminOrg.Vdcs.Vdcs = append(adminOrg.Vdcs.Vdcs, &govcdtypes.Reference{
+			HREF: s.URL + "/api/admin/vdc/" + v.ID,
+			Type: "application/vnd.vmware.admin.vdc+xml",
+			Name: v.Name,
+		})
+	}
+
+	writeXML(w, http.StatusOK, adminOrg)
+}
+
+func (s *Server) vdcHREF(id string) string {
+	return s.URL + "/api/vdc/" + id
+}
+
+// sortedVDCs returns the VDCs sorted by name. The caller must hold the lock.
+func (s *Server) sortedVDCs() []*vdc {
+	vdcs := make([]*vdc, 0, len(s.vdcs))
+	for _, v := range s.vdcs {
+		vdcs = append(vdcs, v)
+	}
+	sort.Slice(vdcs, func(i, j int) bool { return vdcs[i].Name < vdcs[j].Name })
+	return vdcs
+}
+
+// vdcByName returns the VDC with the name. The caller must hold the lock.
+func (s *Server) vdcByName(name string) *vdc {
+	for _, v := range s.vdcs {
+		if v.Name == name {
+			return v
+		}
+	}
+	return nil
+}
+
+// vcdVDC returns the VMware Cloud Director representation of a VDC. The
+// caller must hold the lock.
+func (s *Server) vcdVDC(v *vdc) govcdtypes.Vdc {
+	result := govcdtypes.Vdc{
+		HREF:            s.vdcHREF(v.ID),
+		Type:            "application/vnd.vmware.vcloud.vdc+xml",
+		ID:              urn("vdc", v.ID),
+		Name:            v.Name,
+		Status:          1,
+		Description:     v.Description,
+		AllocationModel: "AllocationVApp",
+		IsEnabled:       true,
+		Link: govcdtypes.LinkList{
+			{Rel: "up", Type: "application/vnd.vmware.vcloud.org+xml", HREF: s.orgHREF()},
+			{Rel: "add", Type: "application/vnd.vmware.vcloud.composeVAppParams+xml", HREF: s.vdcHREF(v.ID) + "/action/composeVApp"},
+		},
+		ResourceEntities:   []*govcdtypes.ResourceEntities{{}},
+		VdcStorageProfiles: &govcdtypes.VdcStorageProfiles{},
+	}
+
+	for _, p := range v.StorageProfiles {
+		result.VdcStorageProfiles.VdcStorageProfile = append(result.VdcStorageProfiles.VdcStorageProfile, &govcdtypes.Reference{
+			HREF: s.URL + "/api/vdcStorageProfile/" + p.ID,
+			ID:   urn("vdcstorageProfile", p.ID),
+			Type: "application/vnd.vmware.vcloud.vdcStorageProfile+xml",
+			Name: p.Class,
+		})
+	}
+
+	for _, a := range s.sortedVApps() {
+		if a.VDCID != v.ID {
+			continue
+		}
+		result.ResourceEntities[0].ResourceEntity = append(result.ResourceEntities[0].ResourceEntity, &govcdtypes.ResourceReference{
+			HREF: s.vappHREF(a.ID),
+			ID:   urn("vapp", a.ID),
+			Type: "application/vnd.vmware.vcloud.vApp+xml",
+			Name: a.Name,
+		})
+	}
+
+	return result
+}
+
+func (s *Server) getVDC(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	v, ok := s.vdcs[r.PathValue("id")]
+	if !ok {
+		notFound(w, r, "VDC", r.PathValue("id"))
+		return
+	}
+
+	writeXML(w, http.StatusOK, s.vcdVDC(v))
+}
+
+func (s *Server) getAdminVDC(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	v, ok := s.vdcs[r.PathValue("id")]
+	if !ok {
+		notFound(w, r, "VDC", r.PathValue("id"))
+		return
+	}
+
+	vcpu := int64(v.VCPUInMhz)
+	admin := govcdtypes.AdminVdc{
+		Xmlns:     govcdtypes.XMLNamespaceVCloud,
+		Vdc:       s.vcdVDC(v),
+		VCpuInMhz: &vcpu,
+	}
+	admin.HREF = s.URL + "/api/admin/vdc/" + v.ID
+
+	writeXML(w, http.StatusOK, admin)
+}
+
+// queryRecord is a record of a query with the attributes used by the
+// filters.
+type queryRecord struct {
+	attributes map[string]string
+	add        func(*govcdtypes.QueryResultRecordsType)
+}
+
+// query implements the typed queries of the XML API used by the provider.
+func (s *Server) query(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var records []queryRecord
+	switch queryType := r.URL.Query().Get("type"); queryType {
+	case "orgVdc", "adminOrgVdc":
+		records = s.vdcRecords(queryType == "adminOrgVdc")
+	case "vApp", "adminVApp":
+		records = s.vappRecords(queryType == "adminVApp")
+	case "vm", "adminVM":
+		records = s.vmRecords(queryType == "adminVM")
+	case "orgVdcStorageProfile":
+		records = s.storageProfileRecords()
+	default:
+		writeError(w, r, http.StatusBadRequest, "BAD_REQUEST", "The query type "+queryType+" is not supported.")
+		return
+	}
+
+	result := &govcdtypes.QueryResultRecordsType{
+		HREF:     s.URL + r.URL.RequestURI(),
+		Page:     1,
+		PageSize: len(records),
+	}
+
+	conditions := parseQueryFilter(r.URL.Query().Get("filter"))
+	for _, record := range records {
+		if matchQueryFilter(conditions, record.attributes) {
+			record.add(result)
+			result.Total++
+		}
+	}
+	result.PageSize = int(result.Total)
+
+	writeXML(w, http.StatusOK, struct {
+		XMLName xml.Name `xml:"QueryResultRecords"`
+		*govcdtypes.QueryResultRecordsType
+	}{QueryResultRecordsType: result})
+}
+
+// parseQueryFilter parses the conjunctions (;) of equalities (==) of a query
+// filter.
+func parseQueryFilter(filter string) map[string]string {
+	conditions := map[string]string{}
+	for _, condition := range strings.Split(strings.Trim(filter, "()"), ";") {
+		name, value, ok := strings.Cut(condition, "==")
+		if !ok {
+			continue
+		}
+		if unescaped, err := url.QueryUnescape(value); err == nil {
+			value = unescaped
+		}
+		conditions[name] = value
+	}
+	return conditions
+}
+
+func matchQueryFilter(conditions, attributes map[string]string) bool {
+	for name, want := range conditions {
+		if got, ok := attributes[name]; ok && got != want {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *Server) vdcRecords(admin bool) []queryRecord {
+	records := make([]queryRecord, 0, len(s.vdcs))
+	for _, v := range s.sortedVDCs() {
+		record := &govcdtypes.QueryResultOrgVdcRecordType{
+			HREF:            s.vdcHREF(v.ID),
+			Name:            v.Name,
+			IsEnabled:       "true",
+			OrgName:         s.config.Org,
+			Org:             s.orgHREF(),
+			AllocationModel: "AllocationVApp",
+			Status:          "READY",
+		}
+		if admin {
+			record.HREF = s.URL + "/api/admin/vdc/" + v.ID
+		}
+
+		records = append(records, queryRecord{
+			attributes: map[string]string{
+				"id":      urn("vdc", v.ID),
+				"name":    v.Name,
+				"org":     s.orgHREF(),
+				"orgName": s.config.Org,
+			},
+			add: func(result *govcdtypes.QueryResultRecordsType) {
+				if admin {
+					result.OrgVdcAdminRecord = append(result.OrgVdcAdminRecord, record)
+					return
+				}
+				result.OrgVdcRecord = append(result.OrgVdcRecord, record)
+			},
+		})
+	}
+	return records
+}
+
+func (s *Server) storageProfileRecords() []queryRecord {
+	records := make([]queryRecord, 0)
+	for _, v := range s.sortedVDCs() {
+		for _, p := range v.StorageProfiles {
+			record := &govcdtypes.QueryResultOrgVdcStorageProfileRecordType{
+				HREF:                    s.URL + "/api/vdcStorageProfile/" + p.ID,
+				ID:                      urn("vdcstorageProfile", p.ID),
+				Name:                    p.Class,
+				IsEnabled:               true,
+				IsDefaultStorageProfile: p.Default,
+				StorageLimitMB:          uint64(p.Limit) * 1024,
+				Vdc:                     s.vdcHREF(v.ID),
+				VdcName:                 v.Name,
+			}
+
+			records = append(records, queryRecord{
+				attributes: map[string]string{
+					"name":    p.Class,
+					"vdc":     s.vdcHREF(v.ID),
+					"vdcName": v.Name,
+				},
+				add: func(result *govcdtypes.QueryResultRecordsType) {
+					result.OrgVdcStorageProfileRecord = append(result.OrgVdcStorageProfileRecord, record)
+				},
+			})
+		}
+	}
+	return records
+}
+
+// task is an asynchronous operation of the VMware Cloud Director API.
+type task = govcdtypes.Task
+
+// newTask records a task completed successfully and returns its HREF. The
+// caller must hold the lock.
+func (s *Server) newTask(operation string, owner *govcdtypes.Reference) string {
+	id := newID()
+	now := time.Now().UTC().Format(time.RFC3339)
+
+	s.tasks[id] = &task{
+		HREF:      s.URL + "/api/task/" + id,
+		Type:      "application/vnd.vmware.vcloud.task+xml",
+		ID:        urn("task", id),
+		Name:      "task",
+		Status:    "success",
+		Operation: operation,
+		StartTime: now,
+		EndTime:   now,
+		Owner:     owner,
+		Progress:  100,
+	}
+
+	return s.tasks[id].HREF
+}
+
+// writeTask writes the response of an asynchronous operation of the XML API.
+// The caller must hold the lock.
+func (s *Server) writeTask(w http.ResponseWriter, operation string, owner *govcdtypes.Reference) {
+	href := s.newTask(operation, owner)
+	writeXML(w, http.StatusAccepted, s.tasks[uuidOf(href)])
+}
+
+// acceptTask writes the response of an asynchronous operation of the
+// OpenAPI: the task is referenced by the Location header. The caller must
+// hold the lock.
+func (s *Server) acceptTask(w http.ResponseWriter, operation string, owner *govcdtypes.Reference) {
+	w.Header().Set("Location", s.newTask(operation, owner))
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func (s *Server) getTask(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	t, ok := s.tasks[r.PathValue("id")]
+	if !ok {
+		notFound(w, r, "Task", r.PathValue("id"))
+		return
+	}
+
+	writeXML(w, http.StatusOK, t)
+}
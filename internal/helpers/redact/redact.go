@@ -0,0 +1,99 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+// Package redact removes the secrets from the HTTP exchanges with the API,
+// before they are logged or stored.
+package redact
+
+import (
+	"mime"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// Placeholder replaces the sensitive values.
+const Placeholder = "REDACTED"
+
+var (
+	// sensitiveHeaders are the headers whose value is never kept.
+	sensitiveHeaders = map[string]bool{
+		"Authorization":                 true,
+		"Proxy-Authorization":           true,
+		"Cookie":                        true,
+		"Set-Cookie":                    true,
+		"X-Api-Key":                     true,
+		"X-Vcloud-Authorization":        true,
+		"X-Vmware-Vcloud-Access-Token":  true,
+		"X-Vmware-Vcloud-Refresh-Token": true,
+		"X-Amz-Security-Token":          true,
+	}
+
+	// sensitiveKey matches the names of the fields holding a secret:
+	// passwords, tokens, secrets, pre-shared keys and private keys.
+	sensitiveKey = `[A-Za-z0-9_.:-]*(?i:password|passwd|secret|token|pre_?shared_?key|psk|private_?key|api_?key)[A-Za-z0-9_.-]*`
+
+	sensitiveJSONValue  = regexp.MustCompile(`("` + sensitiveKey + `"\s*:\s*)"(?:[^"\\]|\\.)*"`)
+	sensitiveXMLElement = regexp.MustCompile(`(<` + sensitiveKey + `(?:\s[^>]*)?>)[^<]*`)
+	sensitiveXMLAttr    = regexp.MustCompile(`(\s` + sensitiveKey + `\s*=\s*)"[^"]*"`)
+	sensitiveFormValue  = regexp.MustCompile(`((?:^|&)` + sensitiveKey + `=)[^&]*`)
+	privateKeyBlock     = regexp.MustCompile(`-----BEGIN [A-Z ]*PRIVATE KEY-----[\s\S]*?-----END [A-Z ]*PRIVATE KEY-----`)
+	sensitiveQueryParam = regexp.MustCompile(`^(?:` + sensitiveKey + `|(?i:X-Amz-Signature|X-Amz-Credential))$`)
+)
+
+// Body returns the JSON, XML or form body with the secrets redacted.
+func Body(body string) string {
+	body = privateKeyBlock.ReplaceAllString(body, Placeholder)
+	body = sensitiveJSONValue.ReplaceAllString(body, `${1}"`+Placeholder+`"`)
+	body = sensitiveXMLElement.ReplaceAllString(body, "${1}"+Placeholder)
+	body = sensitiveXMLAttr.ReplaceAllString(body, `${1}"`+Placeholder+`"`)
+	body = sensitiveFormValue.ReplaceAllString(body, "${1}"+Placeholder)
+	return body
+}
+
+// IsSensitiveHeader reports whether the value of the header is a secret.
+func IsSensitiveHeader(name string) bool {
+	return sensitiveHeaders[http.CanonicalHeaderKey(name)]
+}
+
+// URL returns the URL with the password and the sensitive query parameters
+// redacted.
+func URL(u *url.URL) string {
+	query := u.Query()
+	if len(query) == 0 {
+		return u.Redacted()
+	}
+
+	for name := range query {
+		if sensitiveQueryParam.MatchString(name) {
+			query.Set(name, Placeholder)
+		}
+	}
+
+	c := *u
+	c.RawQuery = query.Encode()
+	return c.Redacted()
+}
+
+// IsTextContent reports whether the body of the content type is text that
+// can be redacted.
+func IsTextContent(contentType string) bool {
+	if contentType == "" {
+		return true
+	}
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+	return strings.HasPrefix(mediaType, "text/") ||
+		strings.HasSuffix(mediaType, "json") ||
+		strings.HasSuffix(mediaType, "xml") ||
+		mediaType == "application/x-www-form-urlencoded"
+}
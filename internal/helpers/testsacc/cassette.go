@@ -0,0 +1,537 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package testsacc
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/helpers/redact"
+)
+
+// Modes of the HTTP cassettes, set with the TF_ACC_CASSETTE environment
+// variable.
+const (
+	CassetteRecord = "record"
+	CassetteReplay = "replay"
+)
+
+const (
+	envCassette    = "TF_ACC_CASSETTE"
+	envCassetteDir = "TF_ACC_CASSETTE_DIR"
+
+	// defaultCassetteDir is relative to the directory of the tested package.
+	defaultCassetteDir = "testdata/cassettes"
+)
+
+// cassetteAPIs are the recorded APIs, with the environment variable holding
+// their URL.
+var cassetteAPIs = map[string]string{
+	"vcd":       "CLOUDAVENUE_URL",
+	"core":      "CLOUDAVENUE_CORE_API",
+	"netbackup": "NETBACKUP_URL",
+	"s3":        "CLOUDAVENUE_S3_URL",
+}
+
+// cassetteSignedAPIs are the APIs whose requests are signed (AWS Signature
+// Version 4): the host and the body of their requests are forwarded
+// unchanged to keep the signature valid.
+var cassetteSignedAPIs = map[string]bool{
+	"s3": true,
+}
+
+var (
+	// cassetteEnv are the environment variables stored in the cassettes and
+	// restored in replay mode.
+	cassetteEnv = []string{"CLOUDAVENUE_ORG", "CLOUDAVENUE_USERNAME", "CLOUDAVENUE_VDC", "NETBACKUP_USERNAME"}
+	// cassetteSecretEnv are the environment variables never stored, set to
+	// a placeholder in replay mode.
+	cassetteSecretEnv = []string{"CLOUDAVENUE_PASSWORD", "NETBACKUP_PASSWORD"}
+	// cassetteSkippedHeaders are the response headers not stored nor
+	// replayed, the proxy sets them.
+	cassetteSkippedHeaders = []string{"Connection", "Content-Length", "Content-Encoding", "Keep-Alive", "Transfer-Encoding"}
+)
+
+// Cassette is the record of the HTTP exchanges of a test.
+type Cassette struct {
+	// Env holds the environment variables of the test, the URLs of the APIs
+	// have the origin of the placeholder of their API.
+	Env          map[string]string `json:"env,omitempty"`
+	Interactions []Interaction     `json:"interactions"`
+}
+
+// Interaction is an HTTP exchange of a cassette. The secrets are redacted
+// and the origin of the API is replaced by a placeholder in the URLs of the
+// headers and bodies.
+type Interaction struct {
+	API    string `json:"api"`
+	Method string `json:"method"`
+	// URI is the path and the query of the request.
+	URI string `json:"uri"`
+	// RequestBody is informative, it is not compared in replay mode.
+	RequestBody string      `json:"request_body,omitempty"`
+	StatusCode  int         `json:"status_code"`
+	Headers     http.Header `json:"headers,omitempty"`
+	Body        string      `json:"body,omitempty"`
+	// BinaryBody holds the body of the responses which are not text.
+	BinaryBody []byte `json:"binary_body,omitempty"`
+}
+
+// cassettePlaceholder returns the origin replacing the origin of the API in
+// the cassettes.
+func cassettePlaceholder(api string) string {
+	return "https://" + api + ".cassette.invalid"
+}
+
+// cassetteFile returns the file of the cassette of a test.
+func cassetteFile(dir, testName string) string {
+	return filepath.Join(dir, strings.NewReplacer("/", "_", " ", "_").Replace(testName)+".json")
+}
+
+// cassetteRecorder is a proxy between the provider and the APIs. It records
+// the exchanges in the cassette of the running test or replays them from it.
+type cassetteRecorder struct {
+	mode string
+	dir  string
+
+	// servers are the proxies, by API.
+	servers map[string]*httptest.Server
+	// upstreams are the URLs of the APIs in record mode.
+	upstreams map[string]*url.URL
+	transport http.RoundTripper
+	certDir   string
+
+	mu       sync.Mutex
+	t        testing.TB
+	file     string
+	cassette *Cassette
+	played   map[int]bool
+}
+
+var (
+	cassetteOnce sync.Once
+	cassetteRec  *cassetteRecorder
+	cassetteErr  error
+)
+
+// StartCassette records or replays the HTTP exchanges of the test, depending
+// on the TF_ACC_CASSETTE environment variable:
+//   - record: the exchanges with the APIs are stored in a cassette named
+//     after the test, with the secrets redacted,
+//   - replay: the exchanges are replayed from the cassette, no credentials
+//     nor network access are required. The test is skipped if it has no
+//     cassette.
+//
+// The proxies are started once for all the tests and the test points the
+// provider to them. The tests can't run in parallel.
+func StartCassette(t *testing.T) {
+	mode := os.Getenv(envCassette)
+	if mode == "" {
+		return
+	}
+	if mode != CassetteRecord && mode != CassetteReplay {
+		t.Fatalf("%s must be %q or %q, got %q", envCassette, CassetteRecord, CassetteReplay, mode)
+	}
+
+	cassetteOnce.Do(func() {
+		dir := os.Getenv(envCassetteDir)
+		if dir == "" {
+			dir = defaultCassetteDir
+		}
+
+		upstreams := make(map[string]string)
+		if mode == CassetteRecord {
+			for api, env := range cassetteAPIs {
+				upstreams[api] = os.Getenv(env)
+			}
+		}
+
+		cassetteRec, cassetteErr = newCassetteRecorder(mode, dir, upstreams)
+		if cassetteErr != nil {
+			return
+		}
+		cassetteErr = TrustCertDir(cassetteRec.certDir)
+	})
+	if cassetteErr != nil {
+		t.Fatalf("Unable to start the cassette proxies: %s", cassetteErr)
+	}
+
+	file := cassetteFile(cassetteRec.dir, t.Name())
+	if mode == CassetteReplay {
+		if _, err := os.Stat(file); errors.Is(err, os.ErrNotExist) {
+			t.Skipf("No cassette %s, record it with %s=%s", file, envCassette, CassetteRecord)
+		}
+	}
+
+	env, err := cassetteRec.start(t, file)
+	if err != nil {
+		t.Fatalf("Unable to start the cassette: %s", err)
+	}
+	for key, value := range env {
+		t.Setenv(key, value)
+	}
+	t.Cleanup(func() {
+		if err := cassetteRec.stop(); err != nil {
+			t.Errorf("Unable to save the cassette: %s", err)
+		}
+	})
+}
+
+// TrustCertDir trusts the certificates of the directory in addition to the
+// system roots, which are still needed to reach the APIs, with SSL_CERT_DIR.
+// It is only needed by the clients of the SDK that are created before the
+// provider can set their TLS configuration. It must be called before the
+// first TLS connection of the test binary, Go loads the system roots once.
+func TrustCertDir(dir string) error {
+	dirs := os.Getenv("SSL_CERT_DIR")
+	if dirs == "" {
+		dirs = "/etc/ssl/certs:/etc/pki/tls/certs"
+	}
+	return os.Setenv("SSL_CERT_DIR", dir+":"+dirs)
+}
+
+// newCassetteRecorder starts the proxies of the APIs. In record mode, every
+// API must have an upstream URL: an API left to the default URL of the SDK
+// would bypass the proxy and be missing from the cassettes.
+func newCassetteRecorder(mode, dir string, upstreams map[string]string) (*cassetteRecorder, error) {
+	r := &cassetteRecorder{
+		mode:      mode,
+		dir:       dir,
+		servers:   make(map[string]*httptest.Server),
+		upstreams: make(map[string]*url.URL),
+		transport: http.DefaultTransport,
+	}
+
+	for api := range cassetteAPIs {
+		if mode == CassetteRecord {
+			if upstreams[api] == "" {
+				r.close()
+				return nil, fmt.Errorf("%s must be set to record the cassettes, the %s API would bypass the proxy", cassetteAPIs[api], api)
+			}
+			u, err := url.Parse(upstreams[api])
+			if err != nil {
+				r.close()
+				return nil, fmt.Errorf("invalid URL of the %s API: %w", api, err)
+			}
+			r.upstreams[api] = u
+		}
+		r.servers[api] = httptest.NewTLSServer(r.handler(api))
+	}
+
+	if err := r.writeCertDir(); err != nil {
+		r.close()
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// close shuts down the proxies and removes their certificate.
+func (r *cassetteRecorder) close() {
+	for _, server := range r.servers {
+		server.Close()
+	}
+	if r.certDir != "" {
+		os.RemoveAll(r.certDir)
+	}
+}
+
+// writeCertDir writes the certificate of the proxies in a directory for
+// SSL_CERT_DIR. The proxies share the certificate of httptest.
+func (r *cassetteRecorder) writeCertDir() error {
+	dir, err := os.MkdirTemp("", "cassette-")
+	if err != nil {
+		return fmt.Errorf("error creating the certificate directory: %w", err)
+	}
+	r.certDir = dir
+
+	var data []byte
+	for _, server := range r.servers {
+		data = append(data, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})...)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "cassette.pem"), data, 0o600); err != nil {
+		return fmt.Errorf("error writing the certificate file: %w", err)
+	}
+
+	return nil
+}
+
+// start loads or creates the cassette of a test and returns the environment
+// variables pointing the provider to the proxies.
+func (r *cassetteRecorder) start(t testing.TB, file string) (map[string]string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.cassette != nil {
+		return nil, fmt.Errorf("the cassette %s is in use, the tests can't run in parallel", r.file)
+	}
+
+	cassette := &Cassette{Env: make(map[string]string)}
+	if r.mode == CassetteReplay {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("error reading the cassette: %w", err)
+		}
+		if err := json.Unmarshal(data, cassette); err != nil {
+			return nil, fmt.Errorf("error decoding the cassette %s: %w", file, err)
+		}
+	} else {
+		for _, key := range cassetteEnv {
+			if value := os.Getenv(key); value != "" {
+				cassette.Env[key] = value
+			}
+		}
+		for api, u := range r.upstreams {
+			cassette.Env[cassetteAPIs[api]] = cassettePlaceholder(api) + u.Path
+		}
+	}
+
+	env := make(map[string]string)
+	for key, value := range cassette.Env {
+		env[key] = value
+	}
+	for api, server := range r.servers {
+		if value, ok := cassette.Env[cassetteAPIs[api]]; ok {
+			env[cassetteAPIs[api]] = strings.Replace(value, cassettePlaceholder(api), server.URL, 1)
+		}
+	}
+	if r.mode == CassetteReplay {
+		for _, key := range cassetteSecretEnv {
+			if os.Getenv(key) == "" {
+				env[key] = redact.Placeholder
+			}
+		}
+	}
+
+	r.t = t
+	r.file = file
+	r.cassette = cassette
+	r.played = make(map[int]bool)
+
+	return env, nil
+}
+
+// stop saves the cassette of a successful test in record mode.
+func (r *cassetteRecorder) stop() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	t, file, cassette := r.t, r.file, r.cassette
+	r.t, r.file, r.cassette, r.played = nil, "", nil, nil
+
+	if r.mode != CassetteRecord || cassette == nil {
+		return nil
+	}
+	if t.Failed() {
+		log.Default().Printf("TestACC: the test failed, the cassette %s is not saved", file)
+		return nil
+	}
+
+	data, err := json.MarshalIndent(cassette, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(file), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(file, append(data, '\n'), 0o600)
+}
+
+// handler returns the handler of the proxy of an API.
+func (r *cassetteRecorder) handler(api string) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		if r.mode == CassetteRecord {
+			r.record(w, req, api)
+			return
+		}
+		r.replay(w, req, api)
+	}
+}
+
+// record forwards the request to the API, then stores the exchange in the
+// cassette.
+func (r *cassetteRecorder) record(w http.ResponseWriter, req *http.Request, api string) {
+	r.mu.Lock()
+	running := r.cassette != nil
+	r.mu.Unlock()
+	if !running {
+		http.Error(w, "no test is running", http.StatusServiceUnavailable)
+		return
+	}
+
+	var (
+		upstream       = r.upstreams[api]
+		upstreamOrigin = upstream.Scheme + "://" + upstream.Host
+		proxyOrigin    = r.servers[api].URL
+	)
+
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+
+	outBody := body
+	if !cassetteSignedAPIs[api] {
+		outBody = bytes.ReplaceAll(body, []byte(proxyOrigin), []byte(upstreamOrigin))
+	}
+	out, err := http.NewRequestWithContext(req.Context(), req.Method, upstreamOrigin+req.URL.RequestURI(), bytes.NewReader(outBody))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+	out.Header = req.Header.Clone()
+	if cassetteSignedAPIs[api] {
+		// The signature covers the host of the proxy.
+		out.Host = req.Host
+	}
+	// The transport negotiates and decodes the compression.
+	out.Header.Del("Accept-Encoding")
+
+	resp, err := r.transport.RoundTrip(out)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+
+	// The client gets the secrets of the response, the cassette does not.
+	interaction := Interaction{
+		API:        api,
+		Method:     req.Method,
+		URI:        cassetteURI(req.URL),
+		StatusCode: resp.StatusCode,
+		Headers:    make(http.Header),
+	}
+	if redact.IsTextContent(req.Header.Get("Content-Type")) && len(body) > 0 {
+		interaction.RequestBody = redact.Body(strings.ReplaceAll(string(body), proxyOrigin, cassettePlaceholder(api)))
+	}
+	for name, values := range cassetteHeaders(resp.Header) {
+		if redact.IsSensitiveHeader(name) {
+			interaction.Headers[name] = []string{redact.Placeholder}
+			continue
+		}
+		for _, value := range values {
+			interaction.Headers.Add(name, strings.ReplaceAll(value, upstreamOrigin, cassettePlaceholder(api)))
+		}
+	}
+	if redact.IsTextContent(resp.Header.Get("Content-Type")) {
+		interaction.Body = redact.Body(strings.ReplaceAll(string(respBody), upstreamOrigin, cassettePlaceholder(api)))
+	} else {
+		interaction.BinaryBody = respBody
+	}
+
+	r.mu.Lock()
+	if r.cassette != nil {
+		r.cassette.Interactions = append(r.cassette.Interactions, interaction)
+	}
+	r.mu.Unlock()
+
+	for name, values := range cassetteHeaders(resp.Header) {
+		for _, value := range values {
+			w.Header().Add(name, strings.ReplaceAll(value, upstreamOrigin, proxyOrigin))
+		}
+	}
+	if redact.IsTextContent(resp.Header.Get("Content-Type")) {
+		respBody = bytes.ReplaceAll(respBody, []byte(upstreamOrigin), []byte(proxyOrigin))
+	}
+	w.WriteHeader(resp.StatusCode)
+	_, _ = w.Write(respBody)
+}
+
+// replay answers the request with the next exchange of the cassette
+// matching its API, method and URI. The last matching exchange is replayed
+// again when all of them were played, for the polling of the tasks.
+func (r *cassetteRecorder) replay(w http.ResponseWriter, req *http.Request, api string) {
+	_, _ = io.Copy(io.Discard, req.Body)
+	uri := cassetteURI(req.URL)
+
+	r.mu.Lock()
+	if r.cassette == nil {
+		r.mu.Unlock()
+		http.Error(w, "no test is running", http.StatusServiceUnavailable)
+		return
+	}
+
+	var (
+		interaction *Interaction
+		last        = -1
+	)
+	for i := range r.cassette.Interactions {
+		candidate := &r.cassette.Interactions[i]
+		if candidate.API != api || candidate.Method != req.Method || candidate.URI != uri {
+			continue
+		}
+		if !r.played[i] {
+			interaction = candidate
+			r.played[i] = true
+			break
+		}
+		last = i
+	}
+	if interaction == nil && last >= 0 {
+		interaction = &r.cassette.Interactions[last]
+	}
+	t, file := r.t, r.file
+	r.mu.Unlock()
+
+	if interaction == nil {
+		t.Errorf("The cassette %s has no exchange for %s %s %s", file, api, req.Method, uri)
+		http.Error(w, "no exchange recorded in the cassette", http.StatusNotImplemented)
+		return
+	}
+
+	proxyOrigin := r.servers[api].URL
+	for name, values := range interaction.Headers {
+		for _, value := range values {
+			w.Header().Add(name, strings.ReplaceAll(value, cassettePlaceholder(api), proxyOrigin))
+		}
+	}
+	w.WriteHeader(interaction.StatusCode)
+	if interaction.BinaryBody != nil {
+		_, _ = w.Write(interaction.BinaryBody)
+		return
+	}
+	_, _ = io.WriteString(w, strings.ReplaceAll(interaction.Body, cassettePlaceholder(api), proxyOrigin))
+}
+
+// cassetteURI returns the path and the query of the request, with the
+// sensitive query parameters redacted.
+func cassetteURI(u *url.URL) string {
+	return redact.URL(&url.URL{Path: u.Path, RawQuery: u.RawQuery})
+}
+
+// cassetteHeaders returns the response headers replayed by the proxy.
+func cassetteHeaders(header http.Header) http.Header {
+	header = header.Clone()
+	for _, name := range cassetteSkippedHeaders {
+		header.Del(name)
+	}
+	return header
+}
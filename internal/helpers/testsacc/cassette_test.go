@@ -0,0 +1,257 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package testsacc
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// errorsTB records the errors reported to a test.
+type errorsTB struct {
+	testing.TB
+
+	mu     sync.Mutex
+	errors []string
+}
+
+func (t *errorsTB) Errorf(format string, args ...any) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.errors = append(t.errors, fmt.Sprintf(format, args...))
+}
+
+// cassetteDo sends a request to the proxy and returns the response body.
+func cassetteDo(t *testing.T, client *http.Client, method, url string) (*http.Response, string) {
+	t.Helper()
+
+	req, err := http.NewRequest(method, url, strings.NewReader(`{"username":"user","password":"s3cr3t"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return resp, string(body)
+}
+
+// cassetteUpstreams returns the same upstream URL for every API.
+func cassetteUpstreams(url string) map[string]string {
+	upstreams := make(map[string]string, len(cassetteAPIs))
+	for api := range cassetteAPIs {
+		upstreams[api] = url
+	}
+	return upstreams
+}
+
+func TestCassetteRecordMissingURL(t *testing.T) {
+	upstreams := cassetteUpstreams("https://api.example.com")
+	delete(upstreams, "s3")
+
+	recorder, err := newCassetteRecorder(CassetteRecord, t.TempDir(), upstreams)
+	if err == nil {
+		recorder.close()
+		t.Fatal("the recording must fail when an API would bypass the proxy")
+	}
+	if !strings.Contains(err.Error(), "CLOUDAVENUE_S3_URL") {
+		t.Errorf("error = %v, want CLOUDAVENUE_S3_URL", err)
+	}
+}
+
+func TestCassetteRecordSignedAPI(t *testing.T) {
+	upstream := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		w.Header().Set("Content-Type", "application/xml")
+		fmt.Fprintf(w, `<Request><Host>%s</Host><Body>%s</Body></Request>`, r.Host, body)
+	}))
+	defer upstream.Close()
+
+	recorder, err := newCassetteRecorder(CassetteRecord, t.TempDir(), cassetteUpstreams(upstream.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer recorder.close()
+	recorder.transport = upstream.Client().Transport
+
+	file := cassetteFile(t.TempDir(), "TestAccExample")
+	if _, err := recorder.start(t, file); err != nil {
+		t.Fatal(err)
+	}
+	proxy := recorder.servers["s3"]
+	proxyURL, err := url.Parse(proxy.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The signature covers the host and the body of the request.
+	req, err := http.NewRequestWithContext(t.Context(), http.MethodPut, proxy.URL+"/bucket/key", strings.NewReader(proxy.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := proxy.Client().Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "<Host>" + proxyURL.Host + "</Host><Body>" + proxy.URL + "</Body>"; !strings.Contains(string(body), want) {
+		t.Errorf("body = %s, want %s", body, want)
+	}
+
+	if err := recorder.stop(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestCassetteRecordAndReplay(t *testing.T) {
+	var (
+		polls int
+		mu    sync.Mutex
+	)
+
+	upstream := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		origin := "https://" + r.Host
+		switch r.URL.Path {
+		case "/api/sessions":
+			w.Header().Set("X-Vmware-Vcloud-Access-Token", "secret-token")
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprintf(w, `{"href":"%s/api/org/1","access_token":"secret-token"}`, origin)
+		case "/api/task/1":
+			mu.Lock()
+			polls++
+			status := "running"
+			if polls > 1 {
+				status = "success"
+			}
+			mu.Unlock()
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprintf(w, `{"status":%q}`, status)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer upstream.Close()
+
+	var (
+		dir  = t.TempDir()
+		file = cassetteFile(dir, "TestAccExample/create")
+	)
+	t.Setenv("CLOUDAVENUE_ORG", "cav01ev01ocb0001234")
+	t.Setenv("CLOUDAVENUE_PASSWORD", "")
+
+	// * Record
+	recorder, err := newCassetteRecorder(CassetteRecord, dir, cassetteUpstreams(upstream.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer recorder.close()
+	recorder.transport = upstream.Client().Transport
+
+	env, err := recorder.start(t, file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	proxy := recorder.servers["vcd"]
+	if env["CLOUDAVENUE_URL"] != proxy.URL {
+		t.Fatalf("CLOUDAVENUE_URL = %s, want %s", env["CLOUDAVENUE_URL"], proxy.URL)
+	}
+
+	resp, body := cassetteDo(t, proxy.Client(), http.MethodPost, proxy.URL+"/api/sessions")
+	if resp.Header.Get("X-Vmware-Vcloud-Access-Token") != "secret-token" || !strings.Contains(body, "secret-token") {
+		t.Errorf("record: the client must get the token, got %v %s", resp.Header, body)
+	}
+	if !strings.Contains(body, proxy.URL+"/api/org/1") {
+		t.Errorf("record: body = %s, want the URLs of the proxy", body)
+	}
+	for _, want := range []string{"running", "success"} {
+		if _, body := cassetteDo(t, proxy.Client(), http.MethodGet, proxy.URL+"/api/task/1"); !strings.Contains(body, want) {
+			t.Errorf("record: task = %s, want %s", body, want)
+		}
+	}
+
+	if err := recorder.stop(); err != nil {
+		t.Fatal(err)
+	}
+	recorder.close()
+
+	data, err := os.ReadFile(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, secret := range []string{"secret-token", "s3cr3t", upstream.URL} {
+		if strings.Contains(string(data), secret) {
+			t.Errorf("the cassette contains %s:\n%s", secret, data)
+		}
+	}
+	if filepath.Base(file) != "TestAccExample_create.json" {
+		t.Errorf("cassette file = %s", file)
+	}
+
+	// * Replay
+	upstream.Close()
+
+	replayer, err := newCassetteRecorder(CassetteReplay, dir, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer replayer.close()
+
+	tb := &errorsTB{TB: t}
+	env, err = replayer.start(tb, file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	proxy = replayer.servers["vcd"]
+	if env["CLOUDAVENUE_URL"] != proxy.URL || env["CLOUDAVENUE_ORG"] != "cav01ev01ocb0001234" || env["CLOUDAVENUE_PASSWORD"] == "" {
+		t.Errorf("replay: env = %v", env)
+	}
+
+	resp, body = cassetteDo(t, proxy.Client(), http.MethodPost, proxy.URL+"/api/sessions")
+	if resp.StatusCode != http.StatusOK || !strings.Contains(body, proxy.URL+"/api/org/1") {
+		t.Errorf("replay: session = %d %s", resp.StatusCode, body)
+	}
+	// The last exchange is replayed again once all were played.
+	for _, want := range []string{"running", "success", "success"} {
+		if _, body := cassetteDo(t, proxy.Client(), http.MethodGet, proxy.URL+"/api/task/1"); !strings.Contains(body, want) {
+			t.Errorf("replay: task = %s, want %s", body, want)
+		}
+	}
+
+	if resp, _ := cassetteDo(t, proxy.Client(), http.MethodGet, proxy.URL+"/api/unknown"); resp.StatusCode != http.StatusNotImplemented {
+		t.Errorf("replay: status = %d, want %d", resp.StatusCode, http.StatusNotImplemented)
+	}
+	if len(tb.errors) != 1 {
+		t.Errorf("replay: errors = %v, want the missing exchange reported", tb.errors)
+	}
+
+	if err := replayer.stop(); err != nil {
+		t.Fatal(err)
+	}
+}
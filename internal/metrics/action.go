@@ -26,6 +26,10 @@ const (
 	Update Action = "Update"
 	Delete Action = "Delete"
 	Import Action = "Import"
+	Open   Action = "Open"
+	Close  Action = "Close"
+	List   Action = "List"
+	Invoke Action = "Invoke"
 )
 
 // String returns the string representation of the action.
@@ -0,0 +1,82 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package metrics
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// fileSink appends the events to a local file, one JSON object per line.
+// The file is shared by the provider processes of a Terraform run, so each
+// batch of events is written with a single append.
+type fileSink struct {
+	mu   sync.Mutex
+	path string
+}
+
+// fileEvent is an event written by the file sink.
+type fileEvent struct {
+	// Time is the time the action started.
+	Time time.Time `json:"time"`
+
+	analyticRequest
+}
+
+// newFileSink returns the file sink, after checking that the file can be
+// written.
+func newFileSink(path string) (*fileSink, error) {
+	if dir := filepath.Dir(path); dir != "" {
+		if err := os.MkdirAll(dir, 0o750); err != nil {
+			return nil, fmt.Errorf("creating metrics directory %s: %w", dir, err)
+		}
+	}
+
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("opening metrics file %s: %w", path, err)
+	}
+	if err := f.Close(); err != nil {
+		return nil, fmt.Errorf("closing metrics file %s: %w", path, err)
+	}
+
+	return &fileSink{path: path}, nil
+}
+
+// send appends the events to the file.
+func (s *fileSink) send(events []analyticRequest) error {
+	var lines []byte
+	for _, event := range events {
+		line, err := json.Marshal(fileEvent{Time: event.startTime.UTC(), analyticRequest: event})
+		if err != nil {
+			return err
+		}
+		lines = append(lines, line...)
+		lines = append(lines, '\n')
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	f, err := os.OpenFile(s.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+
+	if _, err := f.Write(lines); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
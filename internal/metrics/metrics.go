@@ -50,6 +50,10 @@ type (
 
 		// Data is the interface containing extra data
 		Data map[string]any `json:"data,omitempty"`
+
+		// startTime is the time the action started. It is not sent to the
+		// analytics service.
+		startTime time.Time
 	}
 
 	terraformRequest struct {
@@ -62,26 +66,31 @@ type (
 	}
 )
 
+// New starts the measure of the action on the resource. The returned
+// function ends the measure and queues the event, which is sent to the
+// configured sink in the background.
 func New(resourceName, organizationID string, action Action) func() {
-	if everyThingIsOK() {
-		start := time.Now()
-		return func() {
-			timeElapsed := time.Since(start)
-			send(
-				analyticRequest{
-					terraformRequest: &terraformRequest{
-						TerraformExecutionID: GlobalExecutionID,
-						ClientVersion:        "terraform-cloudavenue/" + version,
-						ClientToken:          token,
-					},
-					ResourceName:   resourceName,
-					OrganizationID: organizationID,
-					Action:         action.String(),
-					ExecutionTime:  timeElapsed.Milliseconds(),
-				})
-		}
+	if currentSink() == nil {
+		return func() {}
+	}
+
+	start := time.Now()
+	return func() {
+		timeElapsed := time.Since(start)
+		defaultSender.enqueue(
+			analyticRequest{
+				terraformRequest: &terraformRequest{
+					TerraformExecutionID: GlobalExecutionID,
+					ClientVersion:        "terraform-cloudavenue/" + version,
+					ClientToken:          token,
+				},
+				ResourceName:   resourceName,
+				OrganizationID: organizationID,
+				Action:         action.String(),
+				ExecutionTime:  timeElapsed.Milliseconds(),
+				startTime:      start,
+			})
 	}
-	return func() {}
 }
 
 // everyThingIsOK Check if all variables are set.
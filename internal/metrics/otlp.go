@@ -0,0 +1,177 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package metrics
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// otlpMetricsPath is the path of the metrics of an OTLP/HTTP collector.
+	otlpMetricsPath = "/v1/metrics"
+	// otlpScopeName is the instrumentation scope of the metrics.
+	otlpScopeName = "github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/metrics"
+	// otlpMetricName is the name of the metric of the action durations.
+	otlpMetricName = "cloudavenue.provider.action.duration"
+)
+
+// otlpSink exports the events to an OpenTelemetry collector with the
+// OTLP/HTTP protocol and its JSON encoding. Each event is a data point of the
+// otlpMetricName gauge, with the resource, action, organization and
+// execution ID as attributes.
+type otlpSink struct {
+	url     string
+	headers map[string]string
+	client  *http.Client
+}
+
+// newOTLPSink returns the OTLP sink of the collector endpoint.
+func newOTLPSink(endpoint string, headers map[string]string) *otlpSink {
+	return &otlpSink{
+		url:     strings.TrimSuffix(endpoint, "/") + otlpMetricsPath,
+		headers: headers,
+		client:  &http.Client{Timeout: 5 * time.Second},
+	}
+}
+
+// send exports the events to the collector in a single request.
+func (s *otlpSink) send(events []analyticRequest) error {
+	body, err := json.Marshal(otlpPayload(events))
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), s.client.Timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range s.headers {
+		req.Header.Set(k, v)
+	}
+
+	res, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode >= http.StatusBadRequest {
+		return fmt.Errorf("OTLP collector returned %s", res.Status)
+	}
+	return nil
+}
+
+type (
+	otlpRequest struct {
+		ResourceMetrics []otlpResourceMetrics `json:"resourceMetrics"`
+	}
+
+	otlpResourceMetrics struct {
+		Resource     otlpResource       `json:"resource"`
+		ScopeMetrics []otlpScopeMetrics `json:"scopeMetrics"`
+	}
+
+	otlpResource struct {
+		Attributes []otlpAttribute `json:"attributes"`
+	}
+
+	otlpScopeMetrics struct {
+		Scope   otlpScope    `json:"scope"`
+		Metrics []otlpMetric `json:"metrics"`
+	}
+
+	otlpScope struct {
+		Name    string `json:"name"`
+		Version string `json:"version"`
+	}
+
+	otlpMetric struct {
+		Name        string    `json:"name"`
+		Description string    `json:"description"`
+		Unit        string    `json:"unit"`
+		Gauge       otlpGauge `json:"gauge"`
+	}
+
+	otlpGauge struct {
+		DataPoints []otlpDataPoint `json:"dataPoints"`
+	}
+
+	otlpDataPoint struct {
+		Attributes        []otlpAttribute `json:"attributes"`
+		StartTimeUnixNano string          `json:"startTimeUnixNano"`
+		TimeUnixNano      string          `json:"timeUnixNano"`
+		AsInt             string          `json:"asInt"`
+	}
+
+	otlpAttribute struct {
+		Key   string       `json:"key"`
+		Value otlpAnyValue `json:"value"`
+	}
+
+	otlpAnyValue struct {
+		StringValue string `json:"stringValue"`
+	}
+)
+
+// otlpPayload returns the OTLP request of the events.
+func otlpPayload(events []analyticRequest) otlpRequest {
+	points := make([]otlpDataPoint, 0, len(events))
+	for _, event := range events {
+		end := event.startTime.Add(time.Duration(event.ExecutionTime) * time.Millisecond)
+
+		points = append(points, otlpDataPoint{
+			Attributes: []otlpAttribute{
+				otlpString("cloudavenue.resource", event.ResourceName),
+				otlpString("cloudavenue.action", event.Action),
+				otlpString("cloudavenue.organization", event.OrganizationID),
+				otlpString("terraform.execution_id", event.terraformRequest.TerraformExecutionID),
+			},
+			StartTimeUnixNano: strconv.FormatInt(event.startTime.UnixNano(), 10),
+			TimeUnixNano:      strconv.FormatInt(end.UnixNano(), 10),
+			AsInt:             strconv.FormatInt(event.ExecutionTime, 10),
+		})
+	}
+
+	return otlpRequest{
+		ResourceMetrics: []otlpResourceMetrics{{
+			Resource: otlpResource{
+				Attributes: []otlpAttribute{
+					otlpString("service.name", "terraform-provider-cloudavenue"),
+					otlpString("service.version", version),
+				},
+			},
+			ScopeMetrics: []otlpScopeMetrics{{
+				Scope: otlpScope{Name: otlpScopeName, Version: version},
+				Metrics: []otlpMetric{{
+					Name:        otlpMetricName,
+					Description: "The time to execute an action on a resource.",
+					Unit:        "ms",
+					Gauge:       otlpGauge{DataPoints: points},
+				}},
+			}},
+		}},
+	}
+}
+
+// otlpString returns an OTLP string attribute.
+func otlpString(key, value string) otlpAttribute {
+	return otlpAttribute{Key: key, Value: otlpAnyValue{StringValue: value}}
+}
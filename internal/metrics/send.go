@@ -22,28 +22,33 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"time"
 )
 
-// Send is a function to send an event
-// with a given configuration and client.
-// This not return an error because it's not critical.
-func send(event analyticRequest) {
-	// Serialize and pack event
-	eventPkg, err := json.Marshal(event)
+// analyticsTimeout is the maximum time to send a batch of events to the
+// analytics service.
+const analyticsTimeout = 5 * time.Second
+
+// analyticsSink sends the events to the Cloud Avenue analytics service.
+type analyticsSink struct{}
+
+// send sends the batch of events to the analytics service in one request.
+func (analyticsSink) send(events []analyticRequest) error {
+	// Serialize and pack the events
+	eventsPkg, err := json.Marshal(events)
 	if err != nil {
-		return
+		return err
 	}
 
-	// Context with 1 second timeout
-	ctx, cancel := context.WithTimeout(context.Background(), 1*time.Second)
+	ctx, cancel := context.WithTimeout(context.Background(), analyticsTimeout)
 	defer cancel()
 
 	// Compose request
-	req, err := http.NewRequestWithContext(ctx, "POST", target+"/api/v1/send", bytes.NewReader(eventPkg))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, target+"/api/v1/send/batch", bytes.NewReader(eventsPkg))
 	if err != nil {
-		return
+		return err
 	}
 	// Set headers
 	req.Header.Set("Content-Type", "application/json")
@@ -56,7 +61,12 @@ func send(event analyticRequest) {
 	res, err := http.DefaultClient.Do(req)
 	// Check error
 	if err != nil {
-		return
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode >= http.StatusBadRequest {
+		return fmt.Errorf("analytics service returned %s", res.Status)
 	}
-	res.Body.Close()
+	return nil
 }
@@ -0,0 +1,130 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package metrics
+
+import (
+	"context"
+	"log"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	// queueSize is the number of events waiting to be sent. The events
+	// above this limit are dropped, so that a slow or offline sink never
+	// blocks the resource operations.
+	queueSize = 1024
+	// batchSize is the maximum number of events sent at once.
+	batchSize = 100
+	// flushInterval is the maximum time an event waits in a batch.
+	flushInterval = 5 * time.Second
+)
+
+// defaultSender is the sender of the events of the provider.
+var defaultSender = newSender()
+
+// sender sends the events to the sink in the background, by batches.
+type sender struct {
+	start   sync.Once
+	events  chan analyticRequest
+	flushes chan chan struct{}
+	dropped atomic.Int64
+}
+
+// newSender returns a sender. Its goroutine is started by the first event
+// or flush.
+func newSender() *sender {
+	return &sender{
+		events:  make(chan analyticRequest, queueSize),
+		flushes: make(chan chan struct{}),
+	}
+}
+
+// enqueue queues the event without blocking. The event is dropped if the
+// queue is full.
+func (s *sender) enqueue(event analyticRequest) {
+	s.start.Do(func() { go s.run() })
+
+	select {
+	case s.events <- event:
+	default:
+		s.dropped.Add(1)
+	}
+}
+
+// flush sends the queued events and waits for their delivery, until the
+// context is done.
+func (s *sender) flush(ctx context.Context) error {
+	s.start.Do(func() { go s.run() })
+
+	done := make(chan struct{})
+	select {
+	case s.flushes <- done:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// run batches the events and sends them to the current sink when the batch
+// is full, every flushInterval and on flush.
+func (s *sender) run() {
+	ticker := time.NewTicker(flushInterval)
+	defer ticker.Stop()
+
+	batch := make([]analyticRequest, 0, batchSize)
+	send := func() {
+		if dropped := s.dropped.Swap(0); dropped > 0 {
+			log.Printf("[DEBUG] %d metrics events dropped, the queue is full", dropped)
+		}
+		if len(batch) == 0 {
+			return
+		}
+		if sk := currentSink(); sk != nil {
+			deliver(sk, batch)
+		}
+		batch = batch[:0]
+	}
+
+	for {
+		select {
+		case event := <-s.events:
+			batch = append(batch, event)
+			if len(batch) >= batchSize {
+				send()
+			}
+		case <-ticker.C:
+			send()
+		case done := <-s.flushes:
+			// Send all the events queued before the flush.
+			for pending := len(s.events); pending > 0; pending-- {
+				batch = append(batch, <-s.events)
+				if len(batch) >= batchSize {
+					send()
+				}
+			}
+			send()
+			close(done)
+		}
+	}
+}
+
+// Flush sends the pending events and waits for their delivery, until the
+// context is done. It must be called before the provider exits.
+func Flush(ctx context.Context) error {
+	return defaultSender.flush(ctx)
+}
@@ -0,0 +1,160 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package metrics
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+// blockingSink records the events and blocks each send until released.
+type blockingSink struct {
+	mu      sync.Mutex
+	events  []analyticRequest
+	batches int
+	release chan struct{}
+}
+
+func (s *blockingSink) send(events []analyticRequest) error {
+	<-s.release
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.events = append(s.events, events...)
+	s.batches++
+	return nil
+}
+
+func (s *blockingSink) count() (events, batches int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.events), s.batches
+}
+
+// useSink sets the sink of the metrics for the test.
+func useSink(t *testing.T, s sink) {
+	t.Helper()
+
+	sinkMu.Lock()
+	previous := activeSink
+	activeSink = s
+	sinkMu.Unlock()
+
+	t.Cleanup(func() {
+		sinkMu.Lock()
+		activeSink = previous
+		sinkMu.Unlock()
+	})
+}
+
+func TestSenderDoesNotBlock(t *testing.T) {
+	s := &blockingSink{release: make(chan struct{})}
+	useSink(t, s)
+
+	sd := newSender()
+
+	// The sink is blocked, so the first full batch blocks the sender and
+	// the next events fill the queue and are dropped.
+	start := time.Now()
+	for range batchSize + queueSize + 10 {
+		sd.enqueue(analyticRequest{terraformRequest: &terraformRequest{}, ResourceName: "cloudavenue_vm"})
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Fatalf("enqueue took %s with a blocked sink", elapsed)
+	}
+
+	close(s.release)
+
+	ctx, cancel := context.WithTimeout(t.Context(), 5*time.Second)
+	defer cancel()
+	if err := sd.flush(ctx); err != nil {
+		t.Fatalf("flush() error = %v", err)
+	}
+
+	events, _ := s.count()
+	if events == 0 || events > batchSize+queueSize {
+		t.Fatalf("events sent = %d, want between 1 and %d", events, batchSize+queueSize)
+	}
+}
+
+func TestSenderBatches(t *testing.T) {
+	s := &blockingSink{release: make(chan struct{})}
+	close(s.release)
+	useSink(t, s)
+
+	sd := newSender()
+	for range 2*batchSize + 1 {
+		sd.enqueue(analyticRequest{terraformRequest: &terraformRequest{}})
+	}
+
+	if err := sd.flush(t.Context()); err != nil {
+		t.Fatalf("flush() error = %v", err)
+	}
+
+	events, batches := s.count()
+	if events != 2*batchSize+1 {
+		t.Errorf("events sent = %d, want %d", events, 2*batchSize+1)
+	}
+	if batches != 3 {
+		t.Errorf("batches = %d, want 3", batches)
+	}
+}
+
+func TestFlushTimeout(t *testing.T) {
+	s := &blockingSink{release: make(chan struct{})}
+	defer close(s.release)
+	useSink(t, s)
+
+	sd := newSender()
+	sd.enqueue(analyticRequest{terraformRequest: &terraformRequest{}})
+
+	ctx, cancel := context.WithTimeout(t.Context(), 50*time.Millisecond)
+	defer cancel()
+	if err := sd.flush(ctx); err == nil {
+		t.Fatal("flush() error = nil with a blocked sink, want a timeout")
+	}
+}
+
+// stopServer records the calls to StopProvider.
+type stopServer struct {
+	providerServer
+
+	stopped bool
+}
+
+func (s *stopServer) StopProvider(context.Context, *tfprotov6.StopProviderRequest) (*tfprotov6.StopProviderResponse, error) {
+	s.stopped = true
+	return &tfprotov6.StopProviderResponse{}, nil
+}
+
+func TestFlushOnStop(t *testing.T) {
+	s := &blockingSink{release: make(chan struct{})}
+	close(s.release)
+	useSink(t, s)
+
+	downstream := &stopServer{}
+	server := FlushOnStop(downstream)
+
+	New("cloudavenue_vm", "org", Read)()
+	if _, err := server.StopProvider(t.Context(), &tfprotov6.StopProviderRequest{}); err != nil {
+		t.Fatalf("StopProvider() error = %v", err)
+	}
+
+	if !downstream.stopped {
+		t.Error("StopProvider() was not forwarded")
+	}
+	if events, _ := s.count(); events != 1 {
+		t.Errorf("events = %d, want the pending event sent on StopProvider", events)
+	}
+}
@@ -0,0 +1,57 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+// stopFlushTimeout is the maximum time StopProvider waits for the delivery
+// of the pending events. Terraform waits for StopProvider when the run is
+// interrupted.
+const stopFlushTimeout = 5 * time.Second
+
+// providerServer is the provider server of the framework, which also serves
+// the list resources and the actions.
+type providerServer interface {
+	tfprotov6.ProviderServerWithListResource
+	tfprotov6.ProviderServerWithActions
+}
+
+// flushOnStopServer sends the pending events when Terraform stops the
+// provider.
+type flushOnStopServer struct {
+	providerServer
+}
+
+// FlushOnStop returns the provider server sending the pending events when
+// Terraform interrupts the run with StopProvider. The server is returned as
+// is if it does not serve the list resources and the actions.
+func FlushOnStop(server tfprotov6.ProviderServer) tfprotov6.ProviderServer {
+	s, ok := server.(providerServer)
+	if !ok {
+		return server
+	}
+	return flushOnStopServer{s}
+}
+
+// StopProvider cancels the running operations, then sends the pending events.
+func (s flushOnStopServer) StopProvider(ctx context.Context, req *tfprotov6.StopProviderRequest) (*tfprotov6.StopProviderResponse, error) {
+	resp, err := s.providerServer.StopProvider(ctx, req)
+
+	flushCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), stopFlushTimeout)
+	defer cancel()
+	_ = Flush(flushCtx)
+
+	return resp, err
+}
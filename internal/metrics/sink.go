@@ -0,0 +1,136 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package metrics
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+)
+
+// SinkType is the destination of the metrics events.
+type SinkType string
+
+const (
+	// SinkAnalytics sends the events to the Cloud Avenue analytics service.
+	// This is the default sink.
+	SinkAnalytics SinkType = "analytics"
+	// SinkNone disables the metrics.
+	SinkNone SinkType = "none"
+	// SinkFile appends the events to a local file, one JSON object per line.
+	SinkFile SinkType = "file"
+	// SinkOTLP exports the events to an OpenTelemetry collector with the
+	// OTLP/HTTP protocol.
+	SinkOTLP SinkType = "otlp"
+)
+
+// SinkTypes returns all the sink types.
+func SinkTypes() []SinkType {
+	return []SinkType{SinkAnalytics, SinkNone, SinkFile, SinkOTLP}
+}
+
+// SinkTypesString returns all the sink types as strings.
+func SinkTypesString() []string {
+	sinks := make([]string, 0, len(SinkTypes()))
+	for _, s := range SinkTypes() {
+		sinks = append(sinks, string(s))
+	}
+	return sinks
+}
+
+// Config is the configuration of the metrics.
+type Config struct {
+	// Sink is the destination of the events. Defaults to SinkAnalytics.
+	Sink SinkType
+
+	// FilePath is the path of the file of the SinkFile sink.
+	FilePath string
+
+	// OTLPEndpoint is the base URL of the OTLP/HTTP collector of the SinkOTLP
+	// sink (e.g. http://localhost:4318). The events are sent to the
+	// /v1/metrics path.
+	OTLPEndpoint string
+	// OTLPHeaders are the headers added to the requests of the SinkOTLP sink.
+	OTLPHeaders map[string]string
+}
+
+// sink is the destination of the metrics events.
+type sink interface {
+	send(events []analyticRequest) error
+}
+
+var (
+	sinkMu     sync.RWMutex
+	activeSink = defaultSink()
+)
+
+// ErrInvalidConfig is returned by Configure if the configuration is invalid.
+var ErrInvalidConfig = errors.New("invalid metrics configuration")
+
+// Configure sets the sink of the metrics events.
+func Configure(cfg Config) error {
+	var s sink
+
+	switch cfg.Sink {
+	case SinkAnalytics, "":
+		s = defaultSink()
+	case SinkNone:
+		s = nil
+	case SinkFile:
+		if cfg.FilePath == "" {
+			return fmt.Errorf("%w: the file path is required by the %s sink", ErrInvalidConfig, cfg.Sink)
+		}
+		fs, err := newFileSink(cfg.FilePath)
+		if err != nil {
+			return err
+		}
+		s = fs
+	case SinkOTLP:
+		if cfg.OTLPEndpoint == "" {
+			return fmt.Errorf("%w: the endpoint is required by the %s sink", ErrInvalidConfig, cfg.Sink)
+		}
+		s = newOTLPSink(cfg.OTLPEndpoint, cfg.OTLPHeaders)
+	default:
+		return fmt.Errorf("%w: unknown sink %q", ErrInvalidConfig, cfg.Sink)
+	}
+
+	sinkMu.Lock()
+	activeSink = s
+	sinkMu.Unlock()
+
+	return nil
+}
+
+// defaultSink returns the analytics sink, nil if it is not configured by
+// the release process.
+func defaultSink() sink {
+	if !everyThingIsOK() {
+		return nil
+	}
+	return analyticsSink{}
+}
+
+// currentSink returns the sink of the metrics events, nil if the metrics are
+// disabled.
+func currentSink() sink {
+	sinkMu.RLock()
+	defer sinkMu.RUnlock()
+
+	return activeSink
+}
+
+// deliver sends the events to the sink. The metrics are not critical, so an
+// error is only logged.
+func deliver(s sink, events []analyticRequest) {
+	if err := s.send(events); err != nil {
+		log.Printf("[DEBUG] Unable to send %d metrics events: %s", len(events), err)
+	}
+}
@@ -0,0 +1,212 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package metrics
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// configureForTest configures the metrics and restores the previous sink at
+// the end of the test.
+func configureForTest(t *testing.T, cfg Config) {
+	t.Helper()
+
+	previous := currentSink()
+	t.Cleanup(func() {
+		sinkMu.Lock()
+		activeSink = previous
+		sinkMu.Unlock()
+	})
+
+	if err := Configure(cfg); err != nil {
+		t.Fatalf("Configure() error = %v", err)
+	}
+}
+
+func TestConfigure(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     Config
+		wantErr bool
+	}{
+		{name: "Default", cfg: Config{}},
+		{name: "None", cfg: Config{Sink: SinkNone}},
+		{name: "File", cfg: Config{Sink: SinkFile, FilePath: filepath.Join(t.TempDir(), "metrics", "events.jsonl")}},
+		{name: "File without path", cfg: Config{Sink: SinkFile}, wantErr: true},
+		{name: "OTLP", cfg: Config{Sink: SinkOTLP, OTLPEndpoint: "http://localhost:4318"}},
+		{name: "OTLP without endpoint", cfg: Config{Sink: SinkOTLP}, wantErr: true},
+		{name: "Unknown sink", cfg: Config{Sink: "stdout"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			previous := currentSink()
+			defer func() { activeSink = previous }()
+
+			err := Configure(tt.cfg)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Configure() error = %v, wantErr %t", err, tt.wantErr)
+			}
+			if tt.wantErr && !errors.Is(err, ErrInvalidConfig) {
+				t.Errorf("Configure() error = %v, want ErrInvalidConfig", err)
+			}
+		})
+	}
+}
+
+func TestNewNone(t *testing.T) {
+	configureForTest(t, Config{Sink: SinkNone})
+
+	if currentSink() != nil {
+		t.Fatal("sink is set, want nil")
+	}
+	// Must not panic.
+	New("cloudavenue_vm", "org", Create)()
+}
+
+func TestFileSink(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "events.jsonl")
+	configureForTest(t, Config{Sink: SinkFile, FilePath: path})
+
+	New("cloudavenue_vm", "org", Create)()
+	New("cloudavenue_vapp", "org", Delete)()
+	if err := Flush(t.Context()); err != nil {
+		t.Fatalf("Flush() error = %v", err)
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	var got []map[string]any
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := map[string]any{}
+		if err := json.Unmarshal(scanner.Bytes(), &line); err != nil {
+			t.Fatalf("invalid JSON line %q: %v", scanner.Text(), err)
+		}
+		got = append(got, line)
+	}
+
+	if len(got) != 2 {
+		t.Fatalf("lines = %d, want 2", len(got))
+	}
+	if got[0]["resourceName"] != "cloudavenue_vm" || got[0]["action"] != "Create" {
+		t.Errorf("first event = %v", got[0])
+	}
+	if got[1]["resourceName"] != "cloudavenue_vapp" || got[1]["action"] != "Delete" {
+		t.Errorf("second event = %v", got[1])
+	}
+	if _, ok := got[0]["time"]; !ok {
+		t.Errorf("first event has no time: %v", got[0])
+	}
+}
+
+func TestOTLPSink(t *testing.T) {
+	var (
+		gotPath, gotHeader string
+		gotBody            otlpRequest
+	)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		gotHeader = r.Header.Get("X-Api-Key")
+		body, _ := io.ReadAll(r.Body)
+		if err := json.Unmarshal(body, &gotBody); err != nil {
+			t.Errorf("invalid OTLP body: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	configureForTest(t, Config{
+		Sink:         SinkOTLP,
+		OTLPEndpoint: server.URL + "/",
+		OTLPHeaders:  map[string]string{"X-Api-Key": "secret"},
+	})
+
+	New("cloudavenue_vm", "org", Read)()
+	New("cloudavenue_vapp", "org", Read)()
+	if err := Flush(t.Context()); err != nil {
+		t.Fatalf("Flush() error = %v", err)
+	}
+
+	if gotPath != otlpMetricsPath {
+		t.Errorf("path = %q, want %q", gotPath, otlpMetricsPath)
+	}
+	if gotHeader != "secret" {
+		t.Errorf("header = %q, want %q", gotHeader, "secret")
+	}
+
+	metrics := gotBody.ResourceMetrics[0].ScopeMetrics[0].Metrics
+	if len(metrics) != 1 || metrics[0].Name != otlpMetricName {
+		t.Fatalf("metrics = %+v", metrics)
+	}
+	points := metrics[0].Gauge.DataPoints
+	if len(points) != 2 {
+		t.Fatalf("data points = %d, want 2 in a single request", len(points))
+	}
+
+	attributes := map[string]string{}
+	for _, a := range points[0].Attributes {
+		attributes[a.Key] = a.Value.StringValue
+	}
+	if attributes["cloudavenue.resource"] != "cloudavenue_vm" || attributes["cloudavenue.action"] != "Read" {
+		t.Errorf("attributes = %v", attributes)
+	}
+}
+
+func TestAnalyticsSink(t *testing.T) {
+	var (
+		requests int
+		gotPath  string
+		gotBody  []map[string]any
+	)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		gotPath = r.URL.Path
+		body, _ := io.ReadAll(r.Body)
+		if err := json.Unmarshal(body, &gotBody); err != nil {
+			t.Errorf("invalid analytics body: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	previous := target
+	target = server.URL
+	t.Cleanup(func() { target = previous })
+	useSink(t, analyticsSink{})
+
+	New("cloudavenue_vm", "org", Read)()
+	New("cloudavenue_vapp", "org", Read)()
+	if err := Flush(t.Context()); err != nil {
+		t.Fatalf("Flush() error = %v", err)
+	}
+
+	if requests != 1 {
+		t.Errorf("requests = %d, want the events in a single request", requests)
+	}
+	if gotPath != "/api/v1/send/batch" {
+		t.Errorf("path = %q", gotPath)
+	}
+	if len(gotBody) != 2 || gotBody[0]["resourceName"] != "cloudavenue_vm" || gotBody[1]["resourceName"] != "cloudavenue_vapp" {
+		t.Errorf("events = %+v", gotBody)
+	}
+}
@@ -0,0 +1,140 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+// Package orgexport exports the resources of an organization as Terraform
+// configuration, with the import blocks bringing them under management.
+package orgexport
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/standalone"
+)
+
+// step lists the resources of a type.
+type step struct {
+	typeName string
+	// perVDC lists the resources in each VDC.
+	perVDC bool
+}
+
+// steps are the resource types exported, in the order of the output: a
+// resource is listed after the resources it references.
+var steps = []step{
+	{typeName: "cloudavenue_vdc"},
+	{typeName: "cloudavenue_vapp", perVDC: true},
+	{typeName: "cloudavenue_vm", perVDC: true},
+	{typeName: "cloudavenue_edgegateway"},
+	{typeName: "cloudavenue_edgegateway_firewall"},
+	{typeName: "cloudavenue_edgegateway_nat_rule"},
+	{typeName: "cloudavenue_network_routed"},
+	{typeName: "cloudavenue_catalog"},
+	{typeName: "cloudavenue_s3_bucket"},
+}
+
+// Types returns the resource types exported.
+func Types() []string {
+	types := make([]string, 0, len(steps))
+	for _, s := range steps {
+		types = append(types, s.typeName)
+	}
+	return types
+}
+
+// Export lists the resources of the organization. Only the given types are
+// exported, or all the types if none is given.
+//
+// The resources that cannot be listed are reported in the returned error,
+// along with the resources that could be listed.
+func Export(ctx context.Context, p *standalone.Provider, types ...string) ([]standalone.Resource, error) {
+	selected := map[string]bool{}
+	for _, typeName := range types {
+		if !isExported(typeName) {
+			return nil, fmt.Errorf("the resource type %s cannot be exported", typeName)
+		}
+		selected[typeName] = true
+	}
+
+	var (
+		resources []standalone.Resource
+		errs      []error
+		vdcs      []string
+		vdcListed bool
+	)
+	for _, s := range steps {
+		if len(selected) > 0 && !selected[s.typeName] {
+			continue
+		}
+
+		if !s.perVDC {
+			found, err := p.List(ctx, s.typeName, nil)
+			if err != nil {
+				errs = append(errs, err)
+			}
+			resources = append(resources, found...)
+			continue
+		}
+
+		// The VDCs are listed once, even if they are not exported.
+		if !vdcListed {
+			var err error
+			vdcs, err = vdcNames(ctx, p, resources)
+			if err != nil {
+				errs = append(errs, err)
+			}
+			vdcListed = true
+		}
+		for _, vdc := range vdcs {
+			found, err := p.List(ctx, s.typeName, map[string]string{"vdc": vdc})
+			if err != nil {
+				errs = append(errs, err)
+			}
+			resources = append(resources, found...)
+		}
+	}
+
+	return resources, errors.Join(errs...)
+}
+
+// vdcNames returns the names of the VDCs, from the exported resources or
+// from the API.
+func vdcNames(ctx context.Context, p *standalone.Provider, exported []standalone.Resource) ([]string, error) {
+	var (
+		vdcs []standalone.Resource
+		err  error
+	)
+	for _, r := range exported {
+		if r.TypeName == "cloudavenue_vdc" {
+			vdcs = append(vdcs, r)
+		}
+	}
+	if len(vdcs) == 0 {
+		vdcs, err = p.List(ctx, "cloudavenue_vdc", nil)
+	}
+
+	names := make([]string, 0, len(vdcs))
+	for _, vdc := range vdcs {
+		if name, ok := vdc.StringAttribute("name"); ok {
+			names = append(names, name)
+		}
+	}
+	return names, err
+}
+
+// isExported reports whether a resource type is exported.
+func isExported(typeName string) bool {
+	for _, s := range steps {
+		if s.typeName == typeName {
+			return true
+		}
+	}
+	return false
+}
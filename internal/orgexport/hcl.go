@@ -0,0 +1,400 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package orgexport
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	schemaR "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/standalone"
+)
+
+// ImportsFile is the name of the file holding the import blocks.
+const ImportsFile = "imports.tf"
+
+// skippedAttributes are the attributes never written in the configuration.
+var skippedAttributes = map[string]bool{
+	"timeouts": true,
+}
+
+// Render returns the configuration of the resources, by file name: a
+// <type>.tf file with the resource blocks of each type and the imports.tf
+// file with the import blocks.
+//
+// The string attributes holding the ID of another exported resource are
+// written as a reference to this resource.
+func Render(resources []standalone.Resource) (map[string][]byte, error) {
+	files := map[string]*hclwrite.File{}
+	imports := hclwrite.NewEmptyFile()
+
+	addresses := make([]hcl.Traversal, len(resources))
+	references := map[string]hcl.Traversal{}
+	used := map[string]bool{}
+	for i, r := range resources {
+		label := resourceLabel(r.DisplayName)
+		for n := 2; used[r.TypeName+"."+label]; n++ {
+			label = resourceLabel(r.DisplayName) + "_" + strconv.Itoa(n)
+		}
+		used[r.TypeName+"."+label] = true
+		addresses[i] = hcl.Traversal{
+			hcl.TraverseRoot{Name: r.TypeName},
+			hcl.TraverseAttr{Name: label},
+		}
+
+		// The first resource with an ID is referenced: e.g. the edge gateway
+		// rather than its firewall, which shares its ID.
+		if id, ok := r.StringAttribute("id"); ok && id != "" {
+			if _, exists := references[id]; !exists {
+				references[id] = hcl.Traversal{
+					hcl.TraverseRoot{Name: r.TypeName},
+					hcl.TraverseAttr{Name: label},
+					hcl.TraverseAttr{Name: "id"},
+				}
+			}
+		}
+	}
+
+	for i, r := range resources {
+		s, ok := r.State.Schema.(schemaR.Schema)
+		if !ok {
+			return nil, fmt.Errorf("%s: unexpected schema type %T", r.TypeName, r.State.Schema)
+		}
+
+		f, ok := files[r.TypeName]
+		if !ok {
+			f = hclwrite.NewEmptyFile()
+			files[r.TypeName] = f
+		} else {
+			f.Body().AppendNewline()
+		}
+
+		address := addresses[i]
+		block := f.Body().AppendNewBlock("resource", []string{r.TypeName, address[1].(hcl.TraverseAttr).Name})
+		if err := writeResource(block.Body(), s, r, references, address); err != nil {
+			return nil, fmt.Errorf("%s %s: %w", r.TypeName, r.DisplayName, err)
+		}
+
+		if r.ImportID == "" {
+			continue
+		}
+		if len(imports.Body().Blocks()) > 0 {
+			imports.Body().AppendNewline()
+		}
+		importBlock := imports.Body().AppendNewBlock("import", nil)
+		importBlock.Body().SetAttributeTraversal("to", address)
+		importBlock.Body().SetAttributeValue("id", cty.StringVal(r.ImportID))
+	}
+
+	output := make(map[string][]byte, len(files)+1)
+	for typeName, f := range files {
+		output[typeName+".tf"] = f.Bytes()
+	}
+	output[ImportsFile] = imports.Bytes()
+
+	return output, nil
+}
+
+// writeResource writes the attributes and the blocks of a resource.
+func writeResource(body *hclwrite.Body, s schemaR.Schema, r standalone.Resource, references map[string]hcl.Traversal, address hcl.Traversal) error {
+	values := map[string]tftypes.Value{}
+	if err := r.State.Raw.As(&values); err != nil {
+		return err
+	}
+
+	for _, name := range configurableAttributes(s.Attributes, values) {
+		if str, ok := r.StringAttribute(name); ok && name != "id" {
+			if reference, ok := references[str]; ok && !sameResource(reference, address) {
+				body.SetAttributeTraversal(name, reference)
+				continue
+			}
+		}
+
+		v, err := attributeValue(s.Attributes[name], values[name])
+		if err != nil {
+			return fmt.Errorf("attribute %s: %w", name, err)
+		}
+		body.SetAttributeValue(name, v)
+	}
+
+	return writeBlocks(body, s.Blocks, values)
+}
+
+// writeBlocks writes the nested blocks of an object.
+func writeBlocks(body *hclwrite.Body, blocks map[string]schemaR.Block, values map[string]tftypes.Value) error {
+	names := make([]string, 0, len(blocks))
+	for name := range blocks {
+		if !skippedAttributes[name] {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		value, ok := values[name]
+		if !ok || value.IsNull() {
+			continue
+		}
+
+		var (
+			object  schemaR.NestedBlockObject
+			objects []tftypes.Value
+		)
+		switch b := blocks[name].(type) {
+		case schemaR.SingleNestedBlock:
+			object = schemaR.NestedBlockObject{Attributes: b.Attributes, Blocks: b.Blocks}
+			objects = []tftypes.Value{value}
+		case schemaR.ListNestedBlock:
+			object = b.NestedObject
+			if err := value.As(&objects); err != nil {
+				return err
+			}
+		case schemaR.SetNestedBlock:
+			object = b.NestedObject
+			if err := value.As(&objects); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("block %s: unsupported block type %T", name, b)
+		}
+
+		for _, o := range objects {
+			nestedValues := map[string]tftypes.Value{}
+			if err := o.As(&nestedValues); err != nil {
+				return err
+			}
+
+			nested := body.AppendNewBlock(name, nil).Body()
+			for _, attributeName := range configurableAttributes(object.Attributes, nestedValues) {
+				v, err := attributeValue(object.Attributes[attributeName], nestedValues[attributeName])
+				if err != nil {
+					return fmt.Errorf("block %s attribute %s: %w", name, attributeName, err)
+				}
+				nested.SetAttributeValue(attributeName, v)
+			}
+			if err := writeBlocks(nested, object.Blocks, nestedValues); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// configurableAttributes returns the sorted names of the attributes to write:
+// the attributes set in the configuration, that are not sensitive and not
+// null.
+//
+// When both the <x>_id and <x>_name attributes are set, only <x>_id is kept:
+// the resources accept only one of them.
+func configurableAttributes(attributes map[string]schemaR.Attribute, values map[string]tftypes.Value) []string {
+	names := make([]string, 0, len(attributes))
+	for name, a := range attributes {
+		if skippedAttributes[name] || a.IsSensitive() || (!a.IsRequired() && !a.IsOptional()) {
+			continue
+		}
+		if v, ok := values[name]; !ok || v.IsNull() || !v.IsKnown() {
+			continue
+		}
+		if base, ok := strings.CutSuffix(name, "_name"); ok && base != "" {
+			if v, ok := values[base+"_id"]; ok && !v.IsNull() {
+				if a, ok := attributes[base+"_id"]; ok && (a.IsRequired() || a.IsOptional()) {
+					continue
+				}
+			}
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// attributeValue returns the configuration value of an attribute.
+func attributeValue(a schemaR.Attribute, value tftypes.Value) (cty.Value, error) {
+	var object schemaR.NestedAttributeObject
+	switch na := a.(type) {
+	case schemaR.SingleNestedAttribute:
+		return objectValue(na.Attributes, value)
+	case schemaR.ListNestedAttribute:
+		object = na.NestedObject
+	case schemaR.SetNestedAttribute:
+		object = na.NestedObject
+	case schemaR.MapNestedAttribute:
+		elements := map[string]tftypes.Value{}
+		if err := value.As(&elements); err != nil {
+			return cty.NilVal, err
+		}
+		objects := make(map[string]cty.Value, len(elements))
+		for key, element := range elements {
+			v, err := objectValue(na.NestedObject.Attributes, element)
+			if err != nil {
+				return cty.NilVal, err
+			}
+			objects[key] = v
+		}
+		return objectVal(objects), nil
+	default:
+		return ctyValue(value)
+	}
+
+	var elements []tftypes.Value
+	if err := value.As(&elements); err != nil {
+		return cty.NilVal, err
+	}
+	objects := make([]cty.Value, 0, len(elements))
+	for _, element := range elements {
+		v, err := objectValue(object.Attributes, element)
+		if err != nil {
+			return cty.NilVal, err
+		}
+		objects = append(objects, v)
+	}
+	return tupleVal(objects), nil
+}
+
+// objectValue returns the configuration value of a nested object.
+func objectValue(attributes map[string]schemaR.Attribute, value tftypes.Value) (cty.Value, error) {
+	if value.IsNull() {
+		return cty.NullVal(cty.DynamicPseudoType), nil
+	}
+
+	values := map[string]tftypes.Value{}
+	if err := value.As(&values); err != nil {
+		return cty.NilVal, err
+	}
+
+	object := map[string]cty.Value{}
+	for _, name := range configurableAttributes(attributes, values) {
+		v, err := attributeValue(attributes[name], values[name])
+		if err != nil {
+			return cty.NilVal, fmt.Errorf("%s: %w", name, err)
+		}
+		object[name] = v
+	}
+	return objectVal(object), nil
+}
+
+// ctyValue converts a value without nested attributes.
+func ctyValue(value tftypes.Value) (cty.Value, error) {
+	if value.IsNull() || !value.IsKnown() {
+		return cty.NullVal(cty.DynamicPseudoType), nil
+	}
+
+	t := value.Type()
+	switch {
+	case t.Is(tftypes.String):
+		var s string
+		if err := value.As(&s); err != nil {
+			return cty.NilVal, err
+		}
+		return cty.StringVal(s), nil
+	case t.Is(tftypes.Number):
+		n := new(big.Float)
+		if err := value.As(&n); err != nil {
+			return cty.NilVal, err
+		}
+		return cty.NumberVal(n), nil
+	case t.Is(tftypes.Bool):
+		var b bool
+		if err := value.As(&b); err != nil {
+			return cty.NilVal, err
+		}
+		return cty.BoolVal(b), nil
+	case t.Is(tftypes.List{}), t.Is(tftypes.Set{}), t.Is(tftypes.Tuple{}):
+		var elements []tftypes.Value
+		if err := value.As(&elements); err != nil {
+			return cty.NilVal, err
+		}
+		values := make([]cty.Value, 0, len(elements))
+		for _, element := range elements {
+			v, err := ctyValue(element)
+			if err != nil {
+				return cty.NilVal, err
+			}
+			values = append(values, v)
+		}
+		return tupleVal(values), nil
+	case t.Is(tftypes.Map{}), t.Is(tftypes.Object{}):
+		elements := map[string]tftypes.Value{}
+		if err := value.As(&elements); err != nil {
+			return cty.NilVal, err
+		}
+		values := make(map[string]cty.Value, len(elements))
+		for key, element := range elements {
+			if element.IsNull() {
+				continue
+			}
+			v, err := ctyValue(element)
+			if err != nil {
+				return cty.NilVal, err
+			}
+			values[key] = v
+		}
+		return objectVal(values), nil
+	default:
+		return cty.NilVal, fmt.Errorf("unsupported type %s", t)
+	}
+}
+
+// tupleVal returns a tuple, which unlike a list accepts elements of
+// different types, such as objects whose null attributes are omitted.
+func tupleVal(values []cty.Value) cty.Value {
+	if len(values) == 0 {
+		return cty.EmptyTupleVal
+	}
+	return cty.TupleVal(values)
+}
+
+// objectVal returns an object, or an empty object.
+func objectVal(values map[string]cty.Value) cty.Value {
+	if len(values) == 0 {
+		return cty.EmptyObjectVal
+	}
+	return cty.ObjectVal(values)
+}
+
+// sameResource reports whether a reference targets the resource address.
+func sameResource(reference, address hcl.Traversal) bool {
+	return reference.RootName() == address.RootName() &&
+		reference[1].(hcl.TraverseAttr).Name == address[1].(hcl.TraverseAttr).Name
+}
+
+// resourceLabel returns a valid resource name for a display name.
+func resourceLabel(displayName string) string {
+	var b strings.Builder
+	underscore := false
+	for _, r := range strings.ToLower(displayName) {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9', r == '-':
+			b.WriteRune(r)
+			underscore = false
+		case !underscore && b.Len() > 0:
+			b.WriteRune('_')
+			underscore = true
+		}
+	}
+
+	label := strings.TrimRight(b.String(), "_")
+	switch {
+	case label == "":
+		return "resource"
+	case label[0] >= '0' && label[0] <= '9', label[0] == '-':
+		return "r_" + label
+	}
+	return label
+}
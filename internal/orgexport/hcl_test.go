@@ -0,0 +1,168 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package orgexport
+
+import (
+	"context"
+	"testing"
+
+	schemaR "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/standalone"
+)
+
+var (
+	testGatewaySchema = schemaR.Schema{
+		Attributes: map[string]schemaR.Attribute{
+			"id":   schemaR.StringAttribute{Computed: true},
+			"name": schemaR.StringAttribute{Required: true},
+		},
+	}
+
+	testRuleSchema = schemaR.Schema{
+		Attributes: map[string]schemaR.Attribute{
+			"id":                schemaR.StringAttribute{Computed: true},
+			"name":              schemaR.StringAttribute{Required: true},
+			"edge_gateway_id":   schemaR.StringAttribute{Optional: true, Computed: true},
+			"edge_gateway_name": schemaR.StringAttribute{Optional: true, Computed: true},
+			"enabled":           schemaR.BoolAttribute{Optional: true, Computed: true},
+			"priority":          schemaR.Int64Attribute{Optional: true},
+			"password":          schemaR.StringAttribute{Optional: true, Sensitive: true},
+			"ports":             schemaR.ListAttribute{Optional: true, ElementType: types.Int64Type},
+			"source": schemaR.SingleNestedAttribute{
+				Optional: true,
+				Attributes: map[string]schemaR.Attribute{
+					"address": schemaR.StringAttribute{Required: true},
+					"status":  schemaR.StringAttribute{Computed: true},
+				},
+			},
+			"timeouts": schemaR.SingleNestedAttribute{
+				Optional:   true,
+				Attributes: map[string]schemaR.Attribute{"create": schemaR.StringAttribute{Optional: true}},
+			},
+		},
+	}
+)
+
+func testResource(t *testing.T, s schemaR.Schema, typeName, displayName, importID string, values map[string]tftypes.Value) standalone.Resource {
+	t.Helper()
+
+	objectType := s.Type().TerraformType(context.Background()).(tftypes.Object)
+	for name, attributeType := range objectType.AttributeTypes {
+		if _, ok := values[name]; !ok {
+			values[name] = tftypes.NewValue(attributeType, nil)
+		}
+	}
+
+	return standalone.Resource{
+		TypeName:    typeName,
+		DisplayName: displayName,
+		State:       tfsdk.State{Schema: s, Raw: tftypes.NewValue(objectType, values)},
+		ImportID:    importID,
+	}
+}
+
+func TestRender(t *testing.T) {
+	const gatewayID = "urn:vcloud:gateway:1"
+	sourceType := testRuleSchema.Attributes["source"].GetType().TerraformType(context.Background())
+
+	resources := []standalone.Resource{
+		testResource(t, testGatewaySchema, "cloudavenue_edgegateway", "tn01e02ocb0001234spt101", gatewayID, map[string]tftypes.Value{
+			"id":   tftypes.NewValue(tftypes.String, gatewayID),
+			"name": tftypes.NewValue(tftypes.String, "tn01e02ocb0001234spt101"),
+		}),
+		testResource(t, testRuleSchema, "cloudavenue_edgegateway_nat_rule", "SNAT web", "edge.rule-1", map[string]tftypes.Value{
+			"id":                tftypes.NewValue(tftypes.String, "rule-1"),
+			"name":              tftypes.NewValue(tftypes.String, "SNAT web"),
+			"edge_gateway_id":   tftypes.NewValue(tftypes.String, gatewayID),
+			"edge_gateway_name": tftypes.NewValue(tftypes.String, "tn01e02ocb0001234spt101"),
+			"enabled":           tftypes.NewValue(tftypes.Bool, true),
+			"priority":          tftypes.NewValue(tftypes.Number, 10),
+			"password":          tftypes.NewValue(tftypes.String, "s3cr3t"),
+			"ports":             tftypes.NewValue(tftypes.List{ElementType: tftypes.Number}, []tftypes.Value{tftypes.NewValue(tftypes.Number, 80), tftypes.NewValue(tftypes.Number, 443)}),
+			"source": tftypes.NewValue(sourceType, map[string]tftypes.Value{
+				"address": tftypes.NewValue(tftypes.String, "10.0.0.1"),
+				"status":  tftypes.NewValue(tftypes.String, "up"),
+			}),
+		}),
+		// Same display name: the label is deduplicated. No import ID: no
+		// import block.
+		testResource(t, testRuleSchema, "cloudavenue_edgegateway_nat_rule", "SNAT web", "", map[string]tftypes.Value{
+			"id":   tftypes.NewValue(tftypes.String, "rule-2"),
+			"name": tftypes.NewValue(tftypes.String, "SNAT web"),
+		}),
+	}
+
+	files, err := Render(resources)
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+
+	want := map[string]string{
+		"cloudavenue_edgegateway.tf": `resource "cloudavenue_edgegateway" "tn01e02ocb0001234spt101" {
+  name = "tn01e02ocb0001234spt101"
+}
+`,
+		"cloudavenue_edgegateway_nat_rule.tf": `resource "cloudavenue_edgegateway_nat_rule" "snat_web" {
+  edge_gateway_id = cloudavenue_edgegateway.tn01e02ocb0001234spt101.id
+  enabled         = true
+  name            = "SNAT web"
+  ports           = [80, 443]
+  priority        = 10
+  source = {
+    address = "10.0.0.1"
+  }
+}
+
+resource "cloudavenue_edgegateway_nat_rule" "snat_web_2" {
+  name = "SNAT web"
+}
+`,
+		ImportsFile: `import {
+  to = cloudavenue_edgegateway.tn01e02ocb0001234spt101
+  id = "urn:vcloud:gateway:1"
+}
+
+import {
+  to = cloudavenue_edgegateway_nat_rule.snat_web
+  id = "edge.rule-1"
+}
+`,
+	}
+
+	if len(files) != len(want) {
+		t.Errorf("files = %d, want %d", len(files), len(want))
+	}
+	for name, content := range want {
+		if got := string(files[name]); got != content {
+			t.Errorf("%s =\n%s\nwant\n%s", name, got, content)
+		}
+	}
+}
+
+func TestResourceLabel(t *testing.T) {
+	tests := map[string]string{
+		"web":          "web",
+		"My VM (prod)": "my_vm_prod",
+		"tn01-e02.ocb": "tn01-e02_ocb",
+		"01-backup":    "r_01-backup",
+		"":             "resource",
+		"***":          "resource",
+	}
+
+	for displayName, want := range tests {
+		if got := resourceLabel(displayName); got != want {
+			t.Errorf("resourceLabel(%q) = %q, want %q", displayName, got, want)
+		}
+	}
+}
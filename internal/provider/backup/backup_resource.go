@@ -31,12 +31,14 @@ import (
 	"github.com/orange-cloudavenue/cloudavenue-sdk-go/v1/netbackup"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/metrics"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/identity"
 )
 
 var (
-	_ resource.Resource                = &backupResource{}
-	_ resource.ResourceWithConfigure   = &backupResource{}
-	_ resource.ResourceWithImportState = &backupResource{}
+	_ resource.Resource                 = &backupResource{}
+	_ resource.ResourceWithConfigure    = &backupResource{}
+	_ resource.ResourceWithImportState  = &backupResource{}
+	_ identity.ResourceWithIdentitySpec = &backupResource{}
 )
 
 const (
@@ -336,6 +338,22 @@ func (r *backupResource) ImportState(ctx context.Context, req resource.ImportSta
 	resp.Diagnostics.Append(resp.State.Set(ctx, stateRefreshed)...)
 }
 
+// IdentitySpec returns the identity of the resource.
+func (r *backupResource) IdentitySpec() identity.Spec {
+	return identity.Spec{
+		Attributes: []identity.Attribute{
+			{
+				Name:        "type",
+				Description: "The type of the protected object.",
+			},
+			{
+				Name:        "target_name",
+				Description: "The name of the protected object.",
+			},
+		},
+	}
+}
+
 // * CustomFuncs
 
 type target interface {
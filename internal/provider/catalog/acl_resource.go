@@ -35,13 +35,17 @@ import (
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/metrics"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/adminorg"
 	cerrs "github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/errors"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/identity"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/movestate"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &aclResource{}
-	_ resource.ResourceWithConfigure   = &aclResource{}
-	_ resource.ResourceWithImportState = &aclResource{}
+	_ resource.Resource                 = &aclResource{}
+	_ resource.ResourceWithConfigure    = &aclResource{}
+	_ resource.ResourceWithImportState  = &aclResource{}
+	_ resource.ResourceWithMoveState    = &aclResource{}
+	_ identity.ResourceWithIdentitySpec = &aclResource{}
 )
 
 // NewACLResource is a helper function to simplify the provider implementation.
@@ -264,6 +268,27 @@ func (r *aclResource) ImportState(ctx context.Context, req resource.ImportStateR
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("catalog_name"), catalog.Catalog.Name)...)
 }
 
+// IdentitySpec returns the identity of the resource.
+func (r *aclResource) IdentitySpec() identity.Spec {
+	return identity.Spec{
+		Attributes: []identity.Attribute{
+			{
+				Name:        "catalog_id",
+				Description: "The ID of the catalog.",
+			},
+		},
+	}
+}
+
+// MoveState returns the state movers from the equivalent resources of the VMware vcd provider.
+func (r *aclResource) MoveState(_ context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		movestate.FromVCD("vcd_catalog_access_control", movestate.Attributes{
+			"catalog_id": "catalog_id",
+		}),
+	}
+}
+
 // * Custom Funcs
 
 // read the ACL from the API.
@@ -0,0 +1,70 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package catalog
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/metrics"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/adminorg"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/listresource"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ list.ListResource              = &catalogListResource{}
+	_ list.ListResourceWithConfigure = &catalogListResource{}
+)
+
+// NewCatalogListResource is a helper function to simplify the provider implementation.
+func NewCatalogListResource() list.ListResource {
+	return &catalogListResource{
+		Base: listresource.NewBase(NewCatalogResource),
+	}
+}
+
+// catalogListResource is the list resource implementation.
+type catalogListResource struct {
+	listresource.Base
+}
+
+// ListResourceConfigSchema defines the schema of the list resource configuration.
+func (r *catalogListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{
+		MarkdownDescription: "The `cloudavenue_catalog` list resource allows you to list the catalogs of the organization.",
+	}
+}
+
+// List lists the catalogs of the organization.
+func (r *catalogListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	defer metrics.New("cloudavenue_catalog", r.Client.GetOrgName(), metrics.List)()
+
+	adminOrg, diags := adminorg.Init(r.Client)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	catalogs := adminOrg.ListCatalogs().Catalog
+	items := make([]listresource.Item, 0, len(catalogs))
+	for _, c := range catalogs {
+		items = append(items, listresource.Item{
+			DisplayName: c.Name,
+			Attributes: map[string]string{
+				"name": c.Name,
+			},
+		})
+	}
+
+	stream.Results = r.Results(ctx, req, items)
+}
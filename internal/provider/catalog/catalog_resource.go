@@ -36,16 +36,25 @@ import (
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/metrics"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/adminorg"
 	cerrs "github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/errors"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/identity"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &catalogResource{}
-	_ resource.ResourceWithConfigure   = &catalogResource{}
-	_ resource.ResourceWithImportState = &catalogResource{}
-	_ catalog                          = &catalogResource{}
+	_ resource.Resource                 = &catalogResource{}
+	_ resource.ResourceWithConfigure    = &catalogResource{}
+	_ resource.ResourceWithImportState  = &catalogResource{}
+	_ catalog                           = &catalogResource{}
+	_ identity.ResourceWithIdentitySpec = &catalogResource{}
 )
 
+// catalogAttributePaths are the attributes causing the API errors of a
+// catalog.
+var catalogAttributePaths = cerrs.AttributePaths{
+	cerrs.KindConflict:         path.Root("name"),
+	cerrs.KindInvalidReference: path.Root(storageProfile),
+}
+
 // NewCatalogResource is a helper function to simplify the provider implementation.
 func NewCatalogResource() resource.Resource {
 	return &catalogResource{}
@@ -133,7 +142,7 @@ func (r *catalogResource) Create(ctx context.Context, req resource.CreateRequest
 	// Create catalog
 	c, err := r.createCatalogStorageProfile(plan, storageProfiles)
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating Catalog", err.Error())
+		cerrs.AddAttributeError(&resp.Diagnostics, catalogAttributePaths, cerrs.ActionCreate, "catalog", err)
 		return
 	}
 
@@ -172,7 +181,7 @@ func (r *catalogResource) Read(ctx context.Context, req resource.ReadRequest, re
 			return
 		}
 
-		resp.Diagnostics.AddError("Error reading Catalog", err.Error())
+		cerrs.AddError(&resp.Diagnostics, cerrs.ActionRead, "Catalog", err)
 		return
 	}
 
@@ -246,7 +255,7 @@ func (r *catalogResource) Update(ctx context.Context, req resource.UpdateRequest
 		// If field has changed, update it
 		err = newAdminCatalog.Update()
 		if err != nil {
-			resp.Diagnostics.AddError("Error updating Catalog", err.Error())
+			cerrs.AddAttributeError(&resp.Diagnostics, catalogAttributePaths, cerrs.ActionUpdate, "catalog", err)
 			return
 		}
 	}
@@ -288,7 +297,7 @@ func (r *catalogResource) Delete(ctx context.Context, req resource.DeleteRequest
 	}
 
 	if err = adminCatalog.Delete(state.DeleteForce.ValueBool(), state.DeleteRecursive.ValueBool()); err != nil {
-		resp.Diagnostics.AddError("Error deleting Catalog", err.Error())
+		cerrs.AddError(&resp.Diagnostics, cerrs.ActionDelete, "catalog", err)
 		return
 	}
 }
@@ -298,6 +307,20 @@ func (r *catalogResource) ImportState(ctx context.Context, req resource.ImportSt
 	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
 }
 
+// IdentitySpec returns the identity of the resource.
+// The catalog is imported by its name, which can be updated.
+func (r *catalogResource) IdentitySpec() identity.Spec {
+	return identity.Spec{
+		Attributes: []identity.Attribute{
+			{
+				Name:        "name",
+				Description: "The name of the catalog.",
+			},
+		},
+		Mutable: true,
+	}
+}
+
 // createCatalogStorageProfile creates a storage profile reference.
 func (r *catalogResource) createCatalogStorageProfile(plan *catalogResourceModel, storageProfiles *govcdtypes.CatalogStorageProfiles) (*govcd.AdminCatalog, error) {
 	return r.adminOrg.CreateCatalogWithStorageProfile(plan.Name.ValueString(), plan.Description.ValueString(), storageProfiles)
@@ -104,7 +104,7 @@ func (d *catalogsDataSource) Read(ctx context.Context, _ datasource.ReadRequest,
 			if cerrs.IsNotFound(err) {
 				return
 			}
-			resp.Diagnostics.AddError("Error reading catalog", err.Error())
+			cerrs.AddError(&resp.Diagnostics, cerrs.ActionRead, "catalog", err)
 			continue
 		}
 
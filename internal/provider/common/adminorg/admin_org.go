@@ -23,6 +23,7 @@ import (
 
 	v1 "github.com/orange-cloudavenue/cloudavenue-sdk-go/v1"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
+	cerrs "github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/errors"
 )
 
 type AdminOrg struct {
@@ -34,7 +35,7 @@ type AdminOrg struct {
 func Init(c *client.CloudAvenue) (adminOrg AdminOrg, diags diag.Diagnostics) {
 	o, err := c.CAVSDK.V1.AdminOrg()
 	if err != nil {
-		diags.AddError("Error reading Org", err.Error())
+		cerrs.AddError(&diags, cerrs.ActionRead, "Org", err)
 		return adminOrg, diags
 	}
 
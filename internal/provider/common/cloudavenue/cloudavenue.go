@@ -24,14 +24,17 @@ import (
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/mutex"
 )
 
-var cAMutexKV = mutex.NewKV()
+// cAMutexKV is the global mutex, so the lock is also shared with the other
+// provider processes when a lock backend is configured.
+var cAMutexKV = mutex.GlobalMutex
 
 const keyLock = "cloudavenue:customer:api:lock"
 
 // Lock
 // lock call to the cloudavenue customer API.
-func Lock(ctx context.Context) {
-	cAMutexKV.KvLock(ctx, keyLock)
+// Unlock must only be called if Lock returns no error.
+func Lock(ctx context.Context) error {
+	return cAMutexKV.KvLock(ctx, keyLock)
 }
 
 // Unlock
@@ -0,0 +1,117 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+// Package deletionprotection provides the deletion_protection attribute of
+// the critical resources.
+//
+// Unlike the prevent_destroy lifecycle argument, the protection is stored in
+// the state of the resource: it survives the refactoring of the modules and
+// is enforced by the provider, both at plan time and in Delete.
+package deletionprotection
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	schemaR "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+// AttributeName is the name of the attribute.
+const AttributeName = "deletion_protection"
+
+// errorSummary is the summary of the errors of a protected resource.
+const errorSummary = "Resource is protected against deletion"
+
+// ResourceAttribute returns the resource schema of the attribute.
+func ResourceAttribute() *schemaR.BoolAttribute {
+	return &schemaR.BoolAttribute{
+		MarkdownDescription: "Protect the resource against its destruction by Terraform. When `true`, the plans destroying or replacing the resource fail, and so does its deletion. Set it to `false` and apply the change before destroying the resource.",
+		Optional:            true,
+		Computed:            true,
+		Default:             booldefault.StaticBool(false),
+	}
+}
+
+// ModifyPlan rejects the plans destroying or replacing a protected resource.
+// It must be deferred at the start of the ModifyPlan method of the resource,
+// so that the replacements required by the method are taken into account
+// along with the ones required by the plan modifiers of the schema.
+//
+// The plan modifiers of the attributes nested in lists, sets and maps are
+// not run (see schemaRequiresReplace): a replacement only required by one of
+// them is not rejected at plan time, the deletion of the resource is then
+// rejected by CheckDelete during the apply.
+func ModifyPlan(ctx context.Context, typeName string, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Create
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	protected, d := isProtected(ctx, req.State)
+	resp.Diagnostics.Append(d...)
+	if !protected {
+		return
+	}
+
+	if req.Plan.Raw.IsNull() {
+		resp.Diagnostics.AddError(
+			errorSummary,
+			typeName+" has "+AttributeName+" enabled and cannot be destroyed. "+
+				"Set "+AttributeName+" to false and apply the change before destroying it.",
+		)
+		return
+	}
+
+	replace, d := schemaRequiresReplace(ctx, req)
+	resp.Diagnostics.Append(d...)
+	replace = append(replace, resp.RequiresReplace...)
+
+	if len(replace) > 0 {
+		attributes := make([]string, 0, len(replace))
+		for _, p := range replace {
+			attributes = append(attributes, p.String())
+		}
+		resp.Diagnostics.AddError(
+			errorSummary,
+			typeName+" has "+AttributeName+" enabled and cannot be replaced, the change of "+strings.Join(attributes, ", ")+" requires a replacement. "+
+				"Set "+AttributeName+" to false and apply the change before replacing it.",
+		)
+	}
+}
+
+// CheckDelete rejects the deletion of a protected resource. The plan check
+// usually rejects the deletion first.
+func CheckDelete(ctx context.Context, typeName string, state tfsdk.State) (diags diag.Diagnostics) {
+	protected, d := isProtected(ctx, state)
+	diags.Append(d...)
+	if protected {
+		diags.AddError(
+			errorSummary,
+			typeName+" has "+AttributeName+" enabled and cannot be deleted. "+
+				"Set "+AttributeName+" to false and apply the change before deleting it.",
+		)
+	}
+	return diags
+}
+
+// isProtected reports whether the protection is enabled in the state. The
+// states written before the attribute existed are not protected.
+func isProtected(ctx context.Context, state tfsdk.State) (bool, diag.Diagnostics) {
+	var protected *bool
+	d := state.GetAttribute(ctx, path.Root(AttributeName), &protected)
+	if d.HasError() || protected == nil {
+		return false, d
+	}
+	return *protected, d
+}
@@ -0,0 +1,182 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package deletionprotection_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	supertypes "github.com/orange-cloudavenue/terraform-plugin-framework-supertypes"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/deletionprotection"
+)
+
+const testTypeName = "cloudavenue_test"
+
+func testSchema() schema.Schema {
+	protection := deletionprotection.ResourceAttribute()
+	protection.CustomType = supertypes.BoolType{}
+
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required:      true,
+				CustomType:    supertypes.StringType{},
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"description":                    schema.StringAttribute{Optional: true},
+			deletionprotection.AttributeName: protection,
+		},
+	}
+}
+
+// testState returns a state of the test schema, a nil protection is a null
+// value.
+func testState(ctx context.Context, t *testing.T, protection *bool) tfsdk.State {
+	t.Helper()
+	return testStateWith(ctx, t, "my-resource", "", protection)
+}
+
+func testStateWith(ctx context.Context, t *testing.T, name, description string, protection *bool) tfsdk.State {
+	t.Helper()
+
+	s := testSchema()
+	objectType := s.Type().TerraformType(ctx).(tftypes.Object)
+
+	var protectionValue tftypes.Value
+	if protection == nil {
+		protectionValue = tftypes.NewValue(tftypes.Bool, nil)
+	} else {
+		protectionValue = tftypes.NewValue(tftypes.Bool, *protection)
+	}
+
+	return tfsdk.State{
+		Schema: s,
+		Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+			"name":                           tftypes.NewValue(tftypes.String, name),
+			"description":                    tftypes.NewValue(tftypes.String, description),
+			deletionprotection.AttributeName: protectionValue,
+		}),
+	}
+}
+
+func TestResourceAttribute(t *testing.T) {
+	ctx := t.Context()
+	if diags := testSchema().ValidateImplementation(ctx); diags.HasError() {
+		t.Fatalf("schema validation diagnostics: %+v", diags)
+	}
+}
+
+func TestCheckDelete(t *testing.T) {
+	ctx := t.Context()
+	enabled, disabled := true, false
+
+	tests := []struct {
+		name       string
+		protection *bool
+		wantErr    bool
+	}{
+		{name: "Enabled", protection: &enabled, wantErr: true},
+		{name: "Disabled", protection: &disabled},
+		{name: "State without the attribute", protection: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diags := deletionprotection.CheckDelete(ctx, testTypeName, testState(ctx, t, tt.protection))
+			if diags.HasError() != tt.wantErr {
+				t.Fatalf("CheckDelete() diagnostics = %+v, wantErr %t", diags, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestModifyPlan(t *testing.T) {
+	ctx := t.Context()
+	enabled, disabled := true, false
+
+	s := testSchema()
+	null := tftypes.NewValue(s.Type().TerraformType(ctx), nil)
+
+	tests := []struct {
+		name           string
+		state          tfsdk.State
+		plan           tfsdk.Plan
+		requireReplace bool
+		wantErr        bool
+	}{
+		{
+			name:  "Create",
+			state: tfsdk.State{Schema: s, Raw: null},
+			plan:  tfsdk.Plan(testState(ctx, t, &enabled)),
+		},
+		{
+			name:    "Destroy protected",
+			state:   testState(ctx, t, &enabled),
+			plan:    tfsdk.Plan{Schema: s, Raw: null},
+			wantErr: true,
+		},
+		{
+			name:  "Destroy unprotected",
+			state: testState(ctx, t, &disabled),
+			plan:  tfsdk.Plan{Schema: s, Raw: null},
+		},
+		{
+			name:  "Update protected",
+			state: testState(ctx, t, &enabled),
+			plan:  tfsdk.Plan(testState(ctx, t, &disabled)),
+		},
+		{
+			name:    "Replace protected by the schema",
+			state:   testState(ctx, t, &enabled),
+			plan:    tfsdk.Plan(testStateWith(ctx, t, "renamed", "", &enabled)),
+			wantErr: true,
+		},
+		{
+			name:  "Replace unprotected by the schema",
+			state: testState(ctx, t, &disabled),
+			plan:  tfsdk.Plan(testStateWith(ctx, t, "renamed", "", &disabled)),
+		},
+		{
+			name:  "Update protected without replacement",
+			state: testState(ctx, t, &enabled),
+			plan:  tfsdk.Plan(testStateWith(ctx, t, "my-resource", "new description", &enabled)),
+		},
+		{
+			name:           "Replace protected",
+			state:          testState(ctx, t, &enabled),
+			plan:           tfsdk.Plan(testState(ctx, t, &enabled)),
+			requireReplace: true,
+			wantErr:        true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := &resource.ModifyPlanResponse{Plan: tt.plan}
+			if tt.requireReplace {
+				resp.RequiresReplace = append(resp.RequiresReplace, path.Root("name"))
+			}
+
+			deletionprotection.ModifyPlan(ctx, testTypeName, resource.ModifyPlanRequest{State: tt.state, Plan: tt.plan, Config: tfsdk.Config(tt.plan)}, resp)
+			if resp.Diagnostics.HasError() != tt.wantErr {
+				t.Fatalf("ModifyPlan() diagnostics = %+v, wantErr %t", resp.Diagnostics, tt.wantErr)
+			}
+		})
+	}
+}
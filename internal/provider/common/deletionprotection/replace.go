@@ -0,0 +1,297 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package deletionprotection
+
+import (
+	"context"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	schemaR "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// schemaRequiresReplace returns the attributes whose plan modifiers require
+// the replacement of the resource.
+//
+// The framework does not pass the replacements required by the plan
+// modifiers of the schema to the ModifyPlan method of the resource, so the
+// plan modifiers of the changed attributes are run again. The attributes
+// nested in lists, sets and maps are not inspected, only the plan modifiers
+// of the collection itself.
+func schemaRequiresReplace(ctx context.Context, req resource.ModifyPlanRequest) (paths path.Paths, diags diag.Diagnostics) {
+	attributes := req.Plan.Schema.GetAttributes()
+
+	names := make([]string, 0, len(attributes))
+	for name := range attributes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		p, d := attributeRequiresReplace(ctx, req, path.Root(name), attributes[name])
+		diags.Append(d...)
+		paths = append(paths, p...)
+	}
+
+	return paths, diags
+}
+
+// attributeRequiresReplace returns the attribute if one of its plan
+// modifiers requires the replacement of the resource, and the nested
+// attributes requiring it.
+func attributeRequiresReplace(ctx context.Context, req resource.ModifyPlanRequest, p path.Path, attribute any) (paths path.Paths, diags diag.Diagnostics) {
+	var config, plan, state attr.Value
+	diags.Append(req.Config.GetAttribute(ctx, p, &config)...)
+	diags.Append(req.Plan.GetAttribute(ctx, p, &plan)...)
+	diags.Append(req.State.GetAttribute(ctx, p, &state)...)
+	if diags.HasError() || plan.Equal(state) {
+		return nil, diags
+	}
+
+	var replace bool
+
+	switch a := attribute.(type) {
+	case interface{ StringPlanModifiers() []planmodifier.String }:
+		replace = runStringModifiers(ctx, req, p, a.StringPlanModifiers(), config, plan, state, &diags)
+	case interface{ BoolPlanModifiers() []planmodifier.Bool }:
+		replace = runBoolModifiers(ctx, req, p, a.BoolPlanModifiers(), config, plan, state, &diags)
+	case interface{ Int64PlanModifiers() []planmodifier.Int64 }:
+		replace = runInt64Modifiers(ctx, req, p, a.Int64PlanModifiers(), config, plan, state, &diags)
+	case interface{ Int32PlanModifiers() []planmodifier.Int32 }:
+		replace = runInt32Modifiers(ctx, req, p, a.Int32PlanModifiers(), config, plan, state, &diags)
+	case interface{ Float64PlanModifiers() []planmodifier.Float64 }:
+		replace = runFloat64Modifiers(ctx, req, p, a.Float64PlanModifiers(), config, plan, state, &diags)
+	case interface{ ListPlanModifiers() []planmodifier.List }:
+		replace = runListModifiers(ctx, req, p, a.ListPlanModifiers(), config, plan, state, &diags)
+	case interface{ SetPlanModifiers() []planmodifier.Set }:
+		replace = runSetModifiers(ctx, req, p, a.SetPlanModifiers(), config, plan, state, &diags)
+	case interface{ MapPlanModifiers() []planmodifier.Map }:
+		replace = runMapModifiers(ctx, req, p, a.MapPlanModifiers(), config, plan, state, &diags)
+	case interface{ ObjectPlanModifiers() []planmodifier.Object }:
+		replace = runObjectModifiers(ctx, req, p, a.ObjectPlanModifiers(), config, plan, state, &diags)
+	}
+
+	if replace {
+		paths = append(paths, p)
+	}
+
+	if nested, ok := attribute.(schemaR.SingleNestedAttribute); ok {
+		names := make([]string, 0, len(nested.Attributes))
+		for name := range nested.Attributes {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		for _, name := range names {
+			np, d := attributeRequiresReplace(ctx, req, p.AtName(name), nested.Attributes[name])
+			diags.Append(d...)
+			paths = append(paths, np...)
+		}
+	}
+
+	return paths, diags
+}
+
+func runStringModifiers(ctx context.Context, req resource.ModifyPlanRequest, p path.Path, modifiers []planmodifier.String, config, plan, state attr.Value, diags *diag.Diagnostics) bool {
+	if len(modifiers) == 0 {
+		return false
+	}
+
+	r := planmodifier.StringRequest{Path: p, PathExpression: p.Expression(), Config: req.Config, Plan: req.Plan, State: req.State, Private: req.Private}
+	r.ConfigValue = convert(ctx, config, basetypes.StringValuable.ToStringValue, diags)
+	r.PlanValue = convert(ctx, plan, basetypes.StringValuable.ToStringValue, diags)
+	r.StateValue = convert(ctx, state, basetypes.StringValuable.ToStringValue, diags)
+
+	for _, m := range modifiers {
+		resp := &planmodifier.StringResponse{PlanValue: r.PlanValue}
+		m.PlanModifyString(ctx, r, resp)
+		if resp.RequiresReplace {
+			return true
+		}
+	}
+	return false
+}
+
+func runBoolModifiers(ctx context.Context, req resource.ModifyPlanRequest, p path.Path, modifiers []planmodifier.Bool, config, plan, state attr.Value, diags *diag.Diagnostics) bool {
+	if len(modifiers) == 0 {
+		return false
+	}
+
+	r := planmodifier.BoolRequest{Path: p, PathExpression: p.Expression(), Config: req.Config, Plan: req.Plan, State: req.State, Private: req.Private}
+	r.ConfigValue = convert(ctx, config, basetypes.BoolValuable.ToBoolValue, diags)
+	r.PlanValue = convert(ctx, plan, basetypes.BoolValuable.ToBoolValue, diags)
+	r.StateValue = convert(ctx, state, basetypes.BoolValuable.ToBoolValue, diags)
+
+	for _, m := range modifiers {
+		resp := &planmodifier.BoolResponse{PlanValue: r.PlanValue}
+		m.PlanModifyBool(ctx, r, resp)
+		if resp.RequiresReplace {
+			return true
+		}
+	}
+	return false
+}
+
+func runInt64Modifiers(ctx context.Context, req resource.ModifyPlanRequest, p path.Path, modifiers []planmodifier.Int64, config, plan, state attr.Value, diags *diag.Diagnostics) bool {
+	if len(modifiers) == 0 {
+		return false
+	}
+
+	r := planmodifier.Int64Request{Path: p, PathExpression: p.Expression(), Config: req.Config, Plan: req.Plan, State: req.State, Private: req.Private}
+	r.ConfigValue = convert(ctx, config, basetypes.Int64Valuable.ToInt64Value, diags)
+	r.PlanValue = convert(ctx, plan, basetypes.Int64Valuable.ToInt64Value, diags)
+	r.StateValue = convert(ctx, state, basetypes.Int64Valuable.ToInt64Value, diags)
+
+	for _, m := range modifiers {
+		resp := &planmodifier.Int64Response{PlanValue: r.PlanValue}
+		m.PlanModifyInt64(ctx, r, resp)
+		if resp.RequiresReplace {
+			return true
+		}
+	}
+	return false
+}
+
+func runInt32Modifiers(ctx context.Context, req resource.ModifyPlanRequest, p path.Path, modifiers []planmodifier.Int32, config, plan, state attr.Value, diags *diag.Diagnostics) bool {
+	if len(modifiers) == 0 {
+		return false
+	}
+
+	r := planmodifier.Int32Request{Path: p, PathExpression: p.Expression(), Config: req.Config, Plan: req.Plan, State: req.State, Private: req.Private}
+	r.ConfigValue = convert(ctx, config, basetypes.Int32Valuable.ToInt32Value, diags)
+	r.PlanValue = convert(ctx, plan, basetypes.Int32Valuable.ToInt32Value, diags)
+	r.StateValue = convert(ctx, state, basetypes.Int32Valuable.ToInt32Value, diags)
+
+	for _, m := range modifiers {
+		resp := &planmodifier.Int32Response{PlanValue: r.PlanValue}
+		m.PlanModifyInt32(ctx, r, resp)
+		if resp.RequiresReplace {
+			return true
+		}
+	}
+	return false
+}
+
+func runFloat64Modifiers(ctx context.Context, req resource.ModifyPlanRequest, p path.Path, modifiers []planmodifier.Float64, config, plan, state attr.Value, diags *diag.Diagnostics) bool {
+	if len(modifiers) == 0 {
+		return false
+	}
+
+	r := planmodifier.Float64Request{Path: p, PathExpression: p.Expression(), Config: req.Config, Plan: req.Plan, State: req.State, Private: req.Private}
+	r.ConfigValue = convert(ctx, config, basetypes.Float64Valuable.ToFloat64Value, diags)
+	r.PlanValue = convert(ctx, plan, basetypes.Float64Valuable.ToFloat64Value, diags)
+	r.StateValue = convert(ctx, state, basetypes.Float64Valuable.ToFloat64Value, diags)
+
+	for _, m := range modifiers {
+		resp := &planmodifier.Float64Response{PlanValue: r.PlanValue}
+		m.PlanModifyFloat64(ctx, r, resp)
+		if resp.RequiresReplace {
+			return true
+		}
+	}
+	return false
+}
+
+func runListModifiers(ctx context.Context, req resource.ModifyPlanRequest, p path.Path, modifiers []planmodifier.List, config, plan, state attr.Value, diags *diag.Diagnostics) bool {
+	if len(modifiers) == 0 {
+		return false
+	}
+
+	r := planmodifier.ListRequest{Path: p, PathExpression: p.Expression(), Config: req.Config, Plan: req.Plan, State: req.State, Private: req.Private}
+	r.ConfigValue = convert(ctx, config, basetypes.ListValuable.ToListValue, diags)
+	r.PlanValue = convert(ctx, plan, basetypes.ListValuable.ToListValue, diags)
+	r.StateValue = convert(ctx, state, basetypes.ListValuable.ToListValue, diags)
+
+	for _, m := range modifiers {
+		resp := &planmodifier.ListResponse{PlanValue: r.PlanValue}
+		m.PlanModifyList(ctx, r, resp)
+		if resp.RequiresReplace {
+			return true
+		}
+	}
+	return false
+}
+
+func runSetModifiers(ctx context.Context, req resource.ModifyPlanRequest, p path.Path, modifiers []planmodifier.Set, config, plan, state attr.Value, diags *diag.Diagnostics) bool {
+	if len(modifiers) == 0 {
+		return false
+	}
+
+	r := planmodifier.SetRequest{Path: p, PathExpression: p.Expression(), Config: req.Config, Plan: req.Plan, State: req.State, Private: req.Private}
+	r.ConfigValue = convert(ctx, config, basetypes.SetValuable.ToSetValue, diags)
+	r.PlanValue = convert(ctx, plan, basetypes.SetValuable.ToSetValue, diags)
+	r.StateValue = convert(ctx, state, basetypes.SetValuable.ToSetValue, diags)
+
+	for _, m := range modifiers {
+		resp := &planmodifier.SetResponse{PlanValue: r.PlanValue}
+		m.PlanModifySet(ctx, r, resp)
+		if resp.RequiresReplace {
+			return true
+		}
+	}
+	return false
+}
+
+func runMapModifiers(ctx context.Context, req resource.ModifyPlanRequest, p path.Path, modifiers []planmodifier.Map, config, plan, state attr.Value, diags *diag.Diagnostics) bool {
+	if len(modifiers) == 0 {
+		return false
+	}
+
+	r := planmodifier.MapRequest{Path: p, PathExpression: p.Expression(), Config: req.Config, Plan: req.Plan, State: req.State, Private: req.Private}
+	r.ConfigValue = convert(ctx, config, basetypes.MapValuable.ToMapValue, diags)
+	r.PlanValue = convert(ctx, plan, basetypes.MapValuable.ToMapValue, diags)
+	r.StateValue = convert(ctx, state, basetypes.MapValuable.ToMapValue, diags)
+
+	for _, m := range modifiers {
+		resp := &planmodifier.MapResponse{PlanValue: r.PlanValue}
+		m.PlanModifyMap(ctx, r, resp)
+		if resp.RequiresReplace {
+			return true
+		}
+	}
+	return false
+}
+
+func runObjectModifiers(ctx context.Context, req resource.ModifyPlanRequest, p path.Path, modifiers []planmodifier.Object, config, plan, state attr.Value, diags *diag.Diagnostics) bool {
+	if len(modifiers) == 0 {
+		return false
+	}
+
+	r := planmodifier.ObjectRequest{Path: p, PathExpression: p.Expression(), Config: req.Config, Plan: req.Plan, State: req.State, Private: req.Private}
+	r.ConfigValue = convert(ctx, config, basetypes.ObjectValuable.ToObjectValue, diags)
+	r.PlanValue = convert(ctx, plan, basetypes.ObjectValuable.ToObjectValue, diags)
+	r.StateValue = convert(ctx, state, basetypes.ObjectValuable.ToObjectValue, diags)
+
+	for _, m := range modifiers {
+		resp := &planmodifier.ObjectResponse{PlanValue: r.PlanValue}
+		m.PlanModifyObject(ctx, r, resp)
+		if resp.RequiresReplace {
+			return true
+		}
+	}
+	return false
+}
+
+// convert converts a value, possibly of a custom type, to its base type.
+func convert[V any, T any](ctx context.Context, value attr.Value, to func(V, context.Context) (T, diag.Diagnostics), diags *diag.Diagnostics) (converted T) {
+	v, ok := value.(V)
+	if !ok {
+		return converted
+	}
+
+	converted, d := to(v, ctx)
+	diags.Append(d...)
+	return converted
+}
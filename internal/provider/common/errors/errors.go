@@ -8,7 +8,8 @@
  */
 
 // Package errors provides shared error-handling helpers for the provider:
-// unified not-found detection across backends and harmonized diagnostic summaries.
+// unified not-found detection across backends, the classification of the
+// API errors and harmonized diagnostics with remediation hints.
 package errors
 
 import (
@@ -18,6 +19,7 @@ import (
 	"github.com/vmware/go-vcloud-director/v2/govcd"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 
 	commoncloudavenue "github.com/orange-cloudavenue/cloudavenue-sdk-go/pkg/common/cloudavenue"
 	caverrors "github.com/orange-cloudavenue/cloudavenue-sdk-go/pkg/errors"
@@ -46,8 +48,54 @@ const (
 	ActionDelete = "deleting"
 )
 
-// AddError appends a diagnostic with the harmonized summary
-// "Error <verb> <resource>" (e.g. "Error reading vApp") and the raw error as detail.
+// hints are the remediation hints of the error kinds. %[1]s is the
+// resource and %[2]s the verb of the diagnostic, every hint uses the
+// resource so that the arguments of fmt.Sprintf are all consumed.
+var hints = map[Kind]string{
+	KindNotFound:         "The %[1]s does not exist or is not visible to the user of the provider. If it has been deleted outside of Terraform, run a plan to re-create it or remove it from the state.",
+	KindPermissionDenied: "The user of the provider is not allowed to do this operation on the %[1]s. Check the roles and rights of the user in the organization, or ask an administrator of the organization.",
+	KindQuotaExceeded:    "A quota or a limit of the organization has been reached while %[2]s the %[1]s. Release unused resources or ask Cloud Avenue support for a higher quota.",
+	KindEntityBusy:       "The %[1]s is busy completing another operation, e.g. started from the portal or by another Terraform run. Wait for the operation to complete and apply again, or raise the attempts of the retry block of the provider.",
+	KindConflict:         "Another object with the same name or settings as the %[1]s already exists. Choose another name, or bring the existing object under management with an import block.",
+	KindInvalidReference: "The %[1]s references an object that does not exist or cannot be used here. Check the IDs and names of the referenced objects, e.g. the VDC, edge gateway or network.",
+	KindInvalidArgument:  "An argument of the %[1]s is empty or has an invalid format. Check the values of the configuration.",
+}
+
+// NewDiagnostic returns an error diagnostic with the harmonized summary
+// "Error <verb> <resource>" (e.g. "Error reading vApp"). When the error is
+// classified, the kind is added to the summary and the detail starts with a
+// remediation hint, followed by the API error.
+func NewDiagnostic(verb, resource string, err error) diag.Diagnostic {
+	summary := fmt.Sprintf("Error %s %s", verb, resource)
+
+	kind := Classify(err)
+	hint, ok := hints[kind]
+	if !ok {
+		return diag.NewErrorDiagnostic(summary, err.Error())
+	}
+
+	return diag.NewErrorDiagnostic(
+		summary+": "+kind.String(),
+		fmt.Sprintf(hint, resource, verb)+"\n\nAPI error: "+err.Error(),
+	)
+}
+
+// AddError appends the diagnostic of NewDiagnostic.
 func AddError(diags *diag.Diagnostics, verb, resource string, err error) {
-	diags.AddError(fmt.Sprintf("Error %s %s", verb, resource), err.Error())
+	diags.Append(NewDiagnostic(verb, resource, err))
+}
+
+// AttributePaths maps the kinds of the errors caused by the value of an
+// attribute to this attribute, e.g. a conflict to the name of the resource.
+type AttributePaths map[Kind]path.Path
+
+// AddAttributeError appends the diagnostic of NewDiagnostic. The diagnostic
+// targets the attribute of the kind of the error, if any, so that Terraform
+// shows the configuration of the attribute.
+func AddAttributeError(diags *diag.Diagnostics, paths AttributePaths, verb, resource string, err error) {
+	d := NewDiagnostic(verb, resource, err)
+	if p, ok := paths[Classify(err)]; ok {
+		d = diag.WithPath(p, d)
+	}
+	diags.Append(d)
 }
@@ -0,0 +1,159 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package errors
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+	"github.com/vmware/go-vcloud-director/v2/types/v56"
+
+	caverrors "github.com/orange-cloudavenue/cloudavenue-sdk-go/pkg/errors"
+)
+
+func TestClassify(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want Kind
+	}{
+		{name: "Nil", err: nil, want: KindUnknown},
+		{name: "Unknown", err: errors.New("connection reset by peer"), want: KindUnknown},
+		{name: "govcd not found", err: fmt.Errorf("error retrieving vApp: %w", govcd.ErrorEntityNotFound), want: KindNotFound},
+		{
+			name: "VCD minor error code",
+			err:  fmt.Errorf("error creating VDC: %w", &types.Error{MajorErrorCode: 400, MinorErrorCode: "DUPLICATE_NAME", Message: "The name is already used"}),
+			want: KindConflict,
+		},
+		{
+			name: "VCD major error code",
+			err:  &types.Error{MajorErrorCode: 403, MinorErrorCode: "FORBIDDEN_OPERATION", Message: "operation refused"},
+			want: KindPermissionDenied,
+		},
+		{
+			name: "OpenAPI error",
+			err:  fmt.Errorf("error in HTTP POST request: %w", &types.OpenApiError{MinorErrorCode: "INVALID_REFERENCE", Message: "no such network"}),
+			want: KindInvalidReference,
+		},
+		{name: "S3 error code", err: awserr.New("TooManyBuckets", "You have attempted to create more buckets than allowed", nil), want: KindQuotaExceeded},
+		{name: "S3 status code", err: awserr.NewRequestFailure(awserr.New("Unknown", "refused", nil), 409, "req-1"), want: KindConflict},
+		{name: "Formatted busy entity", err: errors.New("error updating VM: API Error: 409: [ BUSY_ENTITY ] The VM is busy completing an operation"), want: KindEntityBusy},
+		{name: "Formatted status code", err: errors.New("error deleting catalog: API Error: 403: denied"), want: KindPermissionDenied},
+		{name: "Formatted access denied", err: errors.New("ACCESS_TO_RESOURCE_IS_FORBIDDEN - Either you need some or all of the following rights"), want: KindPermissionDenied},
+		{name: "Formatted quota", err: errors.New("the VDC CPU quota is exceeded"), want: KindQuotaExceeded},
+		{name: "Formatted duplicate", err: errors.New("a network named net01 already exists"), want: KindConflict},
+		{name: "Formatted invalid reference", err: errors.New("[ INVALID_REFERENCE ] the edge gateway is not valid"), want: KindInvalidReference},
+		{name: "SDK empty value", err: fmt.Errorf("name: %w", caverrors.ErrEmpty), want: KindInvalidArgument},
+		{name: "SDK invalid format", err: fmt.Errorf("id: %w", caverrors.ErrInvalidFormat), want: KindInvalidArgument},
+		{name: "Attribute named quota", err: errors.New("the storage quota attribute must be a positive number"), want: KindUnknown},
+		{name: "Forbidden characters", err: errors.New("the name contains forbidden characters"), want: KindUnknown},
+		{name: "Duplicate word", err: errors.New("duplicate rule ID in the request body"), want: KindUnknown},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Classify(tt.err); got != tt.want {
+				t.Errorf("Classify() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPredicates(t *testing.T) {
+	err := errors.New("[ BUSY_ENTITY ] busy")
+	if !IsEntityBusy(err) || IsConflict(err) || IsPermissionDenied(err) || IsQuotaExceeded(err) || IsInvalidReference(err) {
+		t.Errorf("predicates of %v do not match the entity busy kind", err)
+	}
+}
+
+func TestNewDiagnostic(t *testing.T) {
+	d := NewDiagnostic(ActionCreate, "catalog", errors.New("API Error: 400: [ DUPLICATE_NAME ] catalog01"))
+	if d.Summary() != "Error creating catalog: conflict" {
+		t.Errorf("Summary() = %q", d.Summary())
+	}
+	if !strings.HasPrefix(d.Detail(), "Another object with the same name or settings as the catalog") || !strings.HasSuffix(d.Detail(), "API error: API Error: 400: [ DUPLICATE_NAME ] catalog01") {
+		t.Errorf("Detail() = %q, want a hint and the API error", d.Detail())
+	}
+
+	d = NewDiagnostic(ActionRead, "vApp", errors.New("connection reset by peer"))
+	if d.Summary() != "Error reading vApp" || d.Detail() != "connection reset by peer" {
+		t.Errorf("diagnostic of an unknown error = %q: %q", d.Summary(), d.Detail())
+	}
+}
+
+func TestNewDiagnosticHints(t *testing.T) {
+	errs := map[Kind]error{
+		KindNotFound:         govcd.ErrorEntityNotFound,
+		KindPermissionDenied: &types.Error{MajorErrorCode: 403, MinorErrorCode: "ACCESS_TO_RESOURCE_IS_FORBIDDEN", Message: "denied"},
+		KindQuotaExceeded:    awserr.New("TooManyBuckets", "You have attempted to create more buckets than allowed", nil),
+		KindEntityBusy:       errors.New("[ BUSY_ENTITY ] busy"),
+		KindConflict:         errors.New("[ DUPLICATE_NAME ] vdc01"),
+		KindInvalidReference: errors.New("[ INVALID_REFERENCE ] vdc01"),
+		KindInvalidArgument:  fmt.Errorf("name: %w", caverrors.ErrEmpty),
+	}
+
+	for kind := range hints {
+		t.Run(kind.String(), func(t *testing.T) {
+			err, ok := errs[kind]
+			if !ok {
+				t.Fatalf("no error of the kind %s", kind)
+			}
+
+			d := NewDiagnostic(ActionCreate, "VDC vdc01", err)
+			if d.Summary() != "Error creating VDC vdc01: "+kind.String() {
+				t.Errorf("Summary() = %q", d.Summary())
+			}
+			if strings.Contains(d.Detail(), "%!") {
+				t.Errorf("Detail() = %q, want a formatted hint", d.Detail())
+			}
+			if !strings.Contains(d.Detail(), "VDC vdc01") || !strings.HasSuffix(d.Detail(), "\n\nAPI error: "+err.Error()) {
+				t.Errorf("Detail() = %q, want the resource and the API error", d.Detail())
+			}
+		})
+	}
+}
+
+func TestAddAttributeError(t *testing.T) {
+	paths := AttributePaths{
+		KindConflict:         path.Root("name"),
+		KindInvalidReference: path.Root("edge_gateway_id"),
+	}
+
+	tests := []struct {
+		name     string
+		err      error
+		wantPath path.Path
+	}{
+		{name: "Conflict", err: errors.New("DUPLICATE_NAME"), wantPath: path.Root("name")},
+		{name: "Invalid reference", err: errors.New("INVALID_REFERENCE"), wantPath: path.Root("edge_gateway_id")},
+		{name: "Other kind", err: errors.New("BUSY_ENTITY")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			AddAttributeError(&diags, paths, ActionCreate, "NAT rule", tt.err)
+
+			if diags.ErrorsCount() != 1 {
+				t.Fatalf("diagnostics = %d, want 1", diags.ErrorsCount())
+			}
+			withPath, ok := diags[0].(diag.DiagnosticWithPath)
+			if ok != (tt.wantPath.String() != "") || (ok && !withPath.Path().Equal(tt.wantPath)) {
+				t.Errorf("diagnostic path = %v, want %v", diags[0], tt.wantPath)
+			}
+		})
+	}
+}
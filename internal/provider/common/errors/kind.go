@@ -0,0 +1,222 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package errors
+
+import (
+	"errors"
+	"regexp"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/vmware/go-vcloud-director/v2/types/v56"
+
+	caverrors "github.com/orange-cloudavenue/cloudavenue-sdk-go/pkg/errors"
+)
+
+// Kind is the class of an error returned by the APIs, used to explain the
+// error and how to fix it.
+type Kind int
+
+const (
+	// KindUnknown is an error of no known class.
+	KindUnknown Kind = iota
+	// KindNotFound is an object that does not exist.
+	KindNotFound
+	// KindPermissionDenied is an operation the user is not allowed to do.
+	KindPermissionDenied
+	// KindQuotaExceeded is a quota or a limit of the organization reached.
+	KindQuotaExceeded
+	// KindEntityBusy is an object busy completing another operation.
+	KindEntityBusy
+	// KindConflict is an object that already exists, e.g. a duplicate name.
+	KindConflict
+	// KindInvalidReference is a reference to an object that does not exist
+	// or cannot be used.
+	KindInvalidReference
+	// KindInvalidArgument is an argument rejected by the Cloud Avenue SDK
+	// before calling the API, e.g. an empty name.
+	KindInvalidArgument
+)
+
+// String returns the description of the kind used in the diagnostics.
+func (k Kind) String() string {
+	switch k {
+	case KindNotFound:
+		return "not found"
+	case KindPermissionDenied:
+		return "permission denied"
+	case KindQuotaExceeded:
+		return "quota exceeded"
+	case KindEntityBusy:
+		return "entity busy"
+	case KindConflict:
+		return "conflict"
+	case KindInvalidReference:
+		return "invalid reference"
+	case KindInvalidArgument:
+		return "invalid argument"
+	default:
+		return "unknown"
+	}
+}
+
+var (
+	// minorErrorCodes are the error codes of the VMware Cloud Director API.
+	minorErrorCodes = map[string]Kind{
+		"ACCESS_TO_RESOURCE_IS_FORBIDDEN": KindPermissionDenied,
+		"BUSY_ENTITY":                     KindEntityBusy,
+		"DUPLICATE_NAME":                  KindConflict,
+		"INVALID_REFERENCE":               KindInvalidReference,
+	}
+
+	// s3ErrorCodes are the error codes of the S3 API.
+	s3ErrorCodes = map[string]Kind{
+		"AccessDenied":            KindPermissionDenied,
+		"TooManyBuckets":          KindQuotaExceeded,
+		"QuotaExceeded":           KindQuotaExceeded,
+		"OperationAborted":        KindEntityBusy,
+		"BucketAlreadyExists":     KindConflict,
+		"BucketAlreadyOwnedByYou": KindConflict,
+	}
+
+	// statusCodes are the HTTP status codes of a single kind.
+	statusCodes = map[int]Kind{
+		403: KindPermissionDenied,
+		409: KindConflict,
+	}
+
+	// sdkErrors are the errors of the Cloud Avenue SDK.
+	sdkErrors = map[error]Kind{
+		caverrors.ErrEmpty:         KindInvalidArgument,
+		caverrors.ErrInvalidFormat: KindInvalidArgument,
+	}
+
+	// messageMarkers match the texts identifying the kind of an error that
+	// has been wrapped as a string, in the order they are tested: govcd and
+	// the Cloud Avenue SDK mostly return the API errors formatted in their
+	// own errors. The markers are error codes or complete phrases, not
+	// single words that may occur in any message (e.g. the name of an
+	// attribute). They are tested before the HTTP status code, e.g. a busy
+	// entity may be reported with the status 409.
+	messageMarkers = []struct {
+		kind    Kind
+		markers *regexp.Regexp
+	}{
+		{KindEntityBusy, regexp.MustCompile(`\bBUSY_ENTITY\b|\bOperationAborted\b|(?i)\bis busy (completing|with)\b`)},
+		{KindPermissionDenied, regexp.MustCompile(`\bACCESS_TO_RESOURCE_IS_FORBIDDEN\b|\bAccessDenied\b|(?i)\b(access is denied|permission denied|is not authorized to)\b`)},
+		{KindQuotaExceeded, regexp.MustCompile(`\bQUOTA_EXCEEDED\b|\bTooManyBuckets\b|\bQuotaExceeded\b|(?i)\b(quota (is |has been )?(exceeded|reached)|exceeds? the (quota|limit)|limit (is |has been )?exceeded)\b`)},
+		{KindConflict, regexp.MustCompile(`\bDUPLICATE_NAME\b|\bBucketAlreadyExists\b|\bBucketAlreadyOwnedByYou\b|(?i)\b(already exists|is already in use)\b`)},
+		{KindInvalidReference, regexp.MustCompile(`\bINVALID_REFERENCE\b|(?i)\binvalid reference\b`)},
+	}
+
+	// apiErrorStatus matches the HTTP status code in a formatted
+	// types.Error ("API Error: 403: ...").
+	apiErrorStatus = regexp.MustCompile(`API Error: (\d{3})`)
+)
+
+// statusCoder is implemented by the errors holding the HTTP status code of
+// the response, e.g. awserr.RequestFailure.
+type statusCoder interface {
+	StatusCode() int
+}
+
+// Classify returns the kind of an error from any backend used by the
+// provider: the Cloud Avenue SDK, govcd or the S3 API.
+func Classify(err error) Kind {
+	if err == nil {
+		return KindUnknown
+	}
+	if IsNotFound(err) {
+		return KindNotFound
+	}
+	if kind := classifyType(err); kind != KindUnknown {
+		return kind
+	}
+	return classifyMessage(err.Error())
+}
+
+// IsPermissionDenied reports whether err is an operation the user is not
+// allowed to do.
+func IsPermissionDenied(err error) bool {
+	return Classify(err) == KindPermissionDenied
+}
+
+// IsQuotaExceeded reports whether err is a quota or a limit reached.
+func IsQuotaExceeded(err error) bool {
+	return Classify(err) == KindQuotaExceeded
+}
+
+// IsEntityBusy reports whether err is an object busy completing another
+// operation.
+func IsEntityBusy(err error) bool {
+	return Classify(err) == KindEntityBusy
+}
+
+// IsConflict reports whether err is an object that already exists.
+func IsConflict(err error) bool {
+	return Classify(err) == KindConflict
+}
+
+// IsInvalidReference reports whether err is a reference to an object that
+// does not exist or cannot be used.
+func IsInvalidReference(err error) bool {
+	return Classify(err) == KindInvalidReference
+}
+
+// classifyType returns the kind of the typed errors of the APIs and the
+// Cloud Avenue SDK.
+func classifyType(err error) Kind {
+	for sdkErr, kind := range sdkErrors {
+		if errors.Is(err, sdkErr) {
+			return kind
+		}
+	}
+
+	var (
+		vcdErr     *types.Error
+		openAPIErr *types.OpenApiError
+		awsErr     awserr.Error
+		statusErr  statusCoder
+	)
+
+	switch {
+	case errors.As(err, &vcdErr):
+		if kind, ok := minorErrorCodes[vcdErr.MinorErrorCode]; ok {
+			return kind
+		}
+		return statusCodes[vcdErr.MajorErrorCode]
+	case errors.As(err, &openAPIErr):
+		return minorErrorCodes[openAPIErr.MinorErrorCode]
+	case errors.As(err, &awsErr):
+		if kind, ok := s3ErrorCodes[awsErr.Code()]; ok {
+			return kind
+		}
+	}
+
+	if errors.As(err, &statusErr) {
+		return statusCodes[statusErr.StatusCode()]
+	}
+	return KindUnknown
+}
+
+// classifyMessage returns the kind of an error formatted as a string.
+func classifyMessage(message string) Kind {
+	for _, m := range messageMarkers {
+		if m.markers.MatchString(message) {
+			return m.kind
+		}
+	}
+
+	if m := apiErrorStatus.FindStringSubmatch(message); m != nil {
+		code, _ := strconv.Atoi(m[1])
+		return statusCodes[code]
+	}
+	return KindUnknown
+}
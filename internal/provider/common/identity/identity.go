@@ -0,0 +1,223 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+// Package identity provides the resource identity of the provider resources.
+//
+// A resource describes its identity with a Spec: the identity attributes are
+// copied from the state after each Create, Read and Update, and an import
+// using an identity is converted to the import ID format of the resource.
+//
+// The changes of the resources of a read-only provider are rejected by
+// readonly.Wrap, applied before Wrap.
+package identity
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// OrgAttribute is the identity attribute set by every resource with the name
+// of the organization.
+const OrgAttribute = "org"
+
+// defaultSeparator is the separator of the import ID parts.
+const defaultSeparator = "."
+
+// Spec describes the identity of a resource.
+type Spec struct {
+	// Attributes are the identity attributes, in the order of the import ID
+	// of the resource.
+	Attributes []Attribute
+
+	// Separator is the separator of the import ID parts. Defaults to ".".
+	Separator string
+
+	// Mutable must be set if an identity attribute can change during the
+	// lifecycle of the resource (e.g. an updatable name).
+	Mutable bool
+}
+
+// Attribute is an identity attribute.
+type Attribute struct {
+	// Name is the name of the identity attribute.
+	Name string
+
+	// Description is the description of the identity attribute.
+	Description string
+
+	// StatePath is the path of the state attribute holding the value.
+	// Defaults to the root attribute with the same name.
+	StatePath path.Path
+
+	// Optional is set if the attribute can be omitted from the identity of
+	// an import block. An omitted attribute is also omitted from the import ID.
+	Optional bool
+
+	// SeparatorAllowed is set if the import of the resource parses the
+	// values of the attribute containing the separator (e.g. an IPv4
+	// address). The other values containing the separator are rejected,
+	// the import would split them.
+	SeparatorAllowed bool
+}
+
+// ResourceWithIdentitySpec is implemented by the resources with an identity.
+type ResourceWithIdentitySpec interface {
+	IdentitySpec() Spec
+}
+
+func (a Attribute) statePath() path.Path {
+	if len(a.StatePath.Steps()) == 0 {
+		return path.Root(a.Name)
+	}
+	return a.StatePath
+}
+
+func (s Spec) separator() string {
+	if s.Separator == "" {
+		return defaultSeparator
+	}
+	return s.Separator
+}
+
+// Schema returns the identity schema of the resource.
+func (s Spec) Schema() identityschema.Schema {
+	attributes := map[string]identityschema.Attribute{
+		OrgAttribute: identityschema.StringAttribute{
+			Description:       "The name of the organization. Defaults to the organization of the provider.",
+			OptionalForImport: true,
+		},
+	}
+
+	for _, a := range s.Attributes {
+		attributes[a.Name] = identityschema.StringAttribute{
+			Description:       a.Description,
+			RequiredForImport: !a.Optional,
+			OptionalForImport: a.Optional,
+		}
+	}
+
+	return identityschema.Schema{
+		Attributes: attributes,
+	}
+}
+
+// SetFromState sets the identity from the values of the state.
+func (s Spec) SetFromState(ctx context.Context, orgName string, state tfsdk.State, identity *tfsdk.ResourceIdentity) (diags diag.Diagnostics) {
+	if identity == nil || state.Raw.IsNull() {
+		return diags
+	}
+
+	diags.Append(identity.SetAttribute(ctx, path.Root(OrgAttribute), orgName)...)
+
+	for _, a := range s.Attributes {
+		v, d := stringAttribute(ctx, state, a.statePath())
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+		diags.Append(identity.SetAttribute(ctx, path.Root(a.Name), v)...)
+	}
+
+	return diags
+}
+
+// ImportID returns the import ID of the resource built from the identity.
+func (s Spec) ImportID(ctx context.Context, orgName string, identity *tfsdk.ResourceIdentity) (string, diag.Diagnostics) {
+	var (
+		diags diag.Diagnostics
+		org   types.String
+		parts = make([]string, 0, len(s.Attributes))
+	)
+
+	if identity == nil {
+		diags.AddError("Missing resource identity", "The import requires either an import ID or a resource identity.")
+		return "", diags
+	}
+
+	diags.Append(identity.GetAttribute(ctx, path.Root(OrgAttribute), &org)...)
+	if diags.HasError() {
+		return "", diags
+	}
+
+	if v := org.ValueString(); v != "" && orgName != "" && !strings.EqualFold(v, orgName) {
+		diags.AddAttributeError(
+			path.Root(OrgAttribute),
+			"Invalid resource identity",
+			fmt.Sprintf("The organization %q of the identity does not match the organization %q of the provider.", v, orgName),
+		)
+		return "", diags
+	}
+
+	var separated []Attribute
+	for _, a := range s.Attributes {
+		var v types.String
+		diags.Append(identity.GetAttribute(ctx, path.Root(a.Name), &v)...)
+		if diags.HasError() {
+			return "", diags
+		}
+
+		if v.ValueString() == "" {
+			if a.Optional {
+				continue
+			}
+			diags.AddAttributeError(path.Root(a.Name), "Invalid resource identity", fmt.Sprintf("The identity attribute %q is required.", a.Name))
+			return "", diags
+		}
+		if !a.SeparatorAllowed && strings.Contains(v.ValueString(), s.separator()) {
+			separated = append(separated, a)
+		}
+		parts = append(parts, v.ValueString())
+	}
+
+	// A single part is never split.
+	if len(parts) > 1 {
+		for _, a := range separated {
+			diags.AddAttributeError(
+				path.Root(a.Name),
+				"Invalid resource identity",
+				fmt.Sprintf("The identity attribute %q contains %q, the separator of the import ID of the resource. Use the ID of the object instead of its name, or import the resource with an import ID.", a.Name, s.separator()),
+			)
+		}
+		if diags.HasError() {
+			return "", diags
+		}
+	}
+
+	return strings.Join(parts, s.separator()), diags
+}
+
+// stringAttribute returns the value of a string state attribute, whatever
+// its custom type.
+func stringAttribute(ctx context.Context, state tfsdk.State, p path.Path) (types.String, diag.Diagnostics) {
+	var v attr.Value
+
+	diags := state.GetAttribute(ctx, p, &v)
+	if diags.HasError() || v == nil {
+		return types.StringNull(), diags
+	}
+
+	sv, ok := v.(basetypes.StringValuable)
+	if !ok {
+		diags.AddAttributeError(p, "Invalid identity attribute", fmt.Sprintf("The attribute %s is not a string.", p))
+		return types.StringNull(), diags
+	}
+
+	s, d := sv.ToStringValue(ctx)
+	diags.Append(d...)
+	return s, diags
+}
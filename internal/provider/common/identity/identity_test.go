@@ -0,0 +1,207 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package identity_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	supertypes "github.com/orange-cloudavenue/terraform-plugin-framework-supertypes"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/identity"
+)
+
+const testOrg = "cav01ev01ocb0001234"
+
+type testClient struct{}
+
+func (testClient) GetOrgName() string { return testOrg }
+
+type testResource struct {
+	importID string
+}
+
+func (r *testResource) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "cloudavenue_test"
+}
+
+func (r *testResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id":              schema.StringAttribute{Computed: true, CustomType: supertypes.StringType{}},
+			"edge_gateway_id": schema.StringAttribute{Optional: true},
+			"vdc":             schema.StringAttribute{Optional: true},
+		},
+	}
+}
+
+func (r *testResource) Create(ctx context.Context, _ resource.CreateRequest, resp *resource.CreateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), supertypes.NewStringValue("urn:vcloud:firewall:1"))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("edge_gateway_id"), types.StringValue("urn:vcloud:gateway:1"))...)
+}
+
+func (r *testResource) Read(context.Context, resource.ReadRequest, *resource.ReadResponse) {}
+
+func (r *testResource) Update(context.Context, resource.UpdateRequest, *resource.UpdateResponse) {}
+
+func (r *testResource) Delete(context.Context, resource.DeleteRequest, *resource.DeleteResponse) {}
+
+func (r *testResource) ImportState(_ context.Context, req resource.ImportStateRequest, _ *resource.ImportStateResponse) {
+	r.importID = req.ID
+}
+
+func (r *testResource) IdentitySpec() identity.Spec {
+	return identity.Spec{
+		Attributes: []identity.Attribute{
+			{Name: "vdc", Optional: true},
+			{Name: "edge_gateway_id"},
+			{Name: "id"},
+		},
+	}
+}
+
+func newTestResource(t *testing.T) (resource.Resource, *testResource, schema.Schema, resource.IdentitySchemaResponse) {
+	t.Helper()
+
+	ctx := t.Context()
+	inner := &testResource{}
+
+	r := identity.Wrap([]func() resource.Resource{func() resource.Resource { return inner }})[0]()
+
+	rc, ok := r.(resource.ResourceWithConfigure)
+	if !ok {
+		t.Fatal("wrapped resource does not implement ResourceWithConfigure")
+	}
+	rc.Configure(ctx, resource.ConfigureRequest{ProviderData: testClient{}}, &resource.ConfigureResponse{})
+
+	schemaResp := resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	ri, ok := r.(resource.ResourceWithIdentity)
+	if !ok {
+		t.Fatal("wrapped resource does not implement ResourceWithIdentity")
+	}
+	identityResp := resource.IdentitySchemaResponse{}
+	ri.IdentitySchema(ctx, resource.IdentitySchemaRequest{}, &identityResp)
+
+	if diags := identityResp.IdentitySchema.ValidateImplementation(ctx); diags.HasError() {
+		t.Fatalf("identity schema validation diagnostics: %+v", diags)
+	}
+
+	return r, inner, schemaResp.Schema, identityResp
+}
+
+func TestWrapSetsIdentityOnCreate(t *testing.T) {
+	ctx := t.Context()
+	r, _, s, identityResp := newTestResource(t)
+
+	resp := &resource.CreateResponse{
+		State: tfsdk.State{
+			Schema: s,
+			Raw:    tftypes.NewValue(s.Type().TerraformType(ctx), nil),
+		},
+		Identity: &tfsdk.ResourceIdentity{
+			Schema: identityResp.IdentitySchema,
+			Raw:    tftypes.NewValue(identityResp.IdentitySchema.Type().TerraformType(ctx), nil),
+		},
+	}
+	r.Create(ctx, resource.CreateRequest{}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Create() diagnostics: %+v", resp.Diagnostics)
+	}
+
+	for name, want := range map[string]string{
+		identity.OrgAttribute: testOrg,
+		"edge_gateway_id":     "urn:vcloud:gateway:1",
+		"id":                  "urn:vcloud:firewall:1",
+	} {
+		var got types.String
+		resp.Diagnostics.Append(resp.Identity.GetAttribute(ctx, path.Root(name), &got)...)
+		if got.ValueString() != want {
+			t.Errorf("identity %s = %q, want %q", name, got.ValueString(), want)
+		}
+	}
+}
+
+func TestWrapImportStateWithIdentity(t *testing.T) {
+	ctx := t.Context()
+
+	tests := []struct {
+		name    string
+		org     string
+		vdc     string
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "Without optional attributes",
+			want: "urn:vcloud:gateway:1.urn:vcloud:firewall:1",
+		},
+		{
+			name: "With optional attributes",
+			org:  testOrg,
+			vdc:  "my-vdc",
+			want: "my-vdc.urn:vcloud:gateway:1.urn:vcloud:firewall:1",
+		},
+		{
+			name:    "Other organization",
+			org:     "cav01ev01ocb0009999",
+			wantErr: true,
+		},
+		{
+			name:    "Separator in a value",
+			vdc:     "my.vdc",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, inner, _, identityResp := newTestResource(t)
+
+			id := &tfsdk.ResourceIdentity{
+				Schema: identityResp.IdentitySchema,
+				Raw:    tftypes.NewValue(identityResp.IdentitySchema.Type().TerraformType(ctx), nil),
+			}
+			values := map[string]string{
+				identity.OrgAttribute: tt.org,
+				"vdc":                 tt.vdc,
+				"edge_gateway_id":     "urn:vcloud:gateway:1",
+				"id":                  "urn:vcloud:firewall:1",
+			}
+			for name, v := range values {
+				value := types.StringNull()
+				if v != "" {
+					value = types.StringValue(v)
+				}
+				if diags := id.SetAttribute(ctx, path.Root(name), value); diags.HasError() {
+					t.Fatalf("SetAttribute() diagnostics: %+v", diags)
+				}
+			}
+
+			resp := &resource.ImportStateResponse{}
+			r.(resource.ResourceWithImportState).ImportState(ctx, resource.ImportStateRequest{Identity: id}, resp)
+
+			if resp.Diagnostics.HasError() != tt.wantErr {
+				t.Fatalf("ImportState() diagnostics: %+v", resp.Diagnostics)
+			}
+			if inner.importID != tt.want {
+				t.Errorf("import ID = %q, want %q", inner.importID, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,208 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package identity
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// orgNameGetter is implemented by the provider data (client.CloudAvenue).
+type orgNameGetter interface {
+	GetOrgName() string
+}
+
+var (
+	_ resource.Resource                     = &identityResource{}
+	_ resource.ResourceWithConfigure        = &identityResource{}
+	_ resource.ResourceWithIdentity         = &identityResource{}
+	_ resource.ResourceWithModifyPlan       = &identityResource{}
+	_ resource.ResourceWithValidateConfig   = &identityResource{}
+	_ resource.ResourceWithConfigValidators = &identityResource{}
+	_ resource.ResourceWithUpgradeState     = &identityResource{}
+	_ resource.ResourceWithMoveState        = &identityResource{}
+	_ resource.ResourceWithImportState      = &identityResourceWithImport{}
+	_ ResourceWithIdentitySpec              = &identityResource{}
+)
+
+// identityResource adds the resource identity to a resource. The other
+// methods are forwarded to the resource.
+type identityResource struct {
+	resource.Resource
+
+	spec    Spec
+	orgName string
+}
+
+// identityResourceWithImport is an identityResource of a resource that
+// supports the import.
+type identityResourceWithImport struct {
+	*identityResource
+
+	importer resource.ResourceWithImportState
+}
+
+// Wrap adds the resource identity to the resources implementing
+// ResourceWithIdentitySpec. The other resources are returned unchanged.
+func Wrap(resources []func() resource.Resource) []func() resource.Resource {
+	wrapped := make([]func() resource.Resource, 0, len(resources))
+
+	for _, newResource := range resources {
+		if _, ok := newResource().(ResourceWithIdentitySpec); !ok {
+			wrapped = append(wrapped, newResource)
+			continue
+		}
+
+		wrapped = append(wrapped, func() resource.Resource {
+			return newIdentityResource(newResource())
+		})
+	}
+
+	return wrapped
+}
+
+func newIdentityResource(r resource.Resource) resource.Resource {
+	ir := &identityResource{
+		Resource: r,
+		spec:     r.(ResourceWithIdentitySpec).IdentitySpec(),
+	}
+
+	if importer, ok := r.(resource.ResourceWithImportState); ok {
+		return &identityResourceWithImport{
+			identityResource: ir,
+			importer:         importer,
+		}
+	}
+
+	return ir
+}
+
+// Metadata returns the metadata of the resource.
+func (r *identityResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	r.Resource.Metadata(ctx, req, resp)
+	resp.ResourceBehavior.MutableIdentity = r.spec.Mutable
+}
+
+// IdentitySpec returns the identity of the wrapped resource.
+func (r *identityResource) IdentitySpec() Spec {
+	return r.spec
+}
+
+// IdentitySchema returns the identity schema of the resource.
+func (r *identityResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = r.spec.Schema()
+}
+
+// Configure configures the resource.
+func (r *identityResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if c, ok := req.ProviderData.(orgNameGetter); ok {
+		r.orgName = c.GetOrgName()
+	}
+
+	if rc, ok := r.Resource.(resource.ResourceWithConfigure); ok {
+		rc.Configure(ctx, req, resp)
+	}
+}
+
+// Create creates the resource and sets its identity.
+func (r *identityResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	r.Resource.Create(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.spec.SetFromState(ctx, r.orgName, resp.State, resp.Identity)...)
+}
+
+// Read refreshes the resource and its identity.
+func (r *identityResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	r.Resource.Read(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The identity of a removed resource is computed from the prior state,
+	// the resource may have been created before the identity support.
+	state := resp.State
+	if state.Raw.IsNull() {
+		state = req.State
+	}
+
+	resp.Diagnostics.Append(r.spec.SetFromState(ctx, r.orgName, state, resp.Identity)...)
+}
+
+// Update updates the resource and its identity.
+func (r *identityResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	r.Resource.Update(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.spec.SetFromState(ctx, r.orgName, resp.State, resp.Identity)...)
+}
+
+// Delete deletes the resource.
+func (r *identityResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	r.Resource.Delete(ctx, req, resp)
+}
+
+// ModifyPlan forwards the call to the resource.
+func (r *identityResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if rm, ok := r.Resource.(resource.ResourceWithModifyPlan); ok {
+		rm.ModifyPlan(ctx, req, resp)
+	}
+}
+
+// ValidateConfig forwards the call to the resource.
+func (r *identityResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	if rv, ok := r.Resource.(resource.ResourceWithValidateConfig); ok {
+		rv.ValidateConfig(ctx, req, resp)
+	}
+}
+
+// ConfigValidators forwards the call to the resource.
+func (r *identityResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	if rv, ok := r.Resource.(resource.ResourceWithConfigValidators); ok {
+		return rv.ConfigValidators(ctx)
+	}
+	return nil
+}
+
+// UpgradeState forwards the call to the resource.
+func (r *identityResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	if ru, ok := r.Resource.(resource.ResourceWithUpgradeState); ok {
+		return ru.UpgradeState(ctx)
+	}
+	return nil
+}
+
+// MoveState forwards the call to the resource.
+func (r *identityResource) MoveState(ctx context.Context) []resource.StateMover {
+	if rm, ok := r.Resource.(resource.ResourceWithMoveState); ok {
+		return rm.MoveState(ctx)
+	}
+	return nil
+}
+
+// ImportState imports the resource. An import using an identity is converted
+// to the import ID format of the resource.
+func (r *identityResourceWithImport) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" {
+		id, d := r.spec.ImportID(ctx, r.orgName, req.Identity)
+		resp.Diagnostics.Append(d...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		req.ID = id
+	}
+
+	r.importer.ImportState(ctx, req, resp)
+}
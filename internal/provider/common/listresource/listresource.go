@@ -0,0 +1,160 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+// Package listresource provides the common parts of the list resources used
+// by `terraform query`.
+//
+// A list resource only finds the resources. Each result is built like an
+// import: the attributes identifying the resource are set in an empty state
+// and, if the full resource is requested, the Read of the managed resource
+// refreshes it.
+package listresource
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/identity"
+)
+
+// Item is a resource found by a list resource.
+type Item struct {
+	// DisplayName is the name of the resource displayed by Terraform.
+	DisplayName string
+
+	// Attributes are the values of the root state attributes required to
+	// read the resource (the same attributes as an import).
+	Attributes map[string]string
+}
+
+// Base implements the Metadata and Configure methods of a list resource and
+// builds its results from the managed resource it lists.
+type Base struct {
+	// Client is the CloudAvenue client, set by Configure.
+	Client *client.CloudAvenue
+
+	resource resource.Resource
+	spec     identity.Spec
+	orgName  string
+}
+
+// NewBase returns the Base of the list resource of the managed resource.
+// The managed resource must implement identity.ResourceWithIdentitySpec.
+func NewBase(newResource func() resource.Resource) Base {
+	r := newResource()
+
+	return Base{
+		resource: r,
+		spec:     r.(identity.ResourceWithIdentitySpec).IdentitySpec(),
+	}
+}
+
+// Metadata returns the type name of the managed resource.
+func (b *Base) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	b.resource.Metadata(ctx, req, resp)
+}
+
+// Configure configures the list resource and the managed resource.
+func (b *Base) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.CloudAvenue)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected List Resource Configure Type",
+			fmt.Sprintf("Expected *client.CloudAvenue, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	b.Client = client
+	b.orgName = client.GetOrgName()
+
+	if rc, ok := b.resource.(resource.ResourceWithConfigure); ok {
+		rc.Configure(ctx, req, resp)
+	}
+}
+
+// Results returns the results of the items, up to the limit of the request.
+func (b *Base) Results(ctx context.Context, req list.ListRequest, items []Item) iter.Seq[list.ListResult] {
+	return func(push func(list.ListResult) bool) {
+		var count int64
+
+		for _, item := range items {
+			if req.Limit > 0 && count >= req.Limit {
+				return
+			}
+
+			result, found := b.result(ctx, req, item)
+			if !found {
+				// The resource has been deleted since it was listed.
+				continue
+			}
+			count++
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
+
+// Error returns the results of a failed list.
+func Error(summary string, err error) iter.Seq[list.ListResult] {
+	var diags diag.Diagnostics
+	diags.AddError(summary, err.Error())
+	return list.ListResultsStreamDiagnostics(diags)
+}
+
+// result builds the result of an item.
+func (b *Base) result(ctx context.Context, req list.ListRequest, item Item) (result list.ListResult, found bool) {
+	result = req.NewListResult(ctx)
+	result.DisplayName = item.DisplayName
+
+	state := tfsdk.State{
+		Schema: req.ResourceSchema,
+		Raw:    result.Resource.Raw,
+	}
+
+	for name, value := range item.Attributes {
+		result.Diagnostics.Append(state.SetAttribute(ctx, path.Root(name), value)...)
+	}
+	if result.Diagnostics.HasError() {
+		return result, true
+	}
+
+	if req.IncludeResource {
+		readResp := &resource.ReadResponse{State: state}
+		b.resource.Read(ctx, resource.ReadRequest{State: state}, readResp)
+		result.Diagnostics.Append(readResp.Diagnostics...)
+		if result.Diagnostics.HasError() {
+			return result, true
+		}
+
+		if readResp.State.Raw.IsNull() {
+			return result, false
+		}
+
+		state = readResp.State
+		result.Resource.Raw = state.Raw
+	}
+
+	result.Diagnostics.Append(b.spec.SetFromState(ctx, b.orgName, state, result.Identity)...)
+	return result, true
+}
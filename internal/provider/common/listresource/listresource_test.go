@@ -0,0 +1,181 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package listresource
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/identity"
+)
+
+const testOrg = "cav01ev01ocb0001234"
+
+type testResource struct{}
+
+func newTestResource() resource.Resource { return &testResource{} }
+
+func (r *testResource) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "cloudavenue_test"
+}
+
+func (r *testResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id":          schema.StringAttribute{Computed: true},
+			"name":        schema.StringAttribute{Required: true},
+			"description": schema.StringAttribute{Computed: true},
+		},
+	}
+}
+
+func (r *testResource) Create(context.Context, resource.CreateRequest, *resource.CreateResponse) {}
+
+// Read removes the resource named "deleted" and sets the other computed attributes.
+func (r *testResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var name types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("name"), &name)...)
+
+	if name.ValueString() == "deleted" {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), "urn:vcloud:test:"+name.ValueString())...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("description"), "read")...)
+}
+
+func (r *testResource) Update(context.Context, resource.UpdateRequest, *resource.UpdateResponse) {}
+
+func (r *testResource) Delete(context.Context, resource.DeleteRequest, *resource.DeleteResponse) {}
+
+func (r *testResource) IdentitySpec() identity.Spec {
+	return identity.Spec{
+		Attributes: []identity.Attribute{
+			{Name: "name"},
+		},
+	}
+}
+
+func newTestRequest(t *testing.T, includeResource bool, limit int64) list.ListRequest {
+	t.Helper()
+
+	schemaResp := resource.SchemaResponse{}
+	newTestResource().Schema(t.Context(), resource.SchemaRequest{}, &schemaResp)
+
+	return list.ListRequest{
+		IncludeResource:        includeResource,
+		Limit:                  limit,
+		ResourceSchema:         schemaResp.Schema,
+		ResourceIdentitySchema: newTestResource().(identity.ResourceWithIdentitySpec).IdentitySpec().Schema(),
+	}
+}
+
+func testItems(names ...string) []Item {
+	items := make([]Item, 0, len(names))
+	for _, name := range names {
+		items = append(items, Item{
+			DisplayName: name,
+			Attributes:  map[string]string{"name": name},
+		})
+	}
+	return items
+}
+
+func TestBaseResults(t *testing.T) {
+	tests := []struct {
+		name            string
+		items           []Item
+		includeResource bool
+		limit           int64
+		wantNames       []string
+		wantDescription string
+	}{
+		{
+			name:      "Identity only",
+			items:     testItems("a", "b"),
+			wantNames: []string{"a", "b"},
+		},
+		{
+			name:            "Include resource",
+			items:           testItems("a", "b"),
+			includeResource: true,
+			wantNames:       []string{"a", "b"},
+			wantDescription: "read",
+		},
+		{
+			name:            "Skip deleted resource",
+			items:           testItems("a", "deleted", "b"),
+			includeResource: true,
+			wantNames:       []string{"a", "b"},
+			wantDescription: "read",
+		},
+		{
+			name:      "Limit",
+			items:     testItems("a", "b", "c"),
+			limit:     2,
+			wantNames: []string{"a", "b"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := t.Context()
+
+			b := NewBase(newTestResource)
+			b.orgName = testOrg
+
+			var names []string
+			for result := range b.Results(ctx, newTestRequest(t, tt.includeResource, tt.limit), tt.items) {
+				if result.Diagnostics.HasError() {
+					t.Fatalf("result diagnostics: %+v", result.Diagnostics)
+				}
+
+				var name, org types.String
+				result.Diagnostics.Append(result.Identity.GetAttribute(ctx, path.Root("name"), &name)...)
+				result.Diagnostics.Append(result.Identity.GetAttribute(ctx, path.Root(identity.OrgAttribute), &org)...)
+				if result.Diagnostics.HasError() {
+					t.Fatalf("identity diagnostics: %+v", result.Diagnostics)
+				}
+				if name.ValueString() != result.DisplayName {
+					t.Errorf("identity name = %q, want %q", name.ValueString(), result.DisplayName)
+				}
+				if org.ValueString() != testOrg {
+					t.Errorf("identity org = %q, want %q", org.ValueString(), testOrg)
+				}
+
+				if tt.includeResource {
+					var description types.String
+					result.Diagnostics.Append(result.Resource.GetAttribute(ctx, path.Root("description"), &description)...)
+					if description.ValueString() != tt.wantDescription {
+						t.Errorf("description = %q, want %q", description.ValueString(), tt.wantDescription)
+					}
+				}
+
+				names = append(names, result.DisplayName)
+			}
+
+			if len(names) != len(tt.wantNames) {
+				t.Fatalf("results = %v, want %v", names, tt.wantNames)
+			}
+			for i := range names {
+				if names[i] != tt.wantNames[i] {
+					t.Errorf("results = %v, want %v", names, tt.wantNames)
+				}
+			}
+		})
+	}
+}
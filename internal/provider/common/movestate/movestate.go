@@ -0,0 +1,90 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+// Package movestate provides the state movers of the resources equivalent to
+// the resources of the VMware vcd provider.
+//
+// A state mover only moves the attributes identifying the resource, like an
+// import. The other attributes are refreshed by the next Read of the resource.
+package movestate
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// vcdProviderAddress is the address of the VMware vcd provider, without the
+// registry hostname.
+const vcdProviderAddress = "vmware/vcd"
+
+// Attributes maps the attributes of the target resource to the attributes of
+// the source resource.
+type Attributes map[string]string
+
+// FromVCD returns the state mover of the resource sourceTypeName of the
+// VMware vcd provider.
+func FromVCD(sourceTypeName string, attributes Attributes) resource.StateMover {
+	return resource.StateMover{
+		StateMover: func(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+			if req.SourceTypeName != sourceTypeName || !isVCDProvider(req.SourceProviderAddress) {
+				return
+			}
+
+			if req.SourceRawState == nil {
+				resp.Diagnostics.AddError("Unable to move resource state", fmt.Sprintf("The state of the %s resource is empty.", sourceTypeName))
+				return
+			}
+
+			source := map[string]any{}
+			if err := json.Unmarshal(req.SourceRawState.JSON, &source); err != nil {
+				resp.Diagnostics.AddError("Unable to move resource state", fmt.Sprintf("Error reading the state of the %s resource: %s", sourceTypeName, err))
+				return
+			}
+
+			targets := make([]string, 0, len(attributes))
+			for target := range attributes {
+				targets = append(targets, target)
+			}
+			sort.Strings(targets)
+
+			moved := 0
+			sources := make([]string, 0, len(targets))
+			for _, target := range targets {
+				sources = append(sources, attributes[target])
+
+				value, ok := source[attributes[target]].(string)
+				if !ok || value == "" {
+					continue
+				}
+
+				resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root(target), value)...)
+				moved++
+			}
+
+			if moved == 0 && !resp.Diagnostics.HasError() {
+				resp.Diagnostics.AddError(
+					"Unable to move resource state",
+					fmt.Sprintf("The state of the %s resource has none of the attributes %s.", sourceTypeName, strings.Join(sources, ", ")),
+				)
+			}
+		},
+	}
+}
+
+// isVCDProvider reports whether the provider address is the VMware vcd
+// provider, whatever the registry.
+func isVCDProvider(address string) bool {
+	return address == vcdProviderAddress || strings.HasSuffix(address, "/"+vcdProviderAddress)
+}
@@ -0,0 +1,126 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package movestate_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	supertypes "github.com/orange-cloudavenue/terraform-plugin-framework-supertypes"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/movestate"
+)
+
+func TestFromVCD(t *testing.T) {
+	targetSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id":              schema.StringAttribute{Computed: true, CustomType: supertypes.StringType{}},
+			"name":            schema.StringAttribute{Required: true},
+			"edge_gateway_id": schema.StringAttribute{Optional: true},
+			"description":     schema.StringAttribute{Optional: true},
+		},
+	}
+
+	mover := movestate.FromVCD("vcd_nsxt_nat_rule", movestate.Attributes{
+		"id":              "id",
+		"name":            "name",
+		"edge_gateway_id": "edge_gateway_id",
+	})
+
+	tests := []struct {
+		name            string
+		providerAddress string
+		typeName        string
+		state           string
+		wantMoved       bool
+		wantErr         bool
+	}{
+		{
+			name:            "Move nat rule",
+			providerAddress: "registry.terraform.io/vmware/vcd",
+			typeName:        "vcd_nsxt_nat_rule",
+			state:           `{"id":"b3a1e9c4","name":"dnat","edge_gateway_id":"urn:vcloud:gateway:1","org":"my-org","rule_type":"DNAT"}`,
+			wantMoved:       true,
+		},
+		{
+			name:            "Other resource type",
+			providerAddress: "registry.terraform.io/vmware/vcd",
+			typeName:        "vcd_nsxt_firewall",
+			state:           `{"id":"urn:vcloud:gateway:1"}`,
+		},
+		{
+			name:            "Other provider",
+			providerAddress: "registry.terraform.io/example/vcd",
+			typeName:        "vcd_nsxt_nat_rule",
+			state:           `{"id":"b3a1e9c4"}`,
+		},
+		{
+			name:            "No identifying attribute",
+			providerAddress: "registry.terraform.io/vmware/vcd",
+			typeName:        "vcd_nsxt_nat_rule",
+			state:           `{"rule_type":"DNAT"}`,
+			wantErr:         true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := t.Context()
+
+			resp := &resource.MoveStateResponse{
+				TargetState: tfsdk.State{
+					Schema: targetSchema,
+					Raw:    tftypes.NewValue(targetSchema.Type().TerraformType(ctx), nil),
+				},
+			}
+			mover.StateMover(ctx, resource.MoveStateRequest{
+				SourceProviderAddress: tt.providerAddress,
+				SourceTypeName:        tt.typeName,
+				SourceRawState:        &tfprotov6.RawState{JSON: []byte(tt.state)},
+			}, resp)
+
+			if resp.Diagnostics.HasError() != tt.wantErr {
+				t.Fatalf("StateMover() diagnostics: %+v", resp.Diagnostics)
+			}
+			if moved := !resp.TargetState.Raw.IsNull(); moved != tt.wantMoved {
+				t.Fatalf("state moved = %t, want %t", moved, tt.wantMoved)
+			}
+			if !tt.wantMoved {
+				return
+			}
+
+			for name, want := range map[string]string{
+				"id":              "b3a1e9c4",
+				"name":            "dnat",
+				"edge_gateway_id": "urn:vcloud:gateway:1",
+			} {
+				var got attr.Value
+				resp.Diagnostics.Append(resp.TargetState.GetAttribute(ctx, path.Root(name), &got)...)
+				if got == nil || got.String() != `"`+want+`"` {
+					t.Errorf("%s = %v, want %q", name, got, want)
+				}
+			}
+
+			var description types.String
+			resp.Diagnostics.Append(resp.TargetState.GetAttribute(ctx, path.Root("description"), &description)...)
+			if !description.IsNull() {
+				t.Errorf("description = %s, want null", description)
+			}
+		})
+	}
+}
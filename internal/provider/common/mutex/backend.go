@@ -0,0 +1,203 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package mutex
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// BackendType is the type of a lock backend.
+type BackendType string
+
+const (
+	// BackendFile stores the locks as files in a shared directory.
+	BackendFile BackendType = "file"
+	// BackendS3 stores the locks as objects in a Cloud Avenue S3 bucket.
+	BackendS3 BackendType = "s3"
+)
+
+// BackendTypes returns the types of lock backends.
+func BackendTypes() []BackendType {
+	return []BackendType{BackendFile, BackendS3}
+}
+
+// BackendTypesString returns the types of lock backends as strings.
+func BackendTypesString() []string {
+	types := make([]string, 0, len(BackendTypes()))
+	for _, t := range BackendTypes() {
+		types = append(types, string(t))
+	}
+	return types
+}
+
+const (
+	// DefaultLockTTL is the default time after which a lock that is no
+	// longer refreshed by its owner is considered stale and can be taken
+	// by another process.
+	DefaultLockTTL = 5 * time.Minute
+
+	// pollInterval is the time between two attempts to take a lock held by
+	// another process.
+	pollInterval = time.Second
+)
+
+// ErrLockLost is returned when a lock was taken by another process while it
+// was held, because it was not refreshed within its TTL.
+var ErrLockLost = errors.New("lock lost")
+
+// Backend shares the locks of a KV with the other provider processes, for
+// example the providers of several Terraform workspaces managing the same
+// edge gateway.
+type Backend interface {
+	// Lock blocks until the key is locked or the context is done.
+	Lock(ctx context.Context, key string) error
+	// Unlock releases the key locked by Lock.
+	Unlock(ctx context.Context, key string) error
+	// String describes the backend in the logs and the errors.
+	String() string
+}
+
+// lockInfo is the content of a lock. It identifies its owner.
+type lockInfo struct {
+	Key     string    `json:"key"`
+	Token   string    `json:"token"`
+	Owner   string    `json:"owner"`
+	Created time.Time `json:"created"`
+}
+
+// newLockInfo returns the content of a new lock of the key.
+func newLockInfo(key string) (lockInfo, error) {
+	token := make([]byte, 16)
+	if _, err := rand.Read(token); err != nil {
+		return lockInfo{}, err
+	}
+
+	hostname, _ := os.Hostname()
+	return lockInfo{
+		Key:     key,
+		Token:   hex.EncodeToString(token),
+		Owner:   fmt.Sprintf("%s/%d", hostname, os.Getpid()),
+		Created: time.Now().UTC(),
+	}, nil
+}
+
+// parseLockInfo parses the content of a lock.
+func parseLockInfo(data []byte) lockInfo {
+	var info lockInfo
+	_ = json.Unmarshal(data, &info)
+	return info
+}
+
+// unsafeNameChars matches the characters not kept in the lock names.
+var unsafeNameChars = regexp.MustCompile(`[^A-Za-z0-9._-]+`)
+
+// lockName returns the file or object name of the lock of the key. The keys
+// contain characters such as ':' or '|', so the name is the sanitized key
+// followed by a hash of the key to keep it unique.
+func lockName(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	name := unsafeNameChars.ReplaceAllString(key, "_")
+	if len(name) > 100 {
+		name = name[:100]
+	}
+	return name + "-" + hex.EncodeToString(sum[:6]) + ".lock"
+}
+
+// heartbeats refreshes the locks held by a backend, so that they are not
+// considered stale by the other processes.
+type heartbeats struct {
+	mu    sync.Mutex
+	locks map[string]*heldLock
+}
+
+// heldLock is a lock held by the process.
+type heldLock struct {
+	info lockInfo
+	// version identifies the last write of the lock (an ETag for S3).
+	version string
+	stop    chan struct{}
+	done    chan struct{}
+}
+
+// start records the held lock and refreshes it every interval with the
+// refresh function, until stop is called.
+func (h *heartbeats) start(ctx context.Context, key string, lock *heldLock, interval time.Duration, refresh func(*heldLock) error) {
+	lock.stop = make(chan struct{})
+	lock.done = make(chan struct{})
+
+	h.mu.Lock()
+	if h.locks == nil {
+		h.locks = make(map[string]*heldLock)
+	}
+	h.locks[key] = lock
+	h.mu.Unlock()
+
+	// The heartbeat outlives the Terraform operation that took the lock.
+	ctx = context.WithoutCancel(ctx)
+	go func() {
+		defer close(lock.done)
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-lock.stop:
+				return
+			case <-ticker.C:
+				if err := refresh(lock); err != nil {
+					tflog.Warn(ctx, "Unable to refresh lock", map[string]any{"key": key, "error": err.Error()})
+					if errors.Is(err, ErrLockLost) {
+						return
+					}
+				}
+			}
+		}
+	}()
+}
+
+// stop stops the refresh of the lock and returns it.
+func (h *heartbeats) stop(key string) (*heldLock, bool) {
+	h.mu.Lock()
+	lock, ok := h.locks[key]
+	delete(h.locks, key)
+	h.mu.Unlock()
+
+	if !ok {
+		return nil, false
+	}
+	close(lock.stop)
+	<-lock.done
+	return lock, true
+}
+
+// wait waits for the poll interval, until the context is done.
+func wait(ctx context.Context) error {
+	timer := time.NewTimer(pollInterval)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
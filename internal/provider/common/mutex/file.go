@@ -0,0 +1,188 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package mutex
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// FileBackend stores the locks as files in a directory shared by the
+// provider processes, for example a network file system mounted by the CI
+// runners. A lock file is created exclusively by its owner and removed on
+// unlock.
+type FileBackend struct {
+	dir        string
+	ttl        time.Duration
+	heartbeats heartbeats
+}
+
+var _ Backend = (*FileBackend)(nil)
+
+// renameFile renames a lock file, replaced by the tests to simulate the other
+// processes.
+var renameFile = os.Rename
+
+// NewFileBackend returns a backend storing the locks in the directory, after
+// checking that the directory can be written. The locks not refreshed within
+// the TTL are considered stale.
+func NewFileBackend(dir string, ttl time.Duration) (*FileBackend, error) {
+	if dir == "" {
+		return nil, errors.New("the lock directory is empty")
+	}
+	if ttl <= 0 {
+		ttl = DefaultLockTTL
+	}
+
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return nil, fmt.Errorf("creating lock directory %s: %w", dir, err)
+	}
+
+	f, err := os.CreateTemp(dir, ".write-check-*")
+	if err != nil {
+		return nil, fmt.Errorf("writing in lock directory %s: %w", dir, err)
+	}
+	f.Close()
+	os.Remove(f.Name())
+
+	return &FileBackend{dir: dir, ttl: ttl}, nil
+}
+
+// String implements Backend.
+func (b *FileBackend) String() string {
+	return "file lock backend " + b.dir
+}
+
+// Lock implements Backend.
+func (b *FileBackend) Lock(ctx context.Context, key string) error {
+	info, err := newLockInfo(key)
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(info)
+	if err != nil {
+		return err
+	}
+
+	path := filepath.Join(b.dir, lockName(key))
+	for {
+		f, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o600)
+		if err == nil {
+			if _, err := f.Write(data); err != nil {
+				f.Close()
+				os.Remove(path)
+				return fmt.Errorf("writing lock file %s: %w", path, err)
+			}
+			if err := f.Close(); err != nil {
+				os.Remove(path)
+				return fmt.Errorf("writing lock file %s: %w", path, err)
+			}
+
+			b.heartbeats.start(ctx, key, &heldLock{info: info}, b.ttl/3, func(lock *heldLock) error {
+				return b.refresh(path, lock)
+			})
+			return nil
+		}
+		if !errors.Is(err, os.ErrExist) {
+			return fmt.Errorf("creating lock file %s: %w", path, err)
+		}
+
+		broken, err := b.breakStale(ctx, path)
+		if err != nil {
+			return err
+		}
+		if broken {
+			continue
+		}
+		if err := wait(ctx); err != nil {
+			return err
+		}
+	}
+}
+
+// Unlock implements Backend.
+func (b *FileBackend) Unlock(_ context.Context, key string) error {
+	lock, ok := b.heartbeats.stop(key)
+	if !ok {
+		return fmt.Errorf("unlock of unlocked key %s", key)
+	}
+
+	path := filepath.Join(b.dir, lockName(key))
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("reading lock file %s: %w", path, err)
+	}
+	if parseLockInfo(data).Token != lock.info.Token {
+		return fmt.Errorf("%w: %s is held by another process", ErrLockLost, path)
+	}
+	return os.Remove(path)
+}
+
+// refresh updates the modification time of the lock file, if it is still
+// held by the process.
+func (b *FileBackend) refresh(path string, lock *heldLock) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	if parseLockInfo(data).Token != lock.info.Token {
+		return fmt.Errorf("%w: %s is held by another process", ErrLockLost, path)
+	}
+	now := time.Now()
+	return os.Chtimes(path, now, now)
+}
+
+// breakStale removes the lock file if it was not refreshed within the TTL.
+// It returns true if the lock file was removed. If the lock of another
+// process moved aside by mistake cannot be restored, the lock is lost and
+// ErrLockLost is returned.
+func (b *FileBackend) breakStale(ctx context.Context, path string) (bool, error) {
+	fi, err := os.Stat(path)
+	if err != nil {
+		// The lock was released in the meantime.
+		return errors.Is(err, os.ErrNotExist), nil
+	}
+	if time.Since(fi.ModTime()) < b.ttl {
+		return false, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return errors.Is(err, os.ErrNotExist), nil
+	}
+	stale := parseLockInfo(data)
+
+	// Move the stale lock aside, the rename is atomic. If another process
+	// replaced the stale lock in the meantime, its lock is restored.
+	moved := path + "." + stale.Token + ".stale"
+	if err := renameFile(path, moved); err != nil {
+		return errors.Is(err, os.ErrNotExist), nil
+	}
+	defer os.Remove(moved)
+
+	if data, err := os.ReadFile(moved); err == nil && parseLockInfo(data).Token != stale.Token {
+		if err := os.Link(moved, path); err != nil {
+			// A third process created the lock file before the restore, the
+			// two processes would both hold the lock.
+			return false, fmt.Errorf("%w: unable to restore the lock file %s of another process: %w", ErrLockLost, path, err)
+		}
+		return false, nil
+	}
+
+	tflog.Warn(ctx, "Removed stale lock", map[string]any{"key": stale.Key, "owner": stale.Owner, "created": stale.Created.String()})
+	return true, nil
+}
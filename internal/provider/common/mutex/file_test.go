@@ -0,0 +1,195 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package mutex
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestFileBackend(t *testing.T) {
+	dir := t.TempDir()
+	ctx := t.Context()
+
+	// Two KVs sharing the directory, as two provider processes.
+	first, second := NewKV(), NewKV()
+	for _, kv := range []*KV{first, second} {
+		b, err := NewFileBackend(dir, time.Minute)
+		if err != nil {
+			t.Fatalf("NewFileBackend() error = %v", err)
+		}
+		kv.SetBackend(b)
+	}
+
+	const key = "urn:vcloud:gateway:1234"
+	if err := first.KvLock(ctx, key); err != nil {
+		t.Fatalf("KvLock() error = %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, lockName(key))); err != nil {
+		t.Fatalf("lock file not created: %v", err)
+	}
+
+	locked := make(chan error)
+	go func() {
+		locked <- second.KvLock(ctx, key)
+	}()
+
+	select {
+	case err := <-locked:
+		t.Fatalf("KvLock() returned %v while the key is locked by another process", err)
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	first.KvUnlock(ctx, key)
+	if err := <-locked; err != nil {
+		t.Fatalf("KvLock() error = %v", err)
+	}
+	second.KvUnlock(ctx, key)
+
+	if _, err := os.Stat(filepath.Join(dir, lockName(key))); !errors.Is(err, os.ErrNotExist) {
+		t.Fatalf("lock file not removed: %v", err)
+	}
+}
+
+func TestFileBackendTimeout(t *testing.T) {
+	setTimeoutForTest(t, 50*time.Millisecond)
+
+	dir := t.TempDir()
+	first, err := NewFileBackend(dir, time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	kv := NewKV()
+	kv.SetBackend(first)
+
+	other, err := NewFileBackend(dir, time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := other.Lock(t.Context(), "edge"); err != nil {
+		t.Fatalf("Lock() error = %v", err)
+	}
+	t.Cleanup(func() {
+		if err := other.Unlock(t.Context(), "edge"); err != nil {
+			t.Errorf("Unlock() error = %v", err)
+		}
+	})
+
+	if err := kv.KvLock(t.Context(), "edge"); !errors.Is(err, ErrTimeout) {
+		t.Fatalf("KvLock() error = %v, want ErrTimeout", err)
+	}
+	// The local lock is released by the failed lock.
+	kv.SetBackend(nil)
+	if err := kv.KvLock(t.Context(), "edge"); err != nil {
+		t.Fatalf("KvLock() error = %v", err)
+	}
+}
+
+func TestFileBackendStaleLock(t *testing.T) {
+	dir := t.TempDir()
+	b, err := NewFileBackend(dir, time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// A lock left by a crashed process.
+	path := filepath.Join(dir, lockName("edge"))
+	if err := os.WriteFile(path, []byte(`{"key":"edge","token":"crashed"}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	old := time.Now().Add(-2 * time.Minute)
+	if err := os.Chtimes(path, old, old); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := b.Lock(t.Context(), "edge"); err != nil {
+		t.Fatalf("Lock() error = %v", err)
+	}
+	if err := b.Unlock(t.Context(), "edge"); err != nil {
+		t.Fatalf("Unlock() error = %v", err)
+	}
+}
+
+func TestFileBackendLockLost(t *testing.T) {
+	dir := t.TempDir()
+	b, err := NewFileBackend(dir, time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := b.Lock(t.Context(), "edge"); err != nil {
+		t.Fatalf("Lock() error = %v", err)
+	}
+	// Another process took the lock.
+	if err := os.WriteFile(filepath.Join(dir, lockName("edge")), []byte(`{"key":"edge","token":"other"}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := b.Unlock(t.Context(), "edge"); !errors.Is(err, ErrLockLost) {
+		t.Fatalf("Unlock() error = %v, want ErrLockLost", err)
+	}
+}
+
+func TestFileBackendStaleLockRestoreFails(t *testing.T) {
+	dir := t.TempDir()
+	b, err := NewFileBackend(dir, time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// A lock left by a crashed process.
+	path := filepath.Join(dir, lockName("edge"))
+	if err := os.WriteFile(path, []byte(`{"key":"edge","token":"crashed"}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	old := time.Now().Add(-2 * time.Minute)
+	if err := os.Chtimes(path, old, old); err != nil {
+		t.Fatal(err)
+	}
+
+	// Another process replaces the stale lock before it is moved aside, and
+	// a third one creates the lock file before it is restored.
+	t.Cleanup(func() { renameFile = os.Rename })
+	renameFile = func(oldpath, newpath string) error {
+		if err := os.WriteFile(oldpath, []byte(`{"key":"edge","token":"other"}`), 0o600); err != nil {
+			return err
+		}
+		if err := os.Rename(oldpath, newpath); err != nil {
+			return err
+		}
+		return os.WriteFile(oldpath, []byte(`{"key":"edge","token":"third"}`), 0o600)
+	}
+
+	if err := b.Lock(t.Context(), "edge"); !errors.Is(err, ErrLockLost) {
+		t.Fatalf("Lock() error = %v, want ErrLockLost", err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if token := parseLockInfo(data).Token; token != "third" {
+		t.Fatalf("lock file token = %q, want the lock of the third process", token)
+	}
+}
+
+func TestLockName(t *testing.T) {
+	a := lockName("vdc:my-vdc|vapp:my/vapp")
+	b := lockName("vdc:my-vdc|vapp:my_vapp")
+	if a == b {
+		t.Fatalf("lockName() = %s for two keys", a)
+	}
+	if filepath.Base(a) != a {
+		t.Fatalf("lockName() = %s, want a file name", a)
+	}
+}
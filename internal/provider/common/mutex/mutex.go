@@ -21,61 +21,158 @@ package mutex
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
+// ErrTimeout is returned by KvLock when the key is still locked after the
+// timeout.
+var ErrTimeout = errors.New("timeout waiting for the lock")
+
 var GlobalMutex = NewKV()
 
+// timeout is the maximum time KvLock waits for a key, 0 (the default) means
+// no timeout.
+var timeout atomic.Int64
+
+// SetTimeout sets the maximum time KvLock waits for a key. A zero or
+// negative duration disables the timeout, the lock then only aborts when the
+// context is done.
+func SetTimeout(d time.Duration) {
+	if d < 0 {
+		d = 0
+	}
+	timeout.Store(int64(d))
+}
+
+// Timeout returns the maximum time KvLock waits for a key, 0 means no
+// timeout.
+func Timeout() time.Duration {
+	return time.Duration(timeout.Load())
+}
+
 // KV is a simple key/value store for arbitrary mutexes. It can be used to
 // serialize changes across arbitrary collaborators that share knowledge of the
 // keys they must serialize on.
 //
 // The initial use case is to let aws_security_group_rule resources serialize
 // their access to individual security groups based on SG ID.
+//
+// With a Backend, the keys are also locked across the provider processes.
 type KV struct {
-	lock  sync.Mutex
-	store map[string]*sync.Mutex
+	lock    sync.Mutex
+	store   map[string]chan struct{}
+	backend Backend
 }
 
 // NewKV is an implementation of KV.
 func NewKV() *KV {
 	return &KV{
-		store: make(map[string]*sync.Mutex),
+		store: make(map[string]chan struct{}),
 	}
 }
 
+// SetBackend sets the backend sharing the locks with the other provider
+// processes. A nil backend only locks the keys within the process.
+func (m *KV) SetBackend(b Backend) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.backend = b
+}
+
+// getBackend returns the backend of the KV, if any.
+func (m *KV) getBackend() Backend {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	return m.backend
+}
+
 // KvLock locks the mutex for the given key. Caller is responsible for calling kvUnlock
-// for the same key.
-func (m *KV) KvLock(ctx context.Context, key string) {
+// for the same key, only if KvLock returns no error.
+// KvLock aborts when the context is done (Terraform cancellation) or after
+// the timeout set by SetTimeout.
+func (m *KV) KvLock(ctx context.Context, key string) error {
 	if tflog.IsDebug(ctx) {
-		tflog.Debug(ctx, "Locking mutex")
+		tflog.Debug(ctx, "Locking mutex", map[string]any{"key": key})
+	}
+
+	start := time.Now()
+	lockCtx := ctx
+	if d := Timeout(); d > 0 {
+		var cancel context.CancelFunc
+		lockCtx, cancel = context.WithTimeout(ctx, d)
+		defer cancel()
+	}
+
+	sem := m.get(key)
+	select {
+	case sem <- struct{}{}:
+	case <-lockCtx.Done():
+		return lockError(ctx, key, start)
+	}
+
+	if b := m.getBackend(); b != nil {
+		if err := b.Lock(lockCtx, key); err != nil {
+			<-sem
+			if lockCtx.Err() != nil {
+				return lockError(ctx, key, start)
+			}
+			tflog.Debug(ctx, "Locking mutex failed", map[string]any{"key": key, "backend": b.String(), "error": err.Error()})
+			return fmt.Errorf("locking %q with the %s: %w", key, b, err)
+		}
 	}
-	m.get(key).Lock()
+
 	if tflog.IsDebug(ctx) {
-		tflog.Debug(ctx, "Locked mutex")
+		tflog.Debug(ctx, "Locked mutex", map[string]any{"key": key, "wait": time.Since(start).String()})
 	}
+	return nil
+}
+
+// lockError returns the error of a lock aborted by the cancellation of the
+// context or by the timeout.
+func lockError(ctx context.Context, key string, start time.Time) error {
+	wait := time.Since(start)
+	if err := ctx.Err(); err != nil {
+		tflog.Debug(ctx, "Locking mutex aborted", map[string]any{"key": key, "wait": wait.String(), "error": err.Error()})
+		return fmt.Errorf("locking %q aborted after %s: %w", key, wait.Round(time.Millisecond), err)
+	}
+	tflog.Debug(ctx, "Locking mutex timed out", map[string]any{"key": key, "wait": wait.String()})
+	return fmt.Errorf("locking %q: %w after %s, another operation on the same object is still running", key, ErrTimeout, wait.Round(time.Millisecond))
 }
 
 // KvUnlock unlocks the mutex for the given key. Caller must have called kvLock for the same key first.
 func (m *KV) KvUnlock(ctx context.Context, key string) {
 	if tflog.IsDebug(ctx) {
-		tflog.Debug(ctx, "Unlocking mutex")
+		tflog.Debug(ctx, "Unlocking mutex", map[string]any{"key": key})
+	}
+	if b := m.getBackend(); b != nil {
+		// The lock of the backend expires if it cannot be released.
+		if err := b.Unlock(context.WithoutCancel(ctx), key); err != nil {
+			tflog.Warn(ctx, "Unable to release the lock", map[string]any{"key": key, "backend": b.String(), "error": err.Error()})
+		}
+	}
+	select {
+	case <-m.get(key):
+	default:
+		panic("mutex: unlock of unlocked key " + key)
 	}
-	m.get(key).Unlock()
 	if tflog.IsDebug(ctx) {
-		tflog.Debug(ctx, "Unlocked mutex")
+		tflog.Debug(ctx, "Unlocked mutex", map[string]any{"key": key})
 	}
 }
 
 // Returns a mutex for the given key, no guarantee of its lock status.
-func (m *KV) get(key string) *sync.Mutex {
+func (m *KV) get(key string) chan struct{} {
 	m.lock.Lock()
 	defer m.lock.Unlock()
 	mutex, ok := m.store[key]
 	if !ok {
-		mutex = &sync.Mutex{}
+		mutex = make(chan struct{}, 1)
 		m.store[key] = mutex
 	}
 	return mutex
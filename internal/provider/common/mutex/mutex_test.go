@@ -0,0 +1,100 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package mutex
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+// setTimeoutForTest sets the lock timeout and restores the previous one at
+// the end of the test.
+func setTimeoutForTest(t *testing.T, d time.Duration) {
+	t.Helper()
+
+	previous := Timeout()
+	t.Cleanup(func() { SetTimeout(previous) })
+	SetTimeout(d)
+}
+
+func TestKvLock(t *testing.T) {
+	kv := NewKV()
+	ctx := t.Context()
+
+	if err := kv.KvLock(ctx, "a"); err != nil {
+		t.Fatalf("KvLock() error = %v", err)
+	}
+	// Another key is not blocked.
+	if err := kv.KvLock(ctx, "b"); err != nil {
+		t.Fatalf("KvLock() error = %v", err)
+	}
+	kv.KvUnlock(ctx, "b")
+
+	locked := make(chan error)
+	go func() {
+		locked <- kv.KvLock(ctx, "a")
+	}()
+
+	select {
+	case err := <-locked:
+		t.Fatalf("KvLock() returned %v while the key is locked", err)
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	kv.KvUnlock(ctx, "a")
+	if err := <-locked; err != nil {
+		t.Fatalf("KvLock() error = %v", err)
+	}
+	kv.KvUnlock(ctx, "a")
+}
+
+func TestKvLockTimeout(t *testing.T) {
+	setTimeoutForTest(t, 20*time.Millisecond)
+
+	kv := NewKV()
+	ctx := t.Context()
+
+	if err := kv.KvLock(ctx, "edge"); err != nil {
+		t.Fatalf("KvLock() error = %v", err)
+	}
+	defer kv.KvUnlock(ctx, "edge")
+
+	err := kv.KvLock(ctx, "edge")
+	if !errors.Is(err, ErrTimeout) {
+		t.Fatalf("KvLock() error = %v, want ErrTimeout", err)
+	}
+}
+
+func TestKvLockCanceled(t *testing.T) {
+	setTimeoutForTest(t, 0)
+
+	kv := NewKV()
+
+	if err := kv.KvLock(t.Context(), "edge"); err != nil {
+		t.Fatalf("KvLock() error = %v", err)
+	}
+	defer kv.KvUnlock(t.Context(), "edge")
+
+	ctx, cancel := context.WithCancel(t.Context())
+	time.AfterFunc(20*time.Millisecond, cancel)
+
+	err := kv.KvLock(ctx, "edge")
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("KvLock() error = %v, want context.Canceled", err)
+	}
+
+	// The aborted lock does not hold the key.
+	kv.KvUnlock(t.Context(), "edge")
+	if err := kv.KvLock(t.Context(), "edge"); err != nil {
+		t.Fatalf("KvLock() error = %v", err)
+	}
+}
@@ -0,0 +1,247 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package mutex
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/s3"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// S3Backend stores the locks as objects in a Cloud Avenue S3 bucket. A lock
+// object is created with a conditional write (If-None-Match), so that only
+// one process can create it, and deleted on unlock.
+type S3Backend struct {
+	client     *s3.S3
+	bucket     string
+	prefix     string
+	ttl        time.Duration
+	heartbeats heartbeats
+}
+
+var _ Backend = (*S3Backend)(nil)
+
+// ErrConditionalWriteUnsupported is returned by NewS3Backend when the S3
+// endpoint ignores the conditional writes the locks rely on.
+var ErrConditionalWriteUnsupported = errors.New("the S3 endpoint does not support the conditional writes (If-None-Match and If-Match)")
+
+// NewS3Backend returns a backend storing the locks in the bucket, under the
+// prefix. The locks not refreshed within the TTL are considered stale.
+// The support of the conditional writes is checked by writing a probe object
+// in the bucket, an endpoint ignoring them would let several processes hold
+// the same lock.
+func NewS3Backend(ctx context.Context, client *s3.S3, bucket, prefix string, ttl time.Duration) (*S3Backend, error) {
+	if client == nil {
+		return nil, errors.New("the S3 client is not configured")
+	}
+	if bucket == "" {
+		return nil, errors.New("the lock bucket is empty")
+	}
+	if ttl <= 0 {
+		ttl = DefaultLockTTL
+	}
+	if prefix != "" && !strings.HasSuffix(prefix, "/") {
+		prefix += "/"
+	}
+
+	b := &S3Backend{client: client, bucket: bucket, prefix: prefix, ttl: ttl}
+	if err := b.probe(ctx); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// probe checks that the endpoint rejects a conditional write whose
+// condition does not hold, both for If-None-Match and If-Match.
+func (b *S3Backend) probe(ctx context.Context) error {
+	info, err := newLockInfo("probe")
+	if err != nil {
+		return err
+	}
+	objectKey := b.prefix + "probe-" + info.Token
+
+	etag, err := b.put(ctx, objectKey, nil, "If-None-Match", "*")
+	if err != nil {
+		return fmt.Errorf("writing the probe object %s in bucket %s: %w", objectKey, b.bucket, err)
+	}
+
+	var errProbe error
+	if _, err := b.put(ctx, objectKey, nil, "If-None-Match", "*"); err == nil {
+		errProbe = fmt.Errorf("%w: the probe object %s was overwritten with If-None-Match", ErrConditionalWriteUnsupported, objectKey)
+		etag = ""
+	} else if !isPreconditionFailed(err) {
+		errProbe = fmt.Errorf("writing the probe object %s: %w", objectKey, err)
+	}
+
+	if errProbe == nil {
+		if _, err := b.put(ctx, objectKey, nil, "If-Match", `"probe-mismatch"`); err == nil {
+			errProbe = fmt.Errorf("%w: the probe object %s was overwritten with a mismatching If-Match", ErrConditionalWriteUnsupported, objectKey)
+			etag = ""
+		} else if !isPreconditionFailed(err) {
+			errProbe = fmt.Errorf("writing the probe object %s: %w", objectKey, err)
+		}
+	}
+
+	if err := b.delete(ctx, objectKey, etag); err != nil && errProbe == nil {
+		errProbe = fmt.Errorf("deleting the probe object %s: %w", objectKey, err)
+	}
+	return errProbe
+}
+
+// String implements Backend.
+func (b *S3Backend) String() string {
+	return "S3 lock backend s3://" + b.bucket + "/" + b.prefix
+}
+
+// Lock implements Backend.
+func (b *S3Backend) Lock(ctx context.Context, key string) error {
+	info, err := newLockInfo(key)
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(info)
+	if err != nil {
+		return err
+	}
+
+	objectKey := b.prefix + lockName(key)
+	for {
+		etag, err := b.put(ctx, objectKey, data, "If-None-Match", "*")
+		if err == nil {
+			b.heartbeats.start(ctx, key, &heldLock{info: info, version: etag}, b.ttl/3, func(lock *heldLock) error {
+				return b.refresh(objectKey, data, lock)
+			})
+			return nil
+		}
+		if !isPreconditionFailed(err) {
+			return fmt.Errorf("creating lock object %s: %w", objectKey, err)
+		}
+
+		if b.breakStale(ctx, objectKey) {
+			continue
+		}
+		if err := wait(ctx); err != nil {
+			return err
+		}
+	}
+}
+
+// Unlock implements Backend.
+func (b *S3Backend) Unlock(ctx context.Context, key string) error {
+	lock, ok := b.heartbeats.stop(key)
+	if !ok {
+		return fmt.Errorf("unlock of unlocked key %s", key)
+	}
+
+	objectKey := b.prefix + lockName(key)
+	if err := b.delete(ctx, objectKey, lock.version); err != nil {
+		if isPreconditionFailed(err) {
+			return fmt.Errorf("%w: %s is held by another process", ErrLockLost, objectKey)
+		}
+		return fmt.Errorf("deleting lock object %s: %w", objectKey, err)
+	}
+	return nil
+}
+
+// refresh rewrites the lock object, if it is still held by the process, to
+// update its modification time.
+func (b *S3Backend) refresh(objectKey string, data []byte, lock *heldLock) error {
+	etag, err := b.put(context.Background(), objectKey, data, "If-Match", lock.version)
+	if err != nil {
+		if isPreconditionFailed(err) {
+			return fmt.Errorf("%w: %s is held by another process", ErrLockLost, objectKey)
+		}
+		return err
+	}
+	lock.version = etag
+	return nil
+}
+
+// breakStale deletes the lock object if it was not refreshed within the TTL.
+// It returns true if the lock object was deleted.
+func (b *S3Backend) breakStale(ctx context.Context, objectKey string) bool {
+	head, err := b.client.HeadObjectWithContext(ctx, &s3.HeadObjectInput{
+		Bucket: aws.String(b.bucket),
+		Key:    aws.String(objectKey),
+	})
+	if err != nil {
+		// The lock was released in the meantime.
+		var reqErr awserr.RequestFailure
+		return errors.As(err, &reqErr) && reqErr.StatusCode() == http.StatusNotFound
+	}
+	if head.LastModified == nil || time.Since(*head.LastModified) < b.ttl {
+		return false
+	}
+
+	// The deletion only succeeds if the stale lock was not replaced in the
+	// meantime.
+	if err := b.delete(ctx, objectKey, aws.StringValue(head.ETag)); err != nil {
+		return false
+	}
+
+	tflog.Warn(ctx, "Removed stale lock", map[string]any{"object": objectKey, "last_modified": head.LastModified.String()})
+	return true
+}
+
+// put writes the lock object with a conditional header and returns its ETag.
+func (b *S3Backend) put(ctx context.Context, objectKey string, data []byte, condition, value string) (string, error) {
+	req, out := b.client.PutObjectRequest(&s3.PutObjectInput{
+		Bucket:      aws.String(b.bucket),
+		Key:         aws.String(objectKey),
+		Body:        bytes.NewReader(data),
+		ContentType: aws.String("application/json"),
+	})
+	b.prepare(ctx, req, condition, value)
+	if err := req.Send(); err != nil {
+		return "", err
+	}
+	return aws.StringValue(out.ETag), nil
+}
+
+// delete deletes the lock object if its ETag matches.
+func (b *S3Backend) delete(ctx context.Context, objectKey, etag string) error {
+	req, _ := b.client.DeleteObjectRequest(&s3.DeleteObjectInput{
+		Bucket: aws.String(b.bucket),
+		Key:    aws.String(objectKey),
+	})
+	b.prepare(ctx, req, "If-Match", etag)
+	return req.Send()
+}
+
+// prepare prepares the request with the context and the conditional header,
+// which the S3 SDK does not expose for these operations.
+func (b *S3Backend) prepare(ctx context.Context, req *request.Request, condition, value string) {
+	req.SetContext(ctx)
+	if value != "" {
+		req.HTTPRequest.Header.Set(condition, value)
+	}
+}
+
+// isPreconditionFailed reports whether the conditional write failed because
+// the lock object exists or was modified.
+func isPreconditionFailed(err error) bool {
+	var reqErr awserr.RequestFailure
+	if !errors.As(err, &reqErr) {
+		return false
+	}
+	return reqErr.StatusCode() == http.StatusPreconditionFailed || reqErr.StatusCode() == http.StatusConflict
+}
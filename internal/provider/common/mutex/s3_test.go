@@ -0,0 +1,190 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package mutex
+
+import (
+	"crypto/md5" //nolint:gosec // ETag of the fake S3 server
+	"encoding/hex"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+)
+
+// fakeS3 is an S3 server supporting the conditional writes of the lock
+// objects.
+type fakeS3 struct {
+	mu      sync.Mutex
+	objects map[string]fakeObject
+	// unconditional ignores the conditional headers, as some S3 endpoints.
+	unconditional bool
+}
+
+type fakeObject struct {
+	etag     string
+	modified time.Time
+}
+
+func (f *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.unconditional {
+		r.Header.Del("If-Match")
+		r.Header.Del("If-None-Match")
+	}
+
+	object, exists := f.objects[r.URL.Path]
+	if match := r.Header.Get("If-Match"); match != "" && (!exists || match != object.etag) {
+		w.WriteHeader(http.StatusPreconditionFailed)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodPut:
+		if r.Header.Get("If-None-Match") == "*" && exists {
+			w.WriteHeader(http.StatusPreconditionFailed)
+			return
+		}
+		body, _ := io.ReadAll(r.Body)
+		sum := md5.Sum(append(body, []byte(time.Now().String())...)) //nolint:gosec
+		object = fakeObject{etag: `"` + hex.EncodeToString(sum[:]) + `"`, modified: time.Now()}
+		f.objects[r.URL.Path] = object
+		w.Header().Set("ETag", object.etag)
+	case http.MethodHead:
+		if !exists {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("ETag", object.etag)
+		w.Header().Set("Last-Modified", object.modified.UTC().Format(http.TimeFormat))
+	case http.MethodDelete:
+		delete(f.objects, r.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func newFakeS3Client(t *testing.T) (*s3.S3, *fakeS3) {
+	t.Helper()
+
+	fake := &fakeS3{objects: map[string]fakeObject{}}
+	server := httptest.NewServer(fake)
+	t.Cleanup(server.Close)
+
+	sess, err := session.NewSession(&aws.Config{
+		Endpoint:         aws.String(server.URL),
+		Region:           aws.String("region01"),
+		Credentials:      credentials.NewStaticCredentials("access", "secret", ""),
+		S3ForcePathStyle: aws.Bool(true),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s3.New(sess), fake
+}
+
+func TestS3Backend(t *testing.T) {
+	client, fake := newFakeS3Client(t)
+	ctx := t.Context()
+
+	first, err := NewS3Backend(t.Context(), client, "locks", "terraform", time.Minute)
+	if err != nil {
+		t.Fatalf("NewS3Backend() error = %v", err)
+	}
+	second, err := NewS3Backend(t.Context(), client, "locks", "terraform", time.Minute)
+	if err != nil {
+		t.Fatalf("NewS3Backend() error = %v", err)
+	}
+
+	const key = "urn:vcloud:gateway:1234"
+	if err := first.Lock(ctx, key); err != nil {
+		t.Fatalf("Lock() error = %v", err)
+	}
+	if _, ok := fake.objects["/locks/terraform/"+lockName(key)]; !ok {
+		t.Fatalf("lock object not created: %v", fake.objects)
+	}
+
+	locked := make(chan error)
+	go func() {
+		locked <- second.Lock(ctx, key)
+	}()
+
+	select {
+	case err := <-locked:
+		t.Fatalf("Lock() returned %v while the key is locked by another process", err)
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	if err := first.Unlock(ctx, key); err != nil {
+		t.Fatalf("Unlock() error = %v", err)
+	}
+	if err := <-locked; err != nil {
+		t.Fatalf("Lock() error = %v", err)
+	}
+	if err := second.Unlock(ctx, key); err != nil {
+		t.Fatalf("Unlock() error = %v", err)
+	}
+	if len(fake.objects) != 0 {
+		t.Fatalf("lock objects not deleted: %v", fake.objects)
+	}
+}
+
+func TestNewS3BackendConditionalWriteUnsupported(t *testing.T) {
+	client, fake := newFakeS3Client(t)
+	fake.unconditional = true
+
+	if _, err := NewS3Backend(t.Context(), client, "locks", "terraform", time.Minute); !errors.Is(err, ErrConditionalWriteUnsupported) {
+		t.Fatalf("NewS3Backend() error = %v, want %v", err, ErrConditionalWriteUnsupported)
+	}
+	if len(fake.objects) != 0 {
+		t.Fatalf("probe object not deleted: %v", fake.objects)
+	}
+}
+
+func TestS3BackendStaleLock(t *testing.T) {
+	client, fake := newFakeS3Client(t)
+
+	// A lock left by a crashed process.
+	fake.objects["/locks/"+lockName("edge")] = fakeObject{etag: `"crashed"`, modified: time.Now().Add(-2 * time.Minute)}
+
+	b, err := NewS3Backend(t.Context(), client, "locks", "", time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := b.Lock(t.Context(), "edge"); err != nil {
+		t.Fatalf("Lock() error = %v", err)
+	}
+	if object := fake.objects["/locks/"+lockName("edge")]; object.etag == `"crashed"` {
+		t.Fatal("stale lock not replaced")
+	}
+	if err := b.Unlock(t.Context(), "edge"); err != nil {
+		t.Fatalf("Unlock() error = %v", err)
+	}
+}
+
+func TestNewS3BackendInvalid(t *testing.T) {
+	client, _ := newFakeS3Client(t)
+
+	if _, err := NewS3Backend(t.Context(), client, "", "", 0); err == nil || !strings.Contains(err.Error(), "bucket") {
+		t.Fatalf("NewS3Backend() error = %v, want an empty bucket error", err)
+	}
+	if _, err := NewS3Backend(t.Context(), nil, "locks", "", 0); err == nil {
+		t.Fatal("NewS3Backend() error = nil, want an error without client")
+	}
+}
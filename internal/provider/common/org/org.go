@@ -23,6 +23,7 @@ import (
 
 	v1 "github.com/orange-cloudavenue/cloudavenue-sdk-go/v1"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
+	cerrs "github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/errors"
 )
 
 type Org struct {
@@ -34,7 +35,7 @@ type Org struct {
 func Init(c *client.CloudAvenue) (org Org, diags diag.Diagnostics) {
 	o, err := c.CAVSDK.V1.Org()
 	if err != nil {
-		diags.AddError("Error reading Org", err.Error())
+		cerrs.AddError(&diags, cerrs.ActionRead, "Org", err)
 		return org, diags
 	}
 
@@ -0,0 +1,42 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+// Package readonly rejects the changes when the provider is read-only (read_only
+// attribute or CLOUDAVENUE_READ_ONLY environment variable).
+//
+// The resources are wrapped by Wrap. The actions and the ephemeral resources
+// creating a token or a credential check the provider data themselves and
+// return Error.
+package readonly
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// providerTypeName is the type name of the provider, used to name the
+// resource in the read-only error.
+const providerTypeName = "cloudavenue"
+
+// ErrorSummary is the summary of the error returned by the changes rejected
+// because the provider is read-only.
+const ErrorSummary = "Provider is read-only"
+
+// Error returns the error of a change rejected because the provider is
+// read-only. The type name is the name of the resource, the action or the
+// ephemeral resource and the action is the participle of the rejected change
+// (e.g. "created").
+func Error(typeName, action string) diag.Diagnostic {
+	return diag.NewErrorDiagnostic(
+		ErrorSummary,
+		typeName+" cannot be "+action+" because the provider is configured as read-only "+
+			"(read_only attribute or CLOUDAVENUE_READ_ONLY environment variable). "+
+			"The read-only mode only allows reading the resources and the data sources, for example to detect a drift. "+
+			"Disable it to apply changes.",
+	)
+}
@@ -0,0 +1,195 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package readonly_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/identity"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/readonly"
+)
+
+type testClient struct {
+	readOnly bool
+}
+
+func (testClient) GetOrgName() string { return "cav01ev01ocb0001234" }
+
+func (c testClient) IsReadOnly() bool { return c.readOnly }
+
+// testResource is a resource without identity nor import.
+type testResource struct {
+	configured bool
+	created    bool
+}
+
+func (r *testResource) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "cloudavenue_test"
+}
+
+func (r *testResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{Computed: true},
+		},
+	}
+}
+
+func (r *testResource) Configure(context.Context, resource.ConfigureRequest, *resource.ConfigureResponse) {
+	r.configured = true
+}
+
+func (r *testResource) Create(ctx context.Context, _ resource.CreateRequest, resp *resource.CreateResponse) {
+	r.created = true
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), types.StringValue("1"))...)
+}
+
+func (r *testResource) Read(context.Context, resource.ReadRequest, *resource.ReadResponse) {}
+
+func (r *testResource) Update(context.Context, resource.UpdateRequest, *resource.UpdateResponse) {}
+
+func (r *testResource) Delete(context.Context, resource.DeleteRequest, *resource.DeleteResponse) {}
+
+// testIdentityResource is a resource with an identity and the import.
+type testIdentityResource struct {
+	testResource
+}
+
+func (r *testIdentityResource) ImportState(context.Context, resource.ImportStateRequest, *resource.ImportStateResponse) {
+}
+
+func (r *testIdentityResource) IdentitySpec() identity.Spec {
+	return identity.Spec{Attributes: []identity.Attribute{{Name: "id"}}}
+}
+
+// newTestResources wraps the resources as the provider does.
+func newTestResources(t *testing.T, client testClient, resources ...resource.Resource) []resource.Resource {
+	t.Helper()
+
+	newResources := make([]func() resource.Resource, 0, len(resources))
+	for _, r := range resources {
+		newResources = append(newResources, func() resource.Resource { return r })
+	}
+
+	wrapped := make([]resource.Resource, 0, len(resources))
+	for _, newResource := range identity.Wrap(readonly.Wrap(newResources)) {
+		r := newResource()
+		rc, ok := r.(resource.ResourceWithConfigure)
+		if !ok {
+			t.Fatal("wrapped resource does not implement ResourceWithConfigure")
+		}
+		rc.Configure(t.Context(), resource.ConfigureRequest{ProviderData: client}, &resource.ConfigureResponse{})
+		wrapped = append(wrapped, r)
+	}
+
+	return wrapped
+}
+
+func TestWrapKeepsInterfaces(t *testing.T) {
+	plain, withIdentity := &testResource{}, &testIdentityResource{}
+	resources := newTestResources(t, testClient{}, plain, withIdentity)
+
+	if _, ok := resources[0].(resource.ResourceWithImportState); ok {
+		t.Error("the resource without import implements ResourceWithImportState")
+	}
+	if _, ok := resources[0].(resource.ResourceWithIdentity); ok {
+		t.Error("the resource without identity implements ResourceWithIdentity")
+	}
+	if _, ok := resources[1].(resource.ResourceWithImportState); !ok {
+		t.Error("the resource with import does not implement ResourceWithImportState")
+	}
+	if _, ok := resources[1].(resource.ResourceWithIdentity); !ok {
+		t.Error("the resource with identity does not implement ResourceWithIdentity")
+	}
+	if !plain.configured || !withIdentity.configured {
+		t.Error("Configure() is not forwarded to the resources")
+	}
+}
+
+func TestWrapReadOnly(t *testing.T) {
+	ctx := t.Context()
+
+	tests := []struct {
+		name     string
+		readOnly bool
+	}{
+		{
+			name:     "Read-only",
+			readOnly: true,
+		},
+		{
+			name: "Read-write",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			inner := &testResource{}
+			r := newTestResources(t, testClient{readOnly: tt.readOnly}, inner)[0]
+
+			schemaResp := resource.SchemaResponse{}
+			r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+			s := schemaResp.Schema
+
+			createResp := &resource.CreateResponse{
+				State: tfsdk.State{
+					Schema: s,
+					Raw:    tftypes.NewValue(s.Type().TerraformType(ctx), nil),
+				},
+			}
+			r.Create(ctx, resource.CreateRequest{}, createResp)
+
+			if !tt.readOnly {
+				if createResp.Diagnostics.HasError() || !inner.created {
+					t.Fatalf("Create() diagnostics = %+v, want the resource created", createResp.Diagnostics)
+				}
+				return
+			}
+
+			if !createResp.Diagnostics.HasError() || createResp.Diagnostics[0].Summary() != readonly.ErrorSummary {
+				t.Fatalf("Create() diagnostics = %+v, want the read-only error", createResp.Diagnostics)
+			}
+			if inner.created || !createResp.State.Raw.IsNull() {
+				t.Error("Create() created the resource of a read-only provider")
+			}
+
+			updateResp := &resource.UpdateResponse{}
+			r.Update(ctx, resource.UpdateRequest{}, updateResp)
+			if !updateResp.Diagnostics.HasError() {
+				t.Fatal("Update() diagnostics have no error, want the read-only error")
+			}
+
+			deleteResp := &resource.DeleteResponse{}
+			r.Delete(ctx, resource.DeleteRequest{}, deleteResp)
+			if !deleteResp.Diagnostics.HasError() {
+				t.Fatal("Delete() diagnostics have no error, want the read-only error")
+			}
+			if got := deleteResp.Diagnostics[0].Detail(); !strings.Contains(got, "cloudavenue_test") {
+				t.Errorf("Delete() detail = %q, want the resource type", got)
+			}
+
+			// Read keeps working.
+			readResp := &resource.ReadResponse{}
+			r.Read(ctx, resource.ReadRequest{}, readResp)
+			if readResp.Diagnostics.HasError() {
+				t.Fatalf("Read() diagnostics: %+v", readResp.Diagnostics)
+			}
+		})
+	}
+}
@@ -0,0 +1,211 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package readonly
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/identity"
+)
+
+// readOnlyGetter is implemented by the provider data (client.CloudAvenue).
+type readOnlyGetter interface {
+	IsReadOnly() bool
+}
+
+var (
+	_ resource.Resource                     = &readOnlyResource{}
+	_ resource.ResourceWithConfigure        = &readOnlyResource{}
+	_ resource.ResourceWithModifyPlan       = &readOnlyResource{}
+	_ resource.ResourceWithValidateConfig   = &readOnlyResource{}
+	_ resource.ResourceWithConfigValidators = &readOnlyResource{}
+	_ resource.ResourceWithUpgradeState     = &readOnlyResource{}
+	_ resource.ResourceWithMoveState        = &readOnlyResource{}
+	_ resource.ResourceWithImportState      = &readOnlyResourceWithImport{}
+	_ identity.ResourceWithIdentitySpec     = &readOnlyResourceWithIdentitySpec{}
+	_ resource.ResourceWithImportState      = &readOnlyResourceWithImportAndIdentitySpec{}
+	_ identity.ResourceWithIdentitySpec     = &readOnlyResourceWithImportAndIdentitySpec{}
+)
+
+// readOnlyResource rejects the changes of a resource when the provider is
+// read-only. The other methods are forwarded to the resource.
+type readOnlyResource struct {
+	resource.Resource
+
+	readOnly bool
+}
+
+// readOnlyResourceWithImport is a readOnlyResource of a resource that
+// supports the import.
+type readOnlyResourceWithImport struct {
+	*readOnlyResource
+
+	importer resource.ResourceWithImportState
+}
+
+// readOnlyResourceWithIdentitySpec is a readOnlyResource of a resource that
+// has an identity, so that it is still wrapped by identity.Wrap.
+type readOnlyResourceWithIdentitySpec struct {
+	*readOnlyResource
+
+	spec identity.Spec
+}
+
+// readOnlyResourceWithImportAndIdentitySpec is a readOnlyResource of a
+// resource that supports the import and has an identity.
+type readOnlyResourceWithImportAndIdentitySpec struct {
+	*readOnlyResourceWithImport
+
+	spec identity.Spec
+}
+
+// Wrap rejects the creation, the update and the deletion of the resources
+// when the provider is read-only. The reads and the imports are allowed.
+//
+// Wrap must be applied before identity.Wrap: the wrapped resources keep the
+// import and the identity of the resources.
+func Wrap(resources []func() resource.Resource) []func() resource.Resource {
+	wrapped := make([]func() resource.Resource, 0, len(resources))
+
+	for _, newResource := range resources {
+		wrapped = append(wrapped, func() resource.Resource {
+			return newReadOnlyResource(newResource())
+		})
+	}
+
+	return wrapped
+}
+
+func newReadOnlyResource(r resource.Resource) resource.Resource {
+	ro := &readOnlyResource{Resource: r}
+
+	importer, withImport := r.(resource.ResourceWithImportState)
+	spec, withSpec := r.(identity.ResourceWithIdentitySpec)
+
+	switch {
+	case withImport && withSpec:
+		return &readOnlyResourceWithImportAndIdentitySpec{
+			readOnlyResourceWithImport: &readOnlyResourceWithImport{readOnlyResource: ro, importer: importer},
+			spec:                       spec.IdentitySpec(),
+		}
+	case withImport:
+		return &readOnlyResourceWithImport{readOnlyResource: ro, importer: importer}
+	case withSpec:
+		return &readOnlyResourceWithIdentitySpec{readOnlyResource: ro, spec: spec.IdentitySpec()}
+	default:
+		return ro
+	}
+}
+
+// readOnlyError returns the error of a change of the resource rejected
+// because the provider is read-only.
+func (r *readOnlyResource) readOnlyError(ctx context.Context, action string) diag.Diagnostic {
+	resp := resource.MetadataResponse{}
+	r.Resource.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: providerTypeName}, &resp)
+
+	return Error(resp.TypeName, action)
+}
+
+// Configure configures the resource.
+func (r *readOnlyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if c, ok := req.ProviderData.(readOnlyGetter); ok {
+		r.readOnly = c.IsReadOnly()
+	}
+
+	if rc, ok := r.Resource.(resource.ResourceWithConfigure); ok {
+		rc.Configure(ctx, req, resp)
+	}
+}
+
+// Create creates the resource.
+func (r *readOnlyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.readOnly {
+		resp.Diagnostics.Append(r.readOnlyError(ctx, "created"))
+		return
+	}
+
+	r.Resource.Create(ctx, req, resp)
+}
+
+// Update updates the resource.
+func (r *readOnlyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.readOnly {
+		resp.Diagnostics.Append(r.readOnlyError(ctx, "updated"))
+		return
+	}
+
+	r.Resource.Update(ctx, req, resp)
+}
+
+// Delete deletes the resource.
+func (r *readOnlyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.readOnly {
+		resp.Diagnostics.Append(r.readOnlyError(ctx, "deleted"))
+		return
+	}
+
+	r.Resource.Delete(ctx, req, resp)
+}
+
+// ModifyPlan forwards the call to the resource.
+func (r *readOnlyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if rm, ok := r.Resource.(resource.ResourceWithModifyPlan); ok {
+		rm.ModifyPlan(ctx, req, resp)
+	}
+}
+
+// ValidateConfig forwards the call to the resource.
+func (r *readOnlyResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	if rv, ok := r.Resource.(resource.ResourceWithValidateConfig); ok {
+		rv.ValidateConfig(ctx, req, resp)
+	}
+}
+
+// ConfigValidators forwards the call to the resource.
+func (r *readOnlyResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	if rv, ok := r.Resource.(resource.ResourceWithConfigValidators); ok {
+		return rv.ConfigValidators(ctx)
+	}
+	return nil
+}
+
+// UpgradeState forwards the call to the resource.
+func (r *readOnlyResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	if ru, ok := r.Resource.(resource.ResourceWithUpgradeState); ok {
+		return ru.UpgradeState(ctx)
+	}
+	return nil
+}
+
+// MoveState forwards the call to the resource.
+func (r *readOnlyResource) MoveState(ctx context.Context) []resource.StateMover {
+	if rm, ok := r.Resource.(resource.ResourceWithMoveState); ok {
+		return rm.MoveState(ctx)
+	}
+	return nil
+}
+
+// ImportState forwards the call to the resource.
+func (r *readOnlyResourceWithImport) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	r.importer.ImportState(ctx, req, resp)
+}
+
+// IdentitySpec returns the identity of the wrapped resource.
+func (r *readOnlyResourceWithIdentitySpec) IdentitySpec() identity.Spec {
+	return r.spec
+}
+
+// IdentitySpec returns the identity of the wrapped resource.
+func (r *readOnlyResourceWithImportAndIdentitySpec) IdentitySpec() identity.Spec {
+	return r.spec
+}
@@ -0,0 +1,295 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+// Package stateupgrade provides the state upgraders of the resources whose
+// schema has changed.
+//
+// A resource with a breaking schema change declares its upgrade steps in
+// order, the step i upgrading the state from the version i to the version
+// i+1. The schema version of the resource is the number of steps:
+//
+//	var vmStateUpgrade = stateupgrade.Steps{
+//		stateupgrade.Move("cpus", "resource.cpus"),
+//	}
+//
+//	resp.Schema.Version = vmStateUpgrade.Version()
+//
+//	func (r *vmResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+//		return vmStateUpgrade.Upgraders()
+//	}
+//
+// A breaking change of the schema adds a step at the end of the list, the
+// existing steps are never modified.
+//
+// The steps work on the raw state. A step that can't be derived from the
+// state alone, such as replacing a name by an ID, resolves the values through
+// the API with Resolve. The resource then builds its steps with its client,
+// which is configured before the upgrade:
+//
+//	func (r *appPortProfileResource) stateUpgrade() stateupgrade.Steps {
+//		return stateupgrade.Steps{
+//			stateupgrade.Resolve("vdc", []string{"edge_gateway_id", "edge_gateway_name"}, r.edgeGatewayOfVDC),
+//		}
+//	}
+//
+// Since the schema versions have been introduced after some breaking
+// changes, a state of the version 0 may have any of the layouts of the
+// resource: the steps upgrading it skip the attributes that are not in the
+// state.
+package stateupgrade
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// State is the raw state of a resource, as decoded from JSON.
+type State map[string]any
+
+// Step upgrades the raw state of a resource by one version.
+type Step func(ctx context.Context, state State) error
+
+// Resolver returns the values of the attributes replacing an attribute of
+// the prior state, by attribute name. It is called with the value of the
+// replaced attribute and may call the API.
+type Resolver func(ctx context.Context, value string) (map[string]any, error)
+
+// Steps are the upgrade steps of a resource, in order.
+type Steps []Step
+
+// Version returns the schema version of the resource.
+func (s Steps) Version() int64 {
+	return int64(len(s))
+}
+
+// Upgraders returns the state upgraders of every prior version of the
+// resource to its current version.
+func (s Steps) Upgraders() map[int64]resource.StateUpgrader {
+	upgraders := make(map[int64]resource.StateUpgrader, len(s))
+	for version := range s {
+		upgraders[int64(version)] = resource.StateUpgrader{
+			StateUpgrader: s[version:].upgrade,
+		}
+	}
+	return upgraders
+}
+
+// upgrade applies the steps to the raw state and sets the upgraded state.
+// The attributes removed from the schema are dropped.
+func (s Steps) upgrade(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	if req.RawState == nil {
+		resp.Diagnostics.AddError("Unable to upgrade resource state", "The prior state of the resource is empty.")
+		return
+	}
+
+	state := State{}
+	if err := json.Unmarshal(req.RawState.JSON, &state); err != nil {
+		resp.Diagnostics.AddError("Unable to upgrade resource state", fmt.Sprintf("Error reading the prior state of the resource: %s", err))
+		return
+	}
+
+	for _, step := range s {
+		if err := step(ctx, state); err != nil {
+			resp.Diagnostics.AddError("Unable to upgrade resource state", err.Error())
+			return
+		}
+	}
+
+	data, err := json.Marshal(state)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to upgrade resource state", fmt.Sprintf("Error writing the upgraded state of the resource: %s", err))
+		return
+	}
+
+	value, err := tftypes.ValueFromJSONWithOpts(data, resp.State.Schema.Type().TerraformType(ctx), tftypes.ValueFromJSONOpts{
+		IgnoreUndefinedAttributes: true,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to upgrade resource state", fmt.Sprintf("The upgraded state does not match the schema of the resource: %s", err))
+		return
+	}
+
+	resp.State.Raw = value
+}
+
+// Move moves the value of the attribute from to the attribute to. The paths
+// are dotted, the objects missing in the path to are created. The step is
+// skipped if the state has no attribute from or already has an attribute to.
+func Move(from, to string) Step {
+	return func(ctx context.Context, state State) error {
+		value, ok := state.get(from)
+		if !ok {
+			return nil
+		}
+		if _, exists := state.get(to); exists {
+			return nil
+		}
+
+		if err := state.set(to, value); err != nil {
+			return fmt.Errorf("error moving the attribute %s to %s: %w", from, to, err)
+		}
+		state.remove(from)
+		return nil
+	}
+}
+
+// Remove removes the attributes from the state.
+func Remove(paths ...string) Step {
+	return func(ctx context.Context, state State) error {
+		for _, p := range paths {
+			state.remove(p)
+		}
+		return nil
+	}
+}
+
+// Default sets the value of the attribute if it is missing or null.
+func Default(path string, value any) Step {
+	return func(ctx context.Context, state State) error {
+		if current, ok := state.get(path); ok && current != nil {
+			return nil
+		}
+
+		if err := state.set(path, value); err != nil {
+			return fmt.Errorf("error setting the default value of the attribute %s: %w", path, err)
+		}
+		return nil
+	}
+}
+
+// Unwrap replaces a list of at most one object by the object, to convert a
+// block to a single nested attribute. An empty list is replaced by null.
+func Unwrap(path string) Step {
+	return func(ctx context.Context, state State) error {
+		value, ok := state.get(path)
+		if !ok {
+			return nil
+		}
+
+		list, ok := value.([]any)
+		if !ok {
+			return nil
+		}
+
+		switch len(list) {
+		case 0:
+			return state.set(path, nil)
+		case 1:
+			return state.set(path, list[0])
+		default:
+			return fmt.Errorf("error unwrapping the attribute %s: the list has %d elements", path, len(list))
+		}
+	}
+}
+
+// Chain combines several steps in a single step, applied in order.
+func Chain(steps ...Step) Step {
+	return func(ctx context.Context, state State) error {
+		for _, step := range steps {
+			if err := step(ctx, state); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+// Resolve replaces the attribute from by the attributes to, whose values are
+// returned by resolve. The step is skipped if the state has no attribute
+// from, the attribute from is only removed if one of the attributes to is
+// already set.
+func Resolve(from string, to []string, resolve Resolver) Step {
+	return func(ctx context.Context, state State) error {
+		value, ok := state.get(from)
+		if !ok {
+			return nil
+		}
+		state.remove(from)
+
+		name, ok := value.(string)
+		if !ok || name == "" {
+			return nil
+		}
+		for _, p := range to {
+			if current, exists := state.get(p); exists && current != nil {
+				return nil
+			}
+		}
+
+		values, err := resolve(ctx, name)
+		if err != nil {
+			return fmt.Errorf("error resolving the attribute %s %q: %w", from, name, err)
+		}
+		for _, p := range to {
+			if err := state.set(p, values[p]); err != nil {
+				return fmt.Errorf("error setting the attribute %s resolved from %s: %w", p, from, err)
+			}
+		}
+		return nil
+	}
+}
+
+// get returns the value of the attribute at the dotted path.
+func (s State) get(path string) (any, bool) {
+	parent, name := s.parent(path, false)
+	if parent == nil {
+		return nil, false
+	}
+
+	value, ok := parent[name]
+	return value, ok
+}
+
+// set sets the value of the attribute at the dotted path, creating the
+// missing objects.
+func (s State) set(path string, value any) error {
+	parent, name := s.parent(path, true)
+	if parent == nil {
+		return fmt.Errorf("the parent of %s is not an object", path)
+	}
+
+	parent[name] = value
+	return nil
+}
+
+// remove removes the attribute at the dotted path.
+func (s State) remove(path string) {
+	if parent, name := s.parent(path, false); parent != nil {
+		delete(parent, name)
+	}
+}
+
+// parent returns the object holding the attribute at the dotted path and the
+// name of the attribute in this object. The missing or null objects are
+// created if create is true.
+func (s State) parent(path string, create bool) (map[string]any, string) {
+	names := strings.Split(path, ".")
+
+	current := map[string]any(s)
+	for _, name := range names[:len(names)-1] {
+		value, ok := current[name]
+		if (!ok || value == nil) && create {
+			value = map[string]any{}
+			current[name] = value
+		}
+
+		object, ok := value.(map[string]any)
+		if !ok {
+			return nil, ""
+		}
+		current = object
+	}
+
+	return current, names[len(names)-1]
+}
@@ -0,0 +1,273 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package stateupgrade_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/stateupgrade"
+)
+
+func TestSteps(t *testing.T) {
+	currentSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id":   schema.StringAttribute{Computed: true},
+			"name": schema.StringAttribute{Required: true},
+			"resource": schema.SingleNestedAttribute{
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"cpus":   schema.Int64Attribute{Optional: true},
+					"memory": schema.Int64Attribute{Optional: true},
+				},
+			},
+			"settings": schema.SingleNestedAttribute{
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"customization": schema.SingleNestedAttribute{
+						Optional: true,
+						Attributes: map[string]schema.Attribute{
+							"hostname": schema.StringAttribute{Optional: true},
+						},
+					},
+				},
+			},
+			"deletion_protection": schema.BoolAttribute{Optional: true},
+		},
+	}
+
+	steps := stateupgrade.Steps{
+		// 0 -> 1: the flat layout is nested.
+		stateupgrade.Chain(
+			stateupgrade.Move("cpus", "resource.cpus"),
+			stateupgrade.Move("memory", "resource.memory"),
+			stateupgrade.Unwrap("customization"),
+			stateupgrade.Move("customization", "settings.customization"),
+		),
+		// 1 -> 2: the deletion protection is added.
+		stateupgrade.Default("deletion_protection", false),
+	}
+
+	if steps.Version() != 2 {
+		t.Fatalf("Version() = %d, want 2", steps.Version())
+	}
+
+	tests := []struct {
+		name        string
+		version     int64
+		state       string
+		wantErr     bool
+		wantCPUs    types.Int64
+		wantHost    types.String
+		wantProtect types.Bool
+	}{
+		{
+			name:        "Flat layout",
+			version:     0,
+			state:       `{"id":"vm-1","name":"vm","cpus":2,"memory":2048,"customization":[{"hostname":"host"}],"removed":"value"}`,
+			wantCPUs:    types.Int64Value(2),
+			wantHost:    types.StringValue("host"),
+			wantProtect: types.BoolValue(false),
+		},
+		{
+			name:        "Nested layout of version 0",
+			version:     0,
+			state:       `{"id":"vm-1","name":"vm","resource":{"cpus":4,"memory":2048},"settings":{"customization":{"hostname":"host"}}}`,
+			wantCPUs:    types.Int64Value(4),
+			wantHost:    types.StringValue("host"),
+			wantProtect: types.BoolValue(false),
+		},
+		{
+			name:        "Empty block",
+			version:     0,
+			state:       `{"id":"vm-1","name":"vm","cpus":2,"customization":[]}`,
+			wantCPUs:    types.Int64Value(2),
+			wantHost:    types.StringNull(),
+			wantProtect: types.BoolValue(false),
+		},
+		{
+			name:        "Version 1",
+			version:     1,
+			state:       `{"id":"vm-1","name":"vm","resource":{"cpus":4},"deletion_protection":true}`,
+			wantCPUs:    types.Int64Value(4),
+			wantHost:    types.StringNull(),
+			wantProtect: types.BoolValue(true),
+		},
+		{
+			name:    "Several blocks",
+			version: 0,
+			state:   `{"id":"vm-1","name":"vm","customization":[{"hostname":"a"},{"hostname":"b"}]}`,
+			wantErr: true,
+		},
+		{
+			name:    "Invalid JSON",
+			version: 0,
+			state:   `{"id":`,
+			wantErr: true,
+		},
+	}
+
+	ctx := context.Background()
+	upgraders := steps.Upgraders()
+	if len(upgraders) != 2 {
+		t.Fatalf("Upgraders() returned %d upgraders, want 2", len(upgraders))
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			upgrader, ok := upgraders[tt.version]
+			if !ok {
+				t.Fatalf("no upgrader for the version %d", tt.version)
+			}
+
+			resp := &resource.UpgradeStateResponse{
+				State: tfsdk.State{Schema: currentSchema},
+			}
+			upgrader.StateUpgrader(ctx, resource.UpgradeStateRequest{
+				RawState: &tfprotov6.RawState{JSON: []byte(tt.state)},
+			}, resp)
+
+			if resp.Diagnostics.HasError() != tt.wantErr {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			var cpus types.Int64
+			resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("resource").AtName("cpus"), &cpus)...)
+			var hostname types.String
+			resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("settings").AtName("customization").AtName("hostname"), &hostname)...)
+			var protect types.Bool
+			resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("deletion_protection"), &protect)...)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+
+			if !cpus.Equal(tt.wantCPUs) {
+				t.Errorf("resource.cpus = %s, want %s", cpus, tt.wantCPUs)
+			}
+			if !hostname.Equal(tt.wantHost) {
+				t.Errorf("settings.customization.hostname = %s, want %s", hostname, tt.wantHost)
+			}
+			if !protect.Equal(tt.wantProtect) {
+				t.Errorf("deletion_protection = %s, want %s", protect, tt.wantProtect)
+			}
+		})
+	}
+}
+
+func TestStepsWithoutState(t *testing.T) {
+	upgrader := stateupgrade.Steps{stateupgrade.Remove("name")}.Upgraders()[0]
+
+	resp := &resource.UpgradeStateResponse{}
+	upgrader.StateUpgrader(context.Background(), resource.UpgradeStateRequest{}, resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected an error for an empty prior state")
+	}
+}
+
+func TestResolve(t *testing.T) {
+	currentSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id":                schema.StringAttribute{Computed: true},
+			"edge_gateway_id":   schema.StringAttribute{Optional: true, Computed: true},
+			"edge_gateway_name": schema.StringAttribute{Optional: true, Computed: true},
+		},
+	}
+
+	var calls int
+	steps := stateupgrade.Steps{
+		// 0 -> 1: the VDC is replaced by the edge gateway.
+		stateupgrade.Resolve("vdc", []string{"edge_gateway_id", "edge_gateway_name"}, func(_ context.Context, value string) (map[string]any, error) {
+			calls++
+			if value != "vdc01" {
+				return nil, errors.New("no edge gateway found")
+			}
+			return map[string]any{
+				"edge_gateway_id":   "urn:vcloud:gateway:1",
+				"edge_gateway_name": "edge01",
+			}, nil
+		}),
+	}
+
+	tests := []struct {
+		name      string
+		state     string
+		wantErr   bool
+		wantCalls int
+		wantID    types.String
+	}{
+		{
+			name:      "Resolved",
+			state:     `{"id":"1","vdc":"vdc01"}`,
+			wantCalls: 1,
+			wantID:    types.StringValue("urn:vcloud:gateway:1"),
+		},
+		{
+			name:   "Already set",
+			state:  `{"id":"1","vdc":"vdc01","edge_gateway_id":"urn:vcloud:gateway:2"}`,
+			wantID: types.StringValue("urn:vcloud:gateway:2"),
+		},
+		{
+			name:   "Missing",
+			state:  `{"id":"1","edge_gateway_id":"urn:vcloud:gateway:2"}`,
+			wantID: types.StringValue("urn:vcloud:gateway:2"),
+		},
+		{
+			name:      "Error",
+			state:     `{"id":"1","vdc":"vdc02"}`,
+			wantErr:   true,
+			wantCalls: 1,
+		},
+	}
+
+	ctx := context.Background()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls = 0
+
+			resp := &resource.UpgradeStateResponse{
+				State: tfsdk.State{Schema: currentSchema},
+			}
+			steps.Upgraders()[0].StateUpgrader(ctx, resource.UpgradeStateRequest{
+				RawState: &tfprotov6.RawState{JSON: []byte(tt.state)},
+			}, resp)
+
+			if calls != tt.wantCalls {
+				t.Errorf("resolver called %d times, want %d", calls, tt.wantCalls)
+			}
+			if resp.Diagnostics.HasError() != tt.wantErr {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			var id types.String
+			resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("edge_gateway_id"), &id)...)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+			if !id.Equal(tt.wantID) {
+				t.Errorf("edge_gateway_id = %s, want %s", id, tt.wantID)
+			}
+		})
+	}
+}
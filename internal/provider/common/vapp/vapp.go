@@ -58,7 +58,9 @@ type VAPP struct {
 var (
 	// ErrVAppRefEmpty indicates vApp reference is missing required fields.
 	ErrVAppRefEmpty = errors.New("missing information in vApp reference")
-	vcdMutexKV      = mutex.NewKV()
+	// vcdMutexKV is the global mutex, so the vApp locks are also shared with
+	// the other provider processes when a lock backend is configured.
+	vcdMutexKV = mutex.GlobalMutex
 )
 
 // Schema returns schema for `vapp_id` and `vapp_name`.
@@ -159,7 +161,9 @@ func (v VAPP) LockVAPP(ctx context.Context) (d diag.Diagnostics) {
 		return d
 	}
 	key := fmt.Sprintf("vdc:%s|vapp:%s", v.vdc.GetName(), v.GetName())
-	vcdMutexKV.KvLock(ctx, key)
+	if err := vcdMutexKV.KvLock(ctx, key); err != nil {
+		d.AddError("Error acquiring the vApp lock", err.Error())
+	}
 	return d
 }
 
@@ -21,16 +21,164 @@ package vm
 import (
 	"context"
 	"fmt"
+	"net/http"
+
+	"github.com/vmware/go-vcloud-director/v2/govcd"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/vapp"
+)
+
+const (
+	powerON   = "POWERED_ON"
+	powerOFF  = "POWERED_OFF"
+	suspended = "SUSPENDED"
 )
 
+// PowerOperation is a power operation of a VM or a vApp.
+type PowerOperation string
+
 const (
-	powerON = "POWERED_ON"
-	// powerOFF = "POWERED_OFF".
+	PowerOperationPowerOn       PowerOperation = "power_on"
+	PowerOperationPowerOff      PowerOperation = "power_off"
+	PowerOperationReboot        PowerOperation = "reboot"
+	PowerOperationReset         PowerOperation = "reset"
+	PowerOperationShutdownGuest PowerOperation = "shutdown_guest"
+	PowerOperationSuspend       PowerOperation = "suspend"
 )
 
+// PowerOperations returns all the power operations.
+func PowerOperations() []PowerOperation {
+	return []PowerOperation{
+		PowerOperationPowerOn,
+		PowerOperationPowerOff,
+		PowerOperationReboot,
+		PowerOperationReset,
+		PowerOperationShutdownGuest,
+		PowerOperationSuspend,
+	}
+}
+
+// PowerOperationsString returns all the power operations as strings.
+func PowerOperationsString() []string {
+	operations := make([]string, 0, len(PowerOperations()))
+	for _, operation := range PowerOperations() {
+		operations = append(operations, string(operation))
+	}
+	return operations
+}
+
+// targetStatus returns the status reached by the power operation, or an
+// empty string if the operation does not end in a stable status (reboot and
+// reset).
+func (o PowerOperation) targetStatus() string {
+	switch o {
+	case PowerOperationPowerOn:
+		return powerON
+	case PowerOperationPowerOff, PowerOperationShutdownGuest:
+		return powerOFF
+	case PowerOperationSuspend:
+		return suspended
+	case PowerOperationReboot, PowerOperationReset:
+		return ""
+	}
+	return ""
+}
+
+// powerTarget is a VM or a vApp on which a power operation can be run.
+type powerTarget struct {
+	kind       string
+	name       string
+	getStatus  func() (string, error)
+	operations map[PowerOperation]func() (govcd.Task, error)
+}
+
+// Power runs the power operation on the VM and waits for its completion.
+// The operation is skipped if the VM is already in the status it leads to.
+//
+// The power off operation undeploys the VM, like the power off of the
+// Cloud Avenue console, and the shutdown_guest operation shuts down the guest
+// OS before undeploying the VM.
+func (v VM) Power(ctx context.Context, c *client.CloudAvenue, operation PowerOperation) error {
+	// The SDK has no reboot, reset and suspend methods for a VM.
+	vmAction := func(action string) func() (govcd.Task, error) {
+		return func() (govcd.Task, error) {
+			return c.Vmware.Client.ExecuteTaskRequest(v.VM.VM.VM.HREF+"/power/action/"+action, http.MethodPost, "", "error running "+action+" on VM: %s", nil)
+		}
+	}
+
+	return power(ctx, powerTarget{
+		kind:      "VM",
+		name:      v.GetName(),
+		getStatus: v.GetStatus,
+		operations: map[PowerOperation]func() (govcd.Task, error){
+			PowerOperationPowerOn:       v.PowerOn,
+			PowerOperationPowerOff:      v.Undeploy,
+			PowerOperationShutdownGuest: v.Shutdown,
+			PowerOperationReboot:        vmAction("reboot"),
+			PowerOperationReset:         vmAction("reset"),
+			PowerOperationSuspend:       vmAction("suspend"),
+		},
+	}, operation)
+}
+
+// PowerVAPP runs the power operation on all the VMs of the vApp and waits for
+// its completion. The operation is skipped if the vApp is already in the
+// status it leads to.
+func PowerVAPP(ctx context.Context, vApp vapp.VAPP, operation PowerOperation) error {
+	return power(ctx, powerTarget{
+		kind:      "vApp",
+		name:      vApp.GetName(),
+		getStatus: vApp.GetStatus,
+		operations: map[PowerOperation]func() (govcd.Task, error){
+			PowerOperationPowerOn:       vApp.PowerOn,
+			PowerOperationPowerOff:      vApp.Undeploy,
+			PowerOperationShutdownGuest: vApp.Shutdown,
+			PowerOperationReboot:        vApp.Reboot,
+			PowerOperationReset:         vApp.Reset,
+			PowerOperationSuspend:       vApp.Suspend,
+		},
+	}, operation)
+}
+
+// power runs the power operation on the target.
+func power(ctx context.Context, target powerTarget, operation PowerOperation) error {
+	run, ok := target.operations[operation]
+	if !ok {
+		return fmt.Errorf("unsupported power operation %q", operation)
+	}
+
+	status, err := target.getStatus()
+	if err != nil {
+		return fmt.Errorf("error getting status of %s %s: %w", target.kind, target.name, err)
+	}
+
+	if want := operation.targetStatus(); want != "" && status == want {
+		tflog.Debug(ctx, "Power operation skipped, already in the expected status", map[string]any{
+			"kind":      target.kind,
+			"name":      target.name,
+			"operation": string(operation),
+			"status":    status,
+		})
+		return nil
+	}
+
+	task, err := run()
+	if err != nil {
+		return fmt.Errorf("error running %s on %s %s: %w", operation, target.kind, target.name, err)
+	}
+
+	if err := task.WaitTaskCompletion(); err != nil {
+		return fmt.Errorf("error waiting for %s on %s %s: %w", operation, target.kind, target.name, err)
+	}
+
+	return nil
+}
+
 type VMResourceModelState struct { //nolint:revive
 	PowerON types.Bool   `tfsdk:"power_on"`
 	Status  types.String `tfsdk:"status"`
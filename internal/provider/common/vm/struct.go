@@ -29,16 +29,17 @@ import (
 )
 
 type VMResourceModel struct { //nolint:revive
-	ID          types.String `tfsdk:"id"`
-	VDC         types.String `tfsdk:"vdc"`
-	Name        types.String `tfsdk:"name"`
-	VappName    types.String `tfsdk:"vapp_name"`
-	VappID      types.String `tfsdk:"vapp_id"`
-	Description types.String `tfsdk:"description"`
-	DeployOS    types.Object `tfsdk:"deploy_os"`
-	State       types.Object `tfsdk:"state"`
-	Resource    types.Object `tfsdk:"resource"`
-	Settings    types.Object `tfsdk:"settings"`
+	ID                 types.String `tfsdk:"id"`
+	VDC                types.String `tfsdk:"vdc"`
+	Name               types.String `tfsdk:"name"`
+	VappName           types.String `tfsdk:"vapp_name"`
+	VappID             types.String `tfsdk:"vapp_id"`
+	Description        types.String `tfsdk:"description"`
+	DeployOS           types.Object `tfsdk:"deploy_os"`
+	State              types.Object `tfsdk:"state"`
+	Resource           types.Object `tfsdk:"resource"`
+	Settings           types.Object `tfsdk:"settings"`
+	DeletionProtection types.Bool   `tfsdk:"deletion_protection"`
 }
 
 type VMResourceModelAllStructs struct { //nolint:revive
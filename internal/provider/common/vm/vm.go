@@ -83,7 +83,9 @@ func (v VM) LockVM(ctx context.Context) (d diag.Diagnostics) {
 		return d
 	}
 
-	mutex.GlobalMutex.KvLock(ctx, v.constructLockKey())
+	if err := mutex.GlobalMutex.KvLock(ctx, v.constructLockKey()); err != nil {
+		d.AddError("Error acquiring the VM lock", err.Error())
+	}
 	return d
 }
 
@@ -132,7 +132,7 @@ func (d *appPortProfileDataSource) Read(ctx context.Context, req datasource.Read
 			resp.Diagnostics.AddError("App Port Profile not found", err.Error())
 			return
 		}
-		resp.Diagnostics.AddError("Error reading App Port Profile", err.Error())
+		cerrs.AddError(&resp.Diagnostics, cerrs.ActionRead, "App Port Profile", err)
 		return
 	}
 
@@ -20,6 +20,7 @@ package edgegw
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 
@@ -36,8 +37,11 @@ import (
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/metrics"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/edgegw"
 	cerrs "github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/errors"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/identity"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/movestate"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/mutex"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/org"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/stateupgrade"
 )
 
 // NewAppPortProfileResource is a helper function to simplify the provider implementation.
@@ -82,6 +86,7 @@ func (r *appPortProfileResource) Metadata(_ context.Context, req resource.Metada
 // Schema defines the schema for the resource.
 func (r *appPortProfileResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = appPortProfilesSchema(ctx).GetResource(ctx)
+	resp.Schema.Version = r.stateUpgrade().Version()
 }
 
 func (r *appPortProfileResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -123,7 +128,10 @@ func (r *appPortProfileResource) Create(ctx context.Context, req resource.Create
 		Implement the resource creation logic here.
 	*/
 
-	mutex.GlobalMutex.KvLock(ctx, r.edgegw.GetID())
+	if err := mutex.GlobalMutex.KvLock(ctx, r.edgegw.GetID()); err != nil {
+		resp.Diagnostics.AddError("Error acquiring the lock", err.Error())
+		return
+	}
 	defer mutex.GlobalMutex.KvUnlock(ctx, r.edgegw.GetID())
 
 	appPortProfileModel, d := plan.toSDKAppPortProfile(ctx)
@@ -135,7 +143,7 @@ func (r *appPortProfileResource) Create(ctx context.Context, req resource.Create
 	// Create the application port profile
 	appPortProfile, err := r.edgegw.CreateFirewallAppPortProfile(appPortProfileModel)
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating application port profile", err.Error())
+		cerrs.AddError(&resp.Diagnostics, cerrs.ActionCreate, "application port profile", err)
 		return
 	}
 
@@ -219,7 +227,10 @@ func (r *appPortProfileResource) Update(ctx context.Context, req resource.Update
 		Implement the resource update here
 	*/
 
-	mutex.GlobalMutex.KvLock(ctx, r.edgegw.GetID())
+	if err := mutex.GlobalMutex.KvLock(ctx, r.edgegw.GetID()); err != nil {
+		resp.Diagnostics.AddError("Error acquiring the lock", err.Error())
+		return
+	}
 	defer mutex.GlobalMutex.KvUnlock(ctx, r.edgegw.GetID())
 
 	appPortProfile, err := r.edgegw.GetFirewallAppPortProfile(state.ID.Get())
@@ -236,7 +247,7 @@ func (r *appPortProfileResource) Update(ctx context.Context, req resource.Update
 
 	// Update the application port profile
 	if err := appPortProfile.Update(appPortProfileModel); err != nil {
-		resp.Diagnostics.AddError("Error updating application port profile", err.Error())
+		cerrs.AddError(&resp.Diagnostics, cerrs.ActionUpdate, "application port profile", err)
 		return
 	}
 
@@ -277,7 +288,10 @@ func (r *appPortProfileResource) Delete(ctx context.Context, req resource.Delete
 		Implement the resource deletion here
 	*/
 
-	mutex.GlobalMutex.KvLock(ctx, r.edgegw.GetID())
+	if err := mutex.GlobalMutex.KvLock(ctx, r.edgegw.GetID()); err != nil {
+		resp.Diagnostics.AddError("Error acquiring the lock", err.Error())
+		return
+	}
 	defer mutex.GlobalMutex.KvUnlock(ctx, r.edgegw.GetID())
 
 	appPortProfile, err := r.edgegw.GetFirewallAppPortProfile(state.ID.Get())
@@ -288,7 +302,7 @@ func (r *appPortProfileResource) Delete(ctx context.Context, req resource.Delete
 
 	// Delete the application port profile
 	if err := appPortProfile.Delete(); err != nil {
-		resp.Diagnostics.AddError("Error deleting application port profile", err.Error())
+		cerrs.AddError(&resp.Diagnostics, cerrs.ActionDelete, "application port profile", err)
 		return
 	}
 }
@@ -344,8 +358,81 @@ func (r *appPortProfileResource) ImportState(ctx context.Context, req resource.I
 	resp.Diagnostics.Append(resp.State.Set(ctx, stateRefreshed)...)
 }
 
+// IdentitySpec returns the identity of the resource.
+func (r *appPortProfileResource) IdentitySpec() identity.Spec {
+	return identity.Spec{
+		Attributes: []identity.Attribute{
+			{
+				Name:        "edge_gateway_id",
+				Description: "The ID of the edge gateway.",
+			},
+			{
+				Name:        "id",
+				Description: "The ID of the app port profile.",
+			},
+		},
+	}
+}
+
+// MoveState returns the state movers from the equivalent resources of the VMware vcd provider.
+func (r *appPortProfileResource) MoveState(_ context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		movestate.FromVCD("vcd_nsxt_app_port_profile", movestate.Attributes{
+			"id":              "id",
+			"name":            "name",
+			"edge_gateway_id": "context_id",
+		}),
+	}
+}
+
+// stateUpgrade returns the upgrade steps of the app port profile state.
+func (r *appPortProfileResource) stateUpgrade() stateupgrade.Steps {
+	return stateupgrade.Steps{
+		// 0 -> 1: the vdc attribute of the releases before v0.19.0 is
+		// replaced by the edge gateway (GH-691).
+		stateupgrade.Resolve("vdc", []string{"edge_gateway_id", "edge_gateway_name"}, r.edgeGatewayOfVDC),
+	}
+}
+
+// UpgradeState returns the state upgraders of the prior schema versions.
+func (r *appPortProfileResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return r.stateUpgrade().Upgraders()
+}
+
 // * CustomFuncs
 
+// edgeGatewayOfVDC returns the ID and the name of the edge gateway owned by
+// the VDC, for the states of the app port profiles scoped to a VDC.
+func (r *appPortProfileResource) edgeGatewayOfVDC(_ context.Context, vdcName string) (map[string]any, error) {
+	if r.client == nil {
+		return nil, errors.New("the provider is not configured")
+	}
+
+	gateways, err := r.client.CAVSDK.V1.EdgeGateway.List()
+	if err != nil {
+		return nil, err
+	}
+
+	var found map[string]any
+	for _, edge := range *gateways {
+		if edge.GetOwnerName() != vdcName {
+			continue
+		}
+		if found != nil {
+			return nil, errors.New("several edge gateways are owned by the VDC, remove the resource from the state and import it with <edge_gateway_id_or_name>.<app_port_profile_id_or_name>")
+		}
+		found = map[string]any{
+			"edge_gateway_id":   urn.Normalize(urn.Gateway, edge.GetID()).String(),
+			"edge_gateway_name": edge.GetName(),
+		}
+	}
+	if found == nil {
+		return nil, errors.New("no edge gateway is owned by the VDC, remove the resource from the state and import it with <edge_gateway_id_or_name>.<app_port_profile_id_or_name>")
+	}
+
+	return found, nil
+}
+
 func (r *appPortProfileResource) read(ctx context.Context, planOrState *AppPortProfileModel) (stateRefreshed *AppPortProfileModel, found bool, diags diag.Diagnostics) {
 	stateRefreshed = planOrState.Copy()
 
@@ -365,7 +452,7 @@ func (r *appPortProfileResource) read(ctx context.Context, planOrState *AppPortP
 		if cerrs.IsNotFound(err) {
 			return nil, false, nil
 		}
-		diags.AddError("Error reading App Port Profile", err.Error())
+		cerrs.AddError(&diags, cerrs.ActionRead, "App Port Profile", err)
 		return stateRefreshed, found, diags
 	}
 
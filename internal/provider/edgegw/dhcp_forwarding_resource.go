@@ -36,16 +36,20 @@ import (
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/metrics"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/edgegw"
 	cerrs "github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/errors"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/identity"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/movestate"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/mutex"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/org"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &dhcpForwardingResource{}
-	_ resource.ResourceWithConfigure   = &dhcpForwardingResource{}
-	_ resource.ResourceWithImportState = &dhcpForwardingResource{}
-	_ resource.ResourceWithModifyPlan  = &dhcpForwardingResource{}
+	_ resource.Resource                 = &dhcpForwardingResource{}
+	_ resource.ResourceWithConfigure    = &dhcpForwardingResource{}
+	_ resource.ResourceWithImportState  = &dhcpForwardingResource{}
+	_ resource.ResourceWithModifyPlan   = &dhcpForwardingResource{}
+	_ resource.ResourceWithMoveState    = &dhcpForwardingResource{}
+	_ identity.ResourceWithIdentitySpec = &dhcpForwardingResource{}
 )
 
 // NewDhcpForwardingResource is a helper function to simplify the provider implementation.
@@ -264,16 +268,22 @@ func (r *dhcpForwardingResource) Delete(ctx context.Context, req resource.Delete
 	}
 
 	if vdcOrVDCGroup.IsVDCGroup() {
-		mutex.GlobalMutex.KvLock(ctx, vdcOrVDCGroup.GetID())
+		if err := mutex.GlobalMutex.KvLock(ctx, vdcOrVDCGroup.GetID()); err != nil {
+			resp.Diagnostics.AddError("Error acquiring the lock", err.Error())
+			return
+		}
 		defer mutex.GlobalMutex.KvUnlock(ctx, vdcOrVDCGroup.GetID())
 	} else {
-		mutex.GlobalMutex.KvLock(ctx, r.edgegw.GetID())
+		if err := mutex.GlobalMutex.KvLock(ctx, r.edgegw.GetID()); err != nil {
+			resp.Diagnostics.AddError("Error acquiring the lock", err.Error())
+			return
+		}
 		defer mutex.GlobalMutex.KvUnlock(ctx, r.edgegw.GetID())
 	}
 
 	// There is no "delete" for DHCP forwarding. It can only be updated to empty values (disabled)
 	if _, err := r.edgegw.UpdateDhcpForwarder(&govcdtypes.NsxtEdgeGatewayDhcpForwarder{}); err != nil {
-		resp.Diagnostics.AddError("Error deleting DHCP forwarding", err.Error())
+		cerrs.AddError(&resp.Diagnostics, cerrs.ActionDelete, "DHCP forwarding", err)
 		return
 	}
 }
@@ -313,6 +323,27 @@ func (r *dhcpForwardingResource) ImportState(ctx context.Context, req resource.I
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(edgeGatewayName), r.edgegw.GetName())...)
 }
 
+// IdentitySpec returns the identity of the resource.
+func (r *dhcpForwardingResource) IdentitySpec() identity.Spec {
+	return identity.Spec{
+		Attributes: []identity.Attribute{
+			{
+				Name:        "edge_gateway_id",
+				Description: "The ID of the edge gateway.",
+			},
+		},
+	}
+}
+
+// MoveState returns the state movers from the equivalent resources of the VMware vcd provider.
+func (r *dhcpForwardingResource) MoveState(_ context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		movestate.FromVCD("vcd_nsxt_edgegateway_dhcp_forwarding", movestate.Attributes{
+			"edge_gateway_id": "edge_gateway_id",
+		}),
+	}
+}
+
 // ModifyPlan Check if DHCP servers can be edited.
 func (r *dhcpForwardingResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	dPlan := &DhcpForwardingModel{}
@@ -371,10 +402,16 @@ func (r *dhcpForwardingResource) createOrUpdate(ctx context.Context, plan *DhcpF
 	}
 
 	if vdcOrVDCGroup.IsVDCGroup() {
-		mutex.GlobalMutex.KvLock(ctx, vdcOrVDCGroup.GetID())
+		if err := mutex.GlobalMutex.KvLock(ctx, vdcOrVDCGroup.GetID()); err != nil {
+			diags.AddError("Error acquiring the lock", err.Error())
+			return diags
+		}
 		defer mutex.GlobalMutex.KvUnlock(ctx, vdcOrVDCGroup.GetID())
 	} else {
-		mutex.GlobalMutex.KvLock(ctx, r.edgegw.GetID())
+		if err := mutex.GlobalMutex.KvLock(ctx, r.edgegw.GetID()); err != nil {
+			diags.AddError("Error acquiring the lock", err.Error())
+			return diags
+		}
 		defer mutex.GlobalMutex.KvUnlock(ctx, r.edgegw.GetID())
 	}
 
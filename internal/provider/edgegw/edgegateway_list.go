@@ -0,0 +1,68 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package edgegw
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/metrics"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/listresource"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ list.ListResource              = &edgeGatewayListResource{}
+	_ list.ListResourceWithConfigure = &edgeGatewayListResource{}
+)
+
+// NewEdgeGatewayListResource is a helper function to simplify the provider implementation.
+func NewEdgeGatewayListResource() list.ListResource {
+	return &edgeGatewayListResource{
+		Base: listresource.NewBase(NewEdgeGatewayResource),
+	}
+}
+
+// edgeGatewayListResource is the list resource implementation.
+type edgeGatewayListResource struct {
+	listresource.Base
+}
+
+// ListResourceConfigSchema defines the schema of the list resource configuration.
+func (r *edgeGatewayListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{
+		MarkdownDescription: "The `cloudavenue_edgegateway` list resource allows you to list the edge gateways of the organization.",
+	}
+}
+
+// List lists the edge gateways of the organization.
+func (r *edgeGatewayListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	defer metrics.New("cloudavenue_edgegateway", r.Client.GetOrgName(), metrics.List)()
+
+	gateways, err := r.Client.CAVSDK.V1.EdgeGateway.List()
+	if err != nil {
+		stream.Results = listresource.Error("Unable to list edge gateways", err)
+		return
+	}
+
+	items := make([]listresource.Item, 0, len(*gateways))
+	for _, edge := range *gateways {
+		items = append(items, listresource.Item{
+			DisplayName: edge.GetName(),
+			Attributes: map[string]string{
+				"name": edge.GetName(),
+			},
+		})
+	}
+
+	stream.Results = r.Results(ctx, req, items)
+}
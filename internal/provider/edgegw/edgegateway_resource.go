@@ -37,7 +37,10 @@ import (
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/metrics"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/cloudavenue"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/deletionprotection"
 	cerrs "github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/errors"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/identity"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/movestate"
 )
 
 const (
@@ -57,6 +60,8 @@ var (
 			CheckJobDelay: defaultCheckJobDelayEdgeGateway,
 		}
 	}
+	_ resource.ResourceWithMoveState    = &edgeGatewayResource{}
+	_ identity.ResourceWithIdentitySpec = &edgeGatewayResource{}
 )
 
 // NewEdgeGatewayResource returns a new resource implementing the edge_gateway data source.
@@ -339,6 +344,8 @@ func (h *modifyPlanHelper) modifyPlanUpdate(state *edgeGatewayResourceModel) boo
 
 // ModifyPlan modifies the plan to add the default values.
 func (r *edgeGatewayResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	defer deletionprotection.ModifyPlan(ctx, "cloudavenue_edgegateway", req, resp)
+
 	var (
 		plan  = &edgeGatewayResourceModel{}
 		state = &edgeGatewayResourceModel{}
@@ -452,7 +459,10 @@ func (r *edgeGatewayResource) Create(ctx context.Context, req resource.CreateReq
 	ctx, cancel = context.WithTimeout(ctx, createTimeout)
 	defer cancel()
 
-	cloudavenue.Lock(ctx)
+	if err := cloudavenue.Lock(ctx); err != nil {
+		resp.Diagnostics.AddError("Error acquiring the lock", err.Error())
+		return
+	}
 	defer cloudavenue.Unlock(ctx)
 
 	// List all edge gateways for determining the ID of the new edge gateway
@@ -477,7 +487,9 @@ func (r *edgeGatewayResource) Create(ctx context.Context, req resource.CreateReq
 	}
 
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating edge gateway", fmt.Sprintf("error creating edge gateway %s: %s", plan.Name.Get(), err.Error()))
+		cerrs.AddAttributeError(&resp.Diagnostics, cerrs.AttributePaths{
+			cerrs.KindInvalidReference: path.Root("tier0_vrf_name"),
+		}, cerrs.ActionCreate, "edge gateway "+plan.Name.Get(), err)
 		return
 	}
 	if err := job.Wait(1, int(createTimeout.Seconds())); err != nil {
@@ -595,7 +607,10 @@ func (r *edgeGatewayResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
-	cloudavenue.Lock(ctx)
+	if err := cloudavenue.Lock(ctx); err != nil {
+		resp.Diagnostics.AddError("Error acquiring the lock", err.Error())
+		return
+	}
 	defer cloudavenue.Unlock(ctx)
 
 	// Update() is passed a default timeout to use if no value
@@ -656,7 +671,15 @@ func (r *edgeGatewayResource) Delete(ctx context.Context, req resource.DeleteReq
 		return
 	}
 
-	cloudavenue.Lock(ctx)
+	resp.Diagnostics.Append(deletionprotection.CheckDelete(ctx, "cloudavenue_edgegateway", req.State)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := cloudavenue.Lock(ctx); err != nil {
+		resp.Diagnostics.AddError("Error acquiring the lock", err.Error())
+		return
+	}
 	defer cloudavenue.Unlock(ctx)
 
 	deleteTimeout, errTO := state.Timeouts.Delete(ctx, 8*time.Minute)
@@ -680,7 +703,7 @@ func (r *edgeGatewayResource) Delete(ctx context.Context, req resource.DeleteReq
 
 	job, err := edgegw.Delete()
 	if err != nil {
-		resp.Diagnostics.AddError("Error deleting edge gateway", fmt.Sprintf("error deleting edge gateway %s: %s", state.Name.Get(), err.Error()))
+		cerrs.AddError(&resp.Diagnostics, cerrs.ActionDelete, "edge gateway "+state.Name.Get(), err)
 		return
 	}
 
@@ -697,6 +720,28 @@ func (r *edgeGatewayResource) ImportState(ctx context.Context, req resource.Impo
 	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
 }
 
+// IdentitySpec returns the identity of the resource.
+func (r *edgeGatewayResource) IdentitySpec() identity.Spec {
+	return identity.Spec{
+		Attributes: []identity.Attribute{
+			{
+				Name:        "name",
+				Description: "The name of the edge gateway.",
+			},
+		},
+	}
+}
+
+// MoveState returns the state movers from the equivalent resources of the VMware vcd provider.
+func (r *edgeGatewayResource) MoveState(_ context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		movestate.FromVCD("vcd_nsxt_edgegateway", movestate.Attributes{
+			"id":   "id",
+			"name": "name",
+		}),
+	}
+}
+
 // * Custom funcs.
 func (r *edgeGatewayResource) read(_ context.Context, planOrState *edgeGatewayResourceModel) (stateRefreshed *edgeGatewayResourceModel, found bool, diags diag.Diagnostics) {
 	stateRefreshed = planOrState.Copy()
@@ -724,5 +769,10 @@ func (r *edgeGatewayResource) read(_ context.Context, planOrState *edgeGatewayRe
 	stateRefreshed.Description.Set(edgegw.GetDescription())
 	stateRefreshed.Bandwidth.SetInt(edgegw.GetBandwidth())
 
+	// The states written before the protection existed are not protected.
+	if !stateRefreshed.DeletionProtection.IsKnown() {
+		stateRefreshed.DeletionProtection.Set(false)
+	}
+
 	return stateRefreshed, true, nil
 }
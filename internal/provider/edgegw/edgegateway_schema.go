@@ -31,6 +31,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/deletionprotection"
 )
 
 /*
@@ -141,6 +143,9 @@ func edgegwSchema() superschema.Schema {
 					MarkdownDescription: "If no value is specified, the bandwidth is automatically calculated based on the remaining bandwidth of the Tier-0 VRF. More information can be found [here](#bandwidth-attribute).",
 				},
 			},
+			deletionprotection.AttributeName: &superschema.SuperBoolAttribute{
+				Resource: deletionprotection.ResourceAttribute(),
+			},
 		},
 	}
 }
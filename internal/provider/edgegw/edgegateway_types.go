@@ -27,13 +27,14 @@ import (
 )
 
 type edgeGatewayResourceModel struct {
-	Timeouts     timeouts.Value         `tfsdk:"timeouts"`
-	ID           supertypes.StringValue `tfsdk:"id"`
-	Tier0VRFName supertypes.StringValue `tfsdk:"tier0_vrf_name"`
-	Name         supertypes.StringValue `tfsdk:"name"`
-	OwnerName    supertypes.StringValue `tfsdk:"owner_name"`
-	Description  supertypes.StringValue `tfsdk:"description"`
-	Bandwidth    supertypes.Int64Value  `tfsdk:"bandwidth"`
+	Timeouts           timeouts.Value         `tfsdk:"timeouts"`
+	ID                 supertypes.StringValue `tfsdk:"id"`
+	Tier0VRFName       supertypes.StringValue `tfsdk:"tier0_vrf_name"`
+	Name               supertypes.StringValue `tfsdk:"name"`
+	OwnerName          supertypes.StringValue `tfsdk:"owner_name"`
+	Description        supertypes.StringValue `tfsdk:"description"`
+	Bandwidth          supertypes.Int64Value  `tfsdk:"bandwidth"`
+	DeletionProtection supertypes.BoolValue   `tfsdk:"deletion_protection"`
 }
 
 type edgeGatewayDatasourceModel struct {
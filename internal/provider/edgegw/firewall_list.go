@@ -0,0 +1,74 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package edgegw
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+
+	"github.com/orange-cloudavenue/cloudavenue-sdk-go/pkg/urn"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/metrics"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/listresource"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ list.ListResource              = &firewallListResource{}
+	_ list.ListResourceWithConfigure = &firewallListResource{}
+)
+
+// NewFirewallListResource is a helper function to simplify the provider implementation.
+func NewFirewallListResource() list.ListResource {
+	return &firewallListResource{
+		Base: listresource.NewBase(NewFirewallResource),
+	}
+}
+
+// firewallListResource is the list resource implementation.
+type firewallListResource struct {
+	listresource.Base
+}
+
+// ListResourceConfigSchema defines the schema of the list resource configuration.
+func (r *firewallListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{
+		MarkdownDescription: "The `cloudavenue_edgegateway_firewall` list resource allows you to list the firewalls of the edge gateways of the organization, one per edge gateway.",
+	}
+}
+
+// List lists the firewalls of the edge gateways of the organization.
+func (r *firewallListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	defer metrics.New("cloudavenue_edgegateway_firewall", r.Client.GetOrgName(), metrics.List)()
+
+	gateways, err := r.Client.CAVSDK.V1.EdgeGateway.List()
+	if err != nil {
+		stream.Results = listresource.Error("Unable to list edge gateways", err)
+		return
+	}
+
+	items := make([]listresource.Item, 0, len(*gateways))
+	for _, edge := range *gateways {
+		// The firewall has the ID of its edge gateway.
+		id := urn.Normalize(urn.Gateway, edge.GetID()).String()
+
+		items = append(items, listresource.Item{
+			DisplayName: edge.GetName(),
+			Attributes: map[string]string{
+				"id":                id,
+				"edge_gateway_id":   id,
+				"edge_gateway_name": edge.GetName(),
+			},
+		})
+	}
+
+	stream.Results = r.Results(ctx, req, items)
+}
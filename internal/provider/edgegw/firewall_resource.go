@@ -40,15 +40,19 @@ import (
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/edgegw"
 	cerrs "github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/errors"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/identity"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/movestate"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/mutex"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/org"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &firewallResource{}
-	_ resource.ResourceWithConfigure   = &firewallResource{}
-	_ resource.ResourceWithImportState = &firewallResource{}
+	_ resource.Resource                 = &firewallResource{}
+	_ resource.ResourceWithConfigure    = &firewallResource{}
+	_ resource.ResourceWithImportState  = &firewallResource{}
+	_ resource.ResourceWithMoveState    = &firewallResource{}
+	_ identity.ResourceWithIdentitySpec = &firewallResource{}
 )
 
 // readTimeout is the timeout for reading the firewall resource. It is set to 30 seconds to allow for the backend to process the request and return the updated state.
@@ -230,10 +234,16 @@ func (r *firewallResource) Delete(ctx context.Context, req resource.DeleteReques
 	}
 
 	if vdcOrVDCGroup.IsVDCGroup() {
-		mutex.GlobalMutex.KvLock(ctx, vdcOrVDCGroup.GetID())
+		if err := mutex.GlobalMutex.KvLock(ctx, vdcOrVDCGroup.GetID()); err != nil {
+			resp.Diagnostics.AddError("Error acquiring the lock", err.Error())
+			return
+		}
 		defer mutex.GlobalMutex.KvUnlock(ctx, vdcOrVDCGroup.GetID())
 	} else {
-		mutex.GlobalMutex.KvLock(ctx, r.edgegw.GetID())
+		if err := mutex.GlobalMutex.KvLock(ctx, r.edgegw.GetID()); err != nil {
+			resp.Diagnostics.AddError("Error acquiring the lock", err.Error())
+			return
+		}
 		defer mutex.GlobalMutex.KvUnlock(ctx, r.edgegw.GetID())
 	}
 	fwRules, err := r.edgegw.GetNsxtFirewall()
@@ -243,7 +253,7 @@ func (r *firewallResource) Delete(ctx context.Context, req resource.DeleteReques
 	}
 
 	if err := fwRules.DeleteAllRules(); err != nil {
-		resp.Diagnostics.AddError("Error deleting Edge Gateway Firewall", err.Error())
+		cerrs.AddError(&resp.Diagnostics, cerrs.ActionDelete, "Edge Gateway Firewall", err)
 	}
 }
 
@@ -331,6 +341,28 @@ func (r *firewallResource) ImportState(ctx context.Context, req resource.ImportS
 	resp.Diagnostics.Append(resp.State.Set(ctx, stateRefreshed)...)
 }
 
+// IdentitySpec returns the identity of the resource.
+func (r *firewallResource) IdentitySpec() identity.Spec {
+	return identity.Spec{
+		Attributes: []identity.Attribute{
+			{
+				Name:        "edge_gateway_id",
+				Description: "The ID of the edge gateway.",
+			},
+		},
+	}
+}
+
+// MoveState returns the state movers from the equivalent resources of the VMware vcd provider.
+func (r *firewallResource) MoveState(_ context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		movestate.FromVCD("vcd_nsxt_firewall", movestate.Attributes{
+			"id":              "id",
+			"edge_gateway_id": "edge_gateway_id",
+		}),
+	}
+}
+
 // * custom functions
 
 // createOrUpdate creates or updates the resource and sets the Terraform state.
@@ -343,10 +375,16 @@ func (r *firewallResource) createOrUpdate(ctx context.Context, plan *firewallMod
 	}
 
 	if vdcOrVDCGroup.IsVDCGroup() {
-		mutex.GlobalMutex.KvLock(ctx, vdcOrVDCGroup.GetID())
+		if err := mutex.GlobalMutex.KvLock(ctx, vdcOrVDCGroup.GetID()); err != nil {
+			diags.AddError("Error acquiring the lock", err.Error())
+			return diags
+		}
 		defer mutex.GlobalMutex.KvUnlock(ctx, vdcOrVDCGroup.GetID())
 	} else {
-		mutex.GlobalMutex.KvLock(ctx, r.edgegw.GetID())
+		if err := mutex.GlobalMutex.KvLock(ctx, r.edgegw.GetID()); err != nil {
+			diags.AddError("Error acquiring the lock", err.Error())
+			return diags
+		}
 		defer mutex.GlobalMutex.KvUnlock(ctx, r.edgegw.GetID())
 	}
 
@@ -389,8 +427,13 @@ func (r *firewallResource) read(ctx context.Context, planOrState *firewallModel)
 		err     error
 	)
 
+	// The wait for the rules is bounded by readTimeout, the retry policy of
+	// the client only retries the transient API errors.
 	err = retry.RetryContext(ctx, readTimeout, func() *retry.RetryError {
-		fwRules, err = r.edgegw.GetFirewallExtended()
+		err = r.client.Retry(ctx, func() (errGet error) {
+			fwRules, errGet = r.edgegw.GetFirewallExtended()
+			return errGet
+		})
 		if err != nil {
 			return retry.NonRetryableError(err)
 		}
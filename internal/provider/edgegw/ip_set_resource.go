@@ -38,15 +38,19 @@ import (
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/metrics"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/edgegw"
 	cerrs "github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/errors"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/identity"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/movestate"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/mutex"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/org"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &ipSetResource{}
-	_ resource.ResourceWithConfigure   = &ipSetResource{}
-	_ resource.ResourceWithImportState = &ipSetResource{}
+	_ resource.Resource                 = &ipSetResource{}
+	_ resource.ResourceWithConfigure    = &ipSetResource{}
+	_ resource.ResourceWithImportState  = &ipSetResource{}
+	_ resource.ResourceWithMoveState    = &ipSetResource{}
+	_ identity.ResourceWithIdentitySpec = &ipSetResource{}
 )
 
 // NewIpSetResource is a helper function to simplify the provider implementation.
@@ -147,7 +151,10 @@ func (r *ipSetResource) Create(ctx context.Context, req resource.CreateRequest,
 	var createdIPSet *govcd.NsxtFirewallGroup
 
 	if vdcOrVDCGroup.IsVDCGroup() {
-		mutex.GlobalMutex.KvLock(ctx, vdcOrVDCGroup.GetID())
+		if err := mutex.GlobalMutex.KvLock(ctx, vdcOrVDCGroup.GetID()); err != nil {
+			resp.Diagnostics.AddError("Error acquiring the lock", err.Error())
+			return
+		}
 		defer mutex.GlobalMutex.KvUnlock(ctx, vdcOrVDCGroup.GetID())
 		ipSetConfig, d := plan.ToNsxtFirewallGroup(ctx, vdcOrVDCGroup.GetID())
 		resp.Diagnostics.Append(d...)
@@ -156,7 +163,10 @@ func (r *ipSetResource) Create(ctx context.Context, req resource.CreateRequest,
 		}
 		createdIPSet, err = vdcOrVDCGroup.SetIPSet(ipSetConfig)
 	} else {
-		mutex.GlobalMutex.KvLock(ctx, r.edgegw.GetID())
+		if err := mutex.GlobalMutex.KvLock(ctx, r.edgegw.GetID()); err != nil {
+			resp.Diagnostics.AddError("Error acquiring the lock", err.Error())
+			return
+		}
 		defer mutex.GlobalMutex.KvUnlock(ctx, r.edgegw.GetID())
 		ipSetConfig, d := plan.ToNsxtFirewallGroup(ctx, r.edgegw.GetID())
 		resp.Diagnostics.Append(d...)
@@ -166,7 +176,7 @@ func (r *ipSetResource) Create(ctx context.Context, req resource.CreateRequest,
 		createdIPSet, err = r.edgegw.SetIPSet(ipSetConfig)
 	}
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating IP Set", err.Error())
+		cerrs.AddError(&resp.Diagnostics, cerrs.ActionCreate, "IP Set", err)
 		return
 	}
 
@@ -255,7 +265,10 @@ func (r *ipSetResource) Update(ctx context.Context, req resource.UpdateRequest,
 	)
 
 	if vdcOrVDCGroup.IsVDCGroup() {
-		mutex.GlobalMutex.KvLock(ctx, vdcOrVDCGroup.GetID())
+		if err := mutex.GlobalMutex.KvLock(ctx, vdcOrVDCGroup.GetID()); err != nil {
+			resp.Diagnostics.AddError("Error acquiring the lock", err.Error())
+			return
+		}
 		defer mutex.GlobalMutex.KvUnlock(ctx, vdcOrVDCGroup.GetID())
 		ipSetConfig, d = plan.ToNsxtFirewallGroup(ctx, vdcOrVDCGroup.GetID())
 		resp.Diagnostics.Append(d...)
@@ -264,7 +277,10 @@ func (r *ipSetResource) Update(ctx context.Context, req resource.UpdateRequest,
 		}
 		ipSet, err = vdcOrVDCGroup.GetIPSetByID(state.ID.Get())
 	} else {
-		mutex.GlobalMutex.KvLock(ctx, r.edgegw.GetID())
+		if err := mutex.GlobalMutex.KvLock(ctx, r.edgegw.GetID()); err != nil {
+			resp.Diagnostics.AddError("Error acquiring the lock", err.Error())
+			return
+		}
 		defer mutex.GlobalMutex.KvUnlock(ctx, r.edgegw.GetID())
 		ipSetConfig, d = plan.ToNsxtFirewallGroup(ctx, r.edgegw.GetID())
 		resp.Diagnostics.Append(d...)
@@ -279,7 +295,7 @@ func (r *ipSetResource) Update(ctx context.Context, req resource.UpdateRequest,
 	}
 
 	if _, err := ipSet.Update(ipSetConfig); err != nil {
-		resp.Diagnostics.AddError("Error updating IP Set", err.Error())
+		cerrs.AddError(&resp.Diagnostics, cerrs.ActionUpdate, "IP Set", err)
 		return
 	}
 
@@ -324,11 +340,17 @@ func (r *ipSetResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	var ipSet *govcd.NsxtFirewallGroup
 
 	if vdcOrVDCGroup.IsVDCGroup() {
-		mutex.GlobalMutex.KvLock(ctx, vdcOrVDCGroup.GetID())
+		if err := mutex.GlobalMutex.KvLock(ctx, vdcOrVDCGroup.GetID()); err != nil {
+			resp.Diagnostics.AddError("Error acquiring the lock", err.Error())
+			return
+		}
 		defer mutex.GlobalMutex.KvUnlock(ctx, vdcOrVDCGroup.GetID())
 		ipSet, err = vdcOrVDCGroup.GetIPSetByID(state.ID.Get())
 	} else {
-		mutex.GlobalMutex.KvLock(ctx, r.edgegw.GetID())
+		if err := mutex.GlobalMutex.KvLock(ctx, r.edgegw.GetID()); err != nil {
+			resp.Diagnostics.AddError("Error acquiring the lock", err.Error())
+			return
+		}
 		defer mutex.GlobalMutex.KvUnlock(ctx, r.edgegw.GetID())
 		ipSet, err = r.edgegw.GetIPSetByID(state.ID.Get())
 	}
@@ -338,7 +360,7 @@ func (r *ipSetResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	}
 
 	if err := ipSet.Delete(); err != nil {
-		resp.Diagnostics.AddError("Error deleting IP Set", err.Error())
+		cerrs.AddError(&resp.Diagnostics, cerrs.ActionDelete, "IP Set", err)
 		return
 	}
 }
@@ -407,6 +429,35 @@ func (r *ipSetResource) ImportState(ctx context.Context, req resource.ImportStat
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(edgeGatewayName), r.edgegw.GetName())...)
 }
 
+// IdentitySpec returns the identity of the resource.
+// The IP set is imported by its name, which can be updated.
+func (r *ipSetResource) IdentitySpec() identity.Spec {
+	return identity.Spec{
+		Attributes: []identity.Attribute{
+			{
+				Name:        "edge_gateway_id",
+				Description: "The ID of the edge gateway.",
+			},
+			{
+				Name:        "name",
+				Description: "The name of the IP set.",
+			},
+		},
+		Mutable: true,
+	}
+}
+
+// MoveState returns the state movers from the equivalent resources of the VMware vcd provider.
+func (r *ipSetResource) MoveState(_ context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		movestate.FromVCD("vcd_nsxt_ip_set", movestate.Attributes{
+			"id":              "id",
+			"name":            "name",
+			"edge_gateway_id": "edge_gateway_id",
+		}),
+	}
+}
+
 func (r *ipSetResource) read(ctx context.Context, planOrState *IPSetModel) (stateRefreshed *IPSetModel, found bool, diags diag.Diagnostics) {
 	stateRefreshed = planOrState.Copy()
 
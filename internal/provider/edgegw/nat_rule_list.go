@@ -0,0 +1,136 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package edgegw
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/metrics"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/edgegw"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/listresource"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/org"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ list.ListResource              = &natRuleListResource{}
+	_ list.ListResourceWithConfigure = &natRuleListResource{}
+)
+
+// NewNATRuleListResource is a helper function to simplify the provider implementation.
+func NewNATRuleListResource() list.ListResource {
+	return &natRuleListResource{
+		Base: listresource.NewBase(NewNATRuleResource),
+	}
+}
+
+// natRuleListResource is the list resource implementation.
+type natRuleListResource struct {
+	listresource.Base
+}
+
+type natRuleListResourceModel struct {
+	EdgeGatewayID   types.String `tfsdk:"edge_gateway_id"`
+	EdgeGatewayName types.String `tfsdk:"edge_gateway_name"`
+}
+
+// ListResourceConfigSchema defines the schema of the list resource configuration.
+func (r *natRuleListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{
+		MarkdownDescription: "The `cloudavenue_edgegateway_nat_rule` list resource allows you to list the NAT rules of the edge gateways of the organization.",
+		Attributes: map[string]listschema.Attribute{
+			"edge_gateway_id": listschema.StringAttribute{
+				MarkdownDescription: "The ID of the edge gateway. If set, only the NAT rules of this edge gateway are listed.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("edge_gateway_name")),
+				},
+			},
+			"edge_gateway_name": listschema.StringAttribute{
+				MarkdownDescription: "The name of the edge gateway. If set, only the NAT rules of this edge gateway are listed.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+// List lists the NAT rules of the edge gateways of the organization.
+func (r *natRuleListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	defer metrics.New("cloudavenue_edgegateway_nat_rule", r.Client.GetOrgName(), metrics.List)()
+
+	config := &natRuleListResourceModel{}
+	if diags := req.Config.Get(ctx, config); diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	o, diags := org.Init(r.Client)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	// Only the NAT rules of this edge gateway are listed if it is set.
+	var gateways []edgegw.BaseEdgeGW
+	if !config.EdgeGatewayID.IsNull() || !config.EdgeGatewayName.IsNull() {
+		gateways = append(gateways, edgegw.BaseEdgeGW{
+			ID:   config.EdgeGatewayID,
+			Name: config.EdgeGatewayName,
+		})
+	} else {
+		all, err := r.Client.CAVSDK.V1.EdgeGateway.List()
+		if err != nil {
+			stream.Results = listresource.Error("Unable to list edge gateways", err)
+			return
+		}
+		for _, edge := range *all {
+			gateways = append(gateways, edgegw.BaseEdgeGW{
+				Name: types.StringValue(edge.GetName()),
+			})
+		}
+	}
+
+	items := make([]listresource.Item, 0)
+	for _, gateway := range gateways {
+		edge, err := o.GetEdgeGateway(gateway)
+		if err != nil {
+			stream.Results = listresource.Error("Error retrieving Edge Gateway", err)
+			return
+		}
+
+		rules, err := edge.GetAllNatRules(nil)
+		if err != nil {
+			stream.Results = listresource.Error("Unable to list NAT rules", err)
+			return
+		}
+
+		for _, rule := range rules {
+			items = append(items, listresource.Item{
+				DisplayName: edge.GetName() + "/" + rule.NsxtNatRule.Name,
+				Attributes: map[string]string{
+					"id":                rule.NsxtNatRule.ID,
+					"name":              rule.NsxtNatRule.Name,
+					"edge_gateway_id":   edge.GetID(),
+					"edge_gateway_name": edge.GetName(),
+				},
+			})
+		}
+	}
+
+	stream.Results = r.Results(ctx, req, items)
+}
@@ -41,17 +41,28 @@ import (
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/metrics"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/edgegw"
 	cerrs "github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/errors"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/identity"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/movestate"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/mutex"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/org"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &natRuleResource{}
-	_ resource.ResourceWithConfigure   = &natRuleResource{}
-	_ resource.ResourceWithImportState = &natRuleResource{}
+	_ resource.Resource                 = &natRuleResource{}
+	_ resource.ResourceWithConfigure    = &natRuleResource{}
+	_ resource.ResourceWithImportState  = &natRuleResource{}
+	_ resource.ResourceWithMoveState    = &natRuleResource{}
+	_ identity.ResourceWithIdentitySpec = &natRuleResource{}
 )
 
+// natRuleAttributePaths are the attributes causing the API errors of a NAT
+// rule.
+var natRuleAttributePaths = cerrs.AttributePaths{
+	cerrs.KindConflict:         path.Root("name"),
+	cerrs.KindInvalidReference: path.Root("app_port_profile_id"),
+}
+
 // NewNATRuleResource is a helper function to simplify the provider implementation.
 func NewNATRuleResource() resource.Resource {
 	return &natRuleResource{}
@@ -141,10 +152,16 @@ func (r *natRuleResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 	if vdcOrVDCGroup.IsVDCGroup() {
-		mutex.GlobalMutex.KvLock(ctx, vdcOrVDCGroup.GetID())
+		if err := mutex.GlobalMutex.KvLock(ctx, vdcOrVDCGroup.GetID()); err != nil {
+			resp.Diagnostics.AddError("Error acquiring the lock", err.Error())
+			return
+		}
 		defer mutex.GlobalMutex.KvUnlock(ctx, vdcOrVDCGroup.GetID())
 	} else {
-		mutex.GlobalMutex.KvLock(ctx, r.edgegw.GetID())
+		if err := mutex.GlobalMutex.KvLock(ctx, r.edgegw.GetID()); err != nil {
+			resp.Diagnostics.AddError("Error acquiring the lock", err.Error())
+			return
+		}
 		defer mutex.GlobalMutex.KvUnlock(ctx, r.edgegw.GetID())
 	}
 
@@ -158,7 +175,7 @@ func (r *natRuleResource) Create(ctx context.Context, req resource.CreateRequest
 	// Create NAT Rule
 	rule, err := r.edgegw.CreateNatRule(nsxtNATRule)
 	if err != nil {
-		cerrs.AddError(&resp.Diagnostics, cerrs.ActionCreate, "NSX-T NAT rule", err)
+		cerrs.AddAttributeError(&resp.Diagnostics, natRuleAttributePaths, cerrs.ActionCreate, "NSX-T NAT rule", err)
 		return
 	}
 
@@ -250,10 +267,16 @@ func (r *natRuleResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 	if vdcOrVDCGroup.IsVDCGroup() {
-		mutex.GlobalMutex.KvLock(ctx, vdcOrVDCGroup.GetID())
+		if err := mutex.GlobalMutex.KvLock(ctx, vdcOrVDCGroup.GetID()); err != nil {
+			resp.Diagnostics.AddError("Error acquiring the lock", err.Error())
+			return
+		}
 		defer mutex.GlobalMutex.KvUnlock(ctx, vdcOrVDCGroup.GetID())
 	} else {
-		mutex.GlobalMutex.KvLock(ctx, r.edgegw.GetID())
+		if err := mutex.GlobalMutex.KvLock(ctx, r.edgegw.GetID()); err != nil {
+			resp.Diagnostics.AddError("Error acquiring the lock", err.Error())
+			return
+		}
 		defer mutex.GlobalMutex.KvUnlock(ctx, r.edgegw.GetID())
 	}
 
@@ -274,7 +297,7 @@ func (r *natRuleResource) Update(ctx context.Context, req resource.UpdateRequest
 	// Inject ID for update
 	nsxtNATRule.ID = existingRule.NsxtNatRule.ID
 	if _, err = existingRule.Update(nsxtNATRule); err != nil {
-		cerrs.AddError(&resp.Diagnostics, cerrs.ActionUpdate, "NSX-T NAT rule", err)
+		cerrs.AddAttributeError(&resp.Diagnostics, natRuleAttributePaths, cerrs.ActionUpdate, "NSX-T NAT rule", err)
 		return
 	}
 
@@ -322,10 +345,16 @@ func (r *natRuleResource) Delete(ctx context.Context, req resource.DeleteRequest
 		return
 	}
 	if vdcOrVDCGroup.IsVDCGroup() {
-		mutex.GlobalMutex.KvLock(ctx, vdcOrVDCGroup.GetID())
+		if err := mutex.GlobalMutex.KvLock(ctx, vdcOrVDCGroup.GetID()); err != nil {
+			resp.Diagnostics.AddError("Error acquiring the lock", err.Error())
+			return
+		}
 		defer mutex.GlobalMutex.KvUnlock(ctx, vdcOrVDCGroup.GetID())
 	} else {
-		mutex.GlobalMutex.KvLock(ctx, r.edgegw.GetID())
+		if err := mutex.GlobalMutex.KvLock(ctx, r.edgegw.GetID()); err != nil {
+			resp.Diagnostics.AddError("Error acquiring the lock", err.Error())
+			return
+		}
 		defer mutex.GlobalMutex.KvUnlock(ctx, r.edgegw.GetID())
 	}
 
@@ -393,6 +422,33 @@ func (r *natRuleResource) ImportState(ctx context.Context, req resource.ImportSt
 	resp.Diagnostics.Append(resp.State.Set(ctx, stateRefreshed)...)
 }
 
+// IdentitySpec returns the identity of the resource.
+func (r *natRuleResource) IdentitySpec() identity.Spec {
+	return identity.Spec{
+		Attributes: []identity.Attribute{
+			{
+				Name:        "edge_gateway_id",
+				Description: "The ID of the edge gateway.",
+			},
+			{
+				Name:        "id",
+				Description: "The ID of the NAT rule.",
+			},
+		},
+	}
+}
+
+// MoveState returns the state movers from the equivalent resources of the VMware vcd provider.
+func (r *natRuleResource) MoveState(_ context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		movestate.FromVCD("vcd_nsxt_nat_rule", movestate.Attributes{
+			"id":              "id",
+			"name":            "name",
+			"edge_gateway_id": "edge_gateway_id",
+		}),
+	}
+}
+
 // * CustomFuncs
 
 func (r *natRuleResource) read(_ context.Context, planOrState *NATRuleModel) (stateRefreshed *NATRuleModel, found bool, diags diag.Diagnostics) {
@@ -27,14 +27,16 @@ import (
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/metrics"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/edgegw"
 	cerrs "github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/errors"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/identity"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/mutex"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/org"
 )
 
 var (
-	_ resource.Resource                = &networkContextProfileResource{}
-	_ resource.ResourceWithConfigure   = &networkContextProfileResource{}
-	_ resource.ResourceWithImportState = &networkContextProfileResource{}
+	_ resource.Resource                 = &networkContextProfileResource{}
+	_ resource.ResourceWithConfigure    = &networkContextProfileResource{}
+	_ resource.ResourceWithImportState  = &networkContextProfileResource{}
+	_ identity.ResourceWithIdentitySpec = &networkContextProfileResource{}
 )
 
 // NewNetworkContextProfileResource returns a new context profile resource.
@@ -107,7 +109,10 @@ func (r *networkContextProfileResource) Create(ctx context.Context, req resource
 		return
 	}
 
-	mutex.GlobalMutex.KvLock(ctx, r.edgegw.GetID())
+	if err := mutex.GlobalMutex.KvLock(ctx, r.edgegw.GetID()); err != nil {
+		resp.Diagnostics.AddError("Error acquiring the lock", err.Error())
+		return
+	}
 	defer mutex.GlobalMutex.KvUnlock(ctx, r.edgegw.GetID())
 
 	profile, d := plan.toSDKProfile(ctx)
@@ -118,7 +123,7 @@ func (r *networkContextProfileResource) Create(ctx context.Context, req resource
 
 	created, err := r.edgegw.EdgeClient.CreateNetworkContextProfile(profile)
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating Network Context Profile", err.Error())
+		cerrs.AddError(&resp.Diagnostics, cerrs.ActionCreate, "Network Context Profile", err)
 		return
 	}
 
@@ -183,7 +188,10 @@ func (r *networkContextProfileResource) Update(ctx context.Context, req resource
 		return
 	}
 
-	mutex.GlobalMutex.KvLock(ctx, r.edgegw.GetID())
+	if err := mutex.GlobalMutex.KvLock(ctx, r.edgegw.GetID()); err != nil {
+		resp.Diagnostics.AddError("Error acquiring the lock", err.Error())
+		return
+	}
 	defer mutex.GlobalMutex.KvUnlock(ctx, r.edgegw.GetID())
 
 	// Carry ID from state to plan for the update call.
@@ -196,7 +204,7 @@ func (r *networkContextProfileResource) Update(ctx context.Context, req resource
 	}
 
 	if _, err := r.edgegw.EdgeClient.UpdateNetworkContextProfile(profile); err != nil {
-		resp.Diagnostics.AddError("Error updating Network Context Profile", err.Error())
+		cerrs.AddError(&resp.Diagnostics, cerrs.ActionUpdate, "Network Context Profile", err)
 		return
 	}
 
@@ -227,11 +235,14 @@ func (r *networkContextProfileResource) Delete(ctx context.Context, req resource
 		return
 	}
 
-	mutex.GlobalMutex.KvLock(ctx, r.edgegw.GetID())
+	if err := mutex.GlobalMutex.KvLock(ctx, r.edgegw.GetID()); err != nil {
+		resp.Diagnostics.AddError("Error acquiring the lock", err.Error())
+		return
+	}
 	defer mutex.GlobalMutex.KvUnlock(ctx, r.edgegw.GetID())
 
 	if err := r.edgegw.EdgeClient.DeleteNetworkContextProfile(state.ID.Get()); err != nil {
-		resp.Diagnostics.AddError("Error deleting Network Context Profile", err.Error())
+		cerrs.AddError(&resp.Diagnostics, cerrs.ActionDelete, "Network Context Profile", err)
 	}
 }
 
@@ -286,6 +297,22 @@ func (r *networkContextProfileResource) ImportState(ctx context.Context, req res
 	resp.Diagnostics.Append(resp.State.Set(ctx, stateRefreshed)...)
 }
 
+// IdentitySpec returns the identity of the resource.
+func (r *networkContextProfileResource) IdentitySpec() identity.Spec {
+	return identity.Spec{
+		Attributes: []identity.Attribute{
+			{
+				Name:        "edge_gateway_id",
+				Description: "The ID of the edge gateway.",
+			},
+			{
+				Name:        "id",
+				Description: "The ID of the network context profile.",
+			},
+		},
+	}
+}
+
 // read is the generic read function.
 func (r *networkContextProfileResource) read(ctx context.Context, planOrState *networkContextProfileModel) (stateRefreshed *networkContextProfileModel, found bool, diags diag.Diagnostics) {
 	stateRefreshed = planOrState.Copy()
@@ -305,7 +332,7 @@ func (r *networkContextProfileResource) read(ctx context.Context, planOrState *n
 		if cerrs.IsNotFound(err) {
 			return stateRefreshed, false, nil
 		}
-		diags.AddError("Error reading Network Context Profile", err.Error())
+		cerrs.AddError(&diags, cerrs.ActionRead, "Network Context Profile", err)
 		return stateRefreshed, true, diags
 	}
 
@@ -35,15 +35,17 @@ import (
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/metrics"
 	cerrs "github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/errors"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/identity"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/mutex"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/network"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &NetworkRoutedResource{}
-	_ resource.ResourceWithConfigure   = &NetworkRoutedResource{}
-	_ resource.ResourceWithImportState = &NetworkRoutedResource{}
+	_ resource.Resource                 = &NetworkRoutedResource{}
+	_ resource.ResourceWithConfigure    = &NetworkRoutedResource{}
+	_ resource.ResourceWithImportState  = &NetworkRoutedResource{}
+	_ identity.ResourceWithIdentitySpec = &NetworkRoutedResource{}
 )
 
 // NewNetworkRoutedResource is a helper function to simplify the provider implementation.
@@ -221,7 +223,10 @@ func (r *NetworkRoutedResource) Create(ctx context.Context, req resource.CreateR
 		return
 	}
 
-	mutex.GlobalMutex.KvLock(ctx, r.edgegw.GetURN())
+	if err := mutex.GlobalMutex.KvLock(ctx, r.edgegw.GetURN()); err != nil {
+		resp.Diagnostics.AddError("Error acquiring the lock", err.Error())
+		return
+	}
 	defer mutex.GlobalMutex.KvUnlock(ctx, r.edgegw.GetURN())
 
 	netRouted, err := r.vdc.CreateNetworkRouted(sdkValues)
@@ -314,7 +319,10 @@ func (r *NetworkRoutedResource) Update(ctx context.Context, req resource.UpdateR
 		return
 	}
 
-	mutex.GlobalMutex.KvLock(ctx, r.edgegw.GetURN())
+	if err := mutex.GlobalMutex.KvLock(ctx, r.edgegw.GetURN()); err != nil {
+		resp.Diagnostics.AddError("Error acquiring the lock", err.Error())
+		return
+	}
 	defer mutex.GlobalMutex.KvUnlock(ctx, r.edgegw.GetURN())
 
 	netRouted, err := r.vdc.GetNetworkRouted(state.ID.Get())
@@ -365,7 +373,10 @@ func (r *NetworkRoutedResource) Delete(ctx context.Context, req resource.DeleteR
 		Implement the resource deletion here
 	*/
 
-	mutex.GlobalMutex.KvLock(ctx, r.edgegw.GetURN())
+	if err := mutex.GlobalMutex.KvLock(ctx, r.edgegw.GetURN()); err != nil {
+		resp.Diagnostics.AddError("Error acquiring the lock", err.Error())
+		return
+	}
 	defer mutex.GlobalMutex.KvUnlock(ctx, r.edgegw.GetURN())
 
 	netRouted, err := r.vdc.GetNetworkRouted(state.ID.Get())
@@ -431,6 +442,22 @@ func (r *NetworkRoutedResource) ImportState(ctx context.Context, req resource.Im
 	resp.Diagnostics.Append(resp.State.Set(ctx, stateRefreshed)...)
 }
 
+// IdentitySpec returns the identity of the resource.
+func (r *NetworkRoutedResource) IdentitySpec() identity.Spec {
+	return identity.Spec{
+		Attributes: []identity.Attribute{
+			{
+				Name:        "edge_gateway_id",
+				Description: "The ID of the edge gateway.",
+			},
+			{
+				Name:        "id",
+				Description: "The ID of the routed network.",
+			},
+		},
+	}
+}
+
 // * CustomFuncs
 
 // read is a generic read function that can be used by the resource Create, Read and Update functions.
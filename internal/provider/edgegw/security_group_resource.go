@@ -36,6 +36,8 @@ import (
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/metrics"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/edgegw"
 	cerrs "github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/errors"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/identity"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/movestate"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/mutex"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/org"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/pkg/utils"
@@ -43,9 +45,11 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &securityGroupResource{}
-	_ resource.ResourceWithConfigure   = &securityGroupResource{}
-	_ resource.ResourceWithImportState = &securityGroupResource{}
+	_ resource.Resource                 = &securityGroupResource{}
+	_ resource.ResourceWithConfigure    = &securityGroupResource{}
+	_ resource.ResourceWithImportState  = &securityGroupResource{}
+	_ resource.ResourceWithMoveState    = &securityGroupResource{}
+	_ identity.ResourceWithIdentitySpec = &securityGroupResource{}
 )
 
 // NewSecurityGroupResource is a helper function to simplify the provider implementation.
@@ -147,7 +151,10 @@ func (r *securityGroupResource) Create(ctx context.Context, req resource.CreateR
 		return
 	}
 
-	mutex.GlobalMutex.KvLock(ctx, r.edgegw.GetID())
+	if err := mutex.GlobalMutex.KvLock(ctx, r.edgegw.GetID()); err != nil {
+		resp.Diagnostics.AddError("Error acquiring the lock", err.Error())
+		return
+	}
 	defer mutex.GlobalMutex.KvUnlock(ctx, r.edgegw.GetID())
 
 	values, d := plan.ToSDKSecurityGroupModel(ctx)
@@ -158,7 +165,7 @@ func (r *securityGroupResource) Create(ctx context.Context, req resource.CreateR
 
 	fwsg, err := r.edgegw.CreateFirewallSecurityGroup(values)
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating security group", err.Error())
+		cerrs.AddError(&resp.Diagnostics, cerrs.ActionCreate, "security group", err)
 		return
 	}
 
@@ -244,7 +251,10 @@ func (r *securityGroupResource) Update(ctx context.Context, req resource.UpdateR
 		return
 	}
 
-	mutex.GlobalMutex.KvLock(ctx, r.edgegw.GetID())
+	if err := mutex.GlobalMutex.KvLock(ctx, r.edgegw.GetID()); err != nil {
+		resp.Diagnostics.AddError("Error acquiring the lock", err.Error())
+		return
+	}
 	defer mutex.GlobalMutex.KvUnlock(ctx, r.edgegw.GetID())
 
 	fwsg, err := r.edgegw.GetFirewallSecurityGroup(state.ID.Get())
@@ -260,7 +270,7 @@ func (r *securityGroupResource) Update(ctx context.Context, req resource.UpdateR
 	}
 
 	if err := fwsg.Update(values); err != nil {
-		resp.Diagnostics.AddError("Error updating security group", err.Error())
+		cerrs.AddError(&resp.Diagnostics, cerrs.ActionUpdate, "security group", err)
 		return
 	}
 
@@ -301,7 +311,10 @@ func (r *securityGroupResource) Delete(ctx context.Context, req resource.DeleteR
 		Implement the resource deletion here
 	*/
 
-	mutex.GlobalMutex.KvLock(ctx, r.edgegw.GetID())
+	if err := mutex.GlobalMutex.KvLock(ctx, r.edgegw.GetID()); err != nil {
+		resp.Diagnostics.AddError("Error acquiring the lock", err.Error())
+		return
+	}
 	defer mutex.GlobalMutex.KvUnlock(ctx, r.edgegw.GetID())
 
 	fwsg, err := r.edgegw.GetFirewallSecurityGroup(state.ID.Get())
@@ -311,7 +324,7 @@ func (r *securityGroupResource) Delete(ctx context.Context, req resource.DeleteR
 	}
 
 	if err := fwsg.Delete(); err != nil {
-		resp.Diagnostics.AddError("Error deleting security group", err.Error())
+		cerrs.AddError(&resp.Diagnostics, cerrs.ActionDelete, "security group", err)
 		return
 	}
 }
@@ -372,6 +385,33 @@ func (r *securityGroupResource) ImportState(ctx context.Context, req resource.Im
 	resp.Diagnostics.Append(resp.State.Set(ctx, stateRefreshed)...)
 }
 
+// IdentitySpec returns the identity of the resource.
+func (r *securityGroupResource) IdentitySpec() identity.Spec {
+	return identity.Spec{
+		Attributes: []identity.Attribute{
+			{
+				Name:        "edge_gateway_id",
+				Description: "The ID of the edge gateway.",
+			},
+			{
+				Name:        "id",
+				Description: "The ID of the security group.",
+			},
+		},
+	}
+}
+
+// MoveState returns the state movers from the equivalent resources of the VMware vcd provider.
+func (r *securityGroupResource) MoveState(_ context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		movestate.FromVCD("vcd_nsxt_security_group", movestate.Attributes{
+			"id":              "id",
+			"name":            "name",
+			"edge_gateway_id": "edge_gateway_id",
+		}),
+	}
+}
+
 func (r *securityGroupResource) read(ctx context.Context, planOrState *SecurityGroupModel) (stateRefreshed *SecurityGroupModel, found bool, diags diag.Diagnostics) {
 	stateRefreshed = planOrState.Copy()
 
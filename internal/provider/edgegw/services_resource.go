@@ -32,13 +32,15 @@ import (
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/metrics"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/cloudavenue"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/identity"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &ServicesResource{}
-	_ resource.ResourceWithConfigure   = &ServicesResource{}
-	_ resource.ResourceWithImportState = &ServicesResource{}
+	_ resource.Resource                 = &ServicesResource{}
+	_ resource.ResourceWithConfigure    = &ServicesResource{}
+	_ resource.ResourceWithImportState  = &ServicesResource{}
+	_ identity.ResourceWithIdentitySpec = &ServicesResource{}
 )
 
 // NewServicesResource is a helper function to simplify the provider implementation.
@@ -122,7 +124,10 @@ func (r *ServicesResource) Create(ctx context.Context, req resource.CreateReques
 
 	// * Allow the service to already be enabled as some edges may have the parameter enabled by default.
 	if !edge.NetworkServiceIsEnabled() {
-		cloudavenue.Lock(ctx)
+		if err := cloudavenue.Lock(ctx); err != nil {
+			resp.Diagnostics.AddError("Error acquiring the lock", err.Error())
+			return
+		}
 		defer cloudavenue.Unlock(ctx)
 
 		if err := edge.EnableNetworkService(ctx); err != nil {
@@ -216,7 +221,10 @@ func (r *ServicesResource) Delete(ctx context.Context, req resource.DeleteReques
 		return
 	}
 
-	cloudavenue.Lock(ctx)
+	if err := cloudavenue.Lock(ctx); err != nil {
+		resp.Diagnostics.AddError("Error acquiring the lock", err.Error())
+		return
+	}
 	defer cloudavenue.Unlock(ctx)
 
 	if err := edge.DisableNetworkService(ctx); err != nil {
@@ -232,6 +240,18 @@ func (r *ServicesResource) ImportState(_ context.Context, _ resource.ImportState
 	resp.Diagnostics.AddError("Resource Import Error", "The resource does not support import. Create a new resource instead of importing.")
 }
 
+// IdentitySpec returns the identity of the resource.
+func (r *ServicesResource) IdentitySpec() identity.Spec {
+	return identity.Spec{
+		Attributes: []identity.Attribute{
+			{
+				Name:        "edge_gateway_id",
+				Description: "The ID of the edge gateway.",
+			},
+		},
+	}
+}
+
 // * CustomFuncs
 
 // read is a generic read function that can be used by the resource Create, Read and Update functions.
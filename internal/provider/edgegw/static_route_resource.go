@@ -37,6 +37,8 @@ import (
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/metrics"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/edgegw"
 	cerrs "github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/errors"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/identity"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/movestate"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/mutex"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/org"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/pkg/utils"
@@ -44,9 +46,11 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &staticRouteResource{}
-	_ resource.ResourceWithConfigure   = &staticRouteResource{}
-	_ resource.ResourceWithImportState = &staticRouteResource{}
+	_ resource.Resource                 = &staticRouteResource{}
+	_ resource.ResourceWithConfigure    = &staticRouteResource{}
+	_ resource.ResourceWithImportState  = &staticRouteResource{}
+	_ resource.ResourceWithMoveState    = &staticRouteResource{}
+	_ identity.ResourceWithIdentitySpec = &staticRouteResource{}
 )
 
 // NewStaticRouteResource is a helper function to simplify the provider implementation.
@@ -138,10 +142,16 @@ func (r *staticRouteResource) Create(ctx context.Context, req resource.CreateReq
 	}
 
 	if vdcOrVDCGroup.IsVDCGroup() {
-		mutex.GlobalMutex.KvLock(ctx, vdcOrVDCGroup.GetID())
+		if err := mutex.GlobalMutex.KvLock(ctx, vdcOrVDCGroup.GetID()); err != nil {
+			resp.Diagnostics.AddError("Error acquiring the lock", err.Error())
+			return
+		}
 		defer mutex.GlobalMutex.KvUnlock(ctx, vdcOrVDCGroup.GetID())
 	} else {
-		mutex.GlobalMutex.KvLock(ctx, r.edgegw.GetID())
+		if err := mutex.GlobalMutex.KvLock(ctx, r.edgegw.GetID()); err != nil {
+			resp.Diagnostics.AddError("Error acquiring the lock", err.Error())
+			return
+		}
 		defer mutex.GlobalMutex.KvUnlock(ctx, r.edgegw.GetID())
 	}
 
@@ -153,7 +163,7 @@ func (r *staticRouteResource) Create(ctx context.Context, req resource.CreateReq
 
 	createdStaticRoute, err := r.edgegw.CreateStaticRoute(stateRouteConfig)
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating static route", err.Error())
+		cerrs.AddError(&resp.Diagnostics, cerrs.ActionCreate, "static route", err)
 		return
 	}
 
@@ -238,10 +248,16 @@ func (r *staticRouteResource) Update(ctx context.Context, req resource.UpdateReq
 	}
 
 	if vdcOrVDCGroup.IsVDCGroup() {
-		mutex.GlobalMutex.KvLock(ctx, vdcOrVDCGroup.GetID())
+		if err := mutex.GlobalMutex.KvLock(ctx, vdcOrVDCGroup.GetID()); err != nil {
+			resp.Diagnostics.AddError("Error acquiring the lock", err.Error())
+			return
+		}
 		defer mutex.GlobalMutex.KvUnlock(ctx, vdcOrVDCGroup.GetID())
 	} else {
-		mutex.GlobalMutex.KvLock(ctx, r.edgegw.GetID())
+		if err := mutex.GlobalMutex.KvLock(ctx, r.edgegw.GetID()); err != nil {
+			resp.Diagnostics.AddError("Error acquiring the lock", err.Error())
+			return
+		}
 		defer mutex.GlobalMutex.KvUnlock(ctx, r.edgegw.GetID())
 	}
 
@@ -261,7 +277,7 @@ func (r *staticRouteResource) Update(ctx context.Context, req resource.UpdateReq
 	staticRouteConfig.Version = staticRoute.NsxtEdgeGatewayStaticRoute.Version
 
 	if _, err := staticRoute.Update(staticRouteConfig); err != nil {
-		resp.Diagnostics.AddError("Error updating static route", err.Error())
+		cerrs.AddError(&resp.Diagnostics, cerrs.ActionUpdate, "static route", err)
 		return
 	}
 
@@ -304,10 +320,16 @@ func (r *staticRouteResource) Delete(ctx context.Context, req resource.DeleteReq
 	}
 
 	if vdcOrVDCGroup.IsVDCGroup() {
-		mutex.GlobalMutex.KvLock(ctx, vdcOrVDCGroup.GetID())
+		if err := mutex.GlobalMutex.KvLock(ctx, vdcOrVDCGroup.GetID()); err != nil {
+			resp.Diagnostics.AddError("Error acquiring the lock", err.Error())
+			return
+		}
 		defer mutex.GlobalMutex.KvUnlock(ctx, vdcOrVDCGroup.GetID())
 	} else {
-		mutex.GlobalMutex.KvLock(ctx, r.edgegw.GetID())
+		if err := mutex.GlobalMutex.KvLock(ctx, r.edgegw.GetID()); err != nil {
+			resp.Diagnostics.AddError("Error acquiring the lock", err.Error())
+			return
+		}
 		defer mutex.GlobalMutex.KvUnlock(ctx, r.edgegw.GetID())
 	}
 
@@ -318,7 +340,7 @@ func (r *staticRouteResource) Delete(ctx context.Context, req resource.DeleteReq
 	}
 
 	if err := staticRoute.Delete(); err != nil {
-		resp.Diagnostics.AddError("Error deleting static route", err.Error())
+		cerrs.AddError(&resp.Diagnostics, cerrs.ActionDelete, "static route", err)
 		return
 	}
 }
@@ -379,6 +401,33 @@ func (r *staticRouteResource) ImportState(ctx context.Context, req resource.Impo
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(edgeGatewayName), r.edgegw.GetName())...)
 }
 
+// IdentitySpec returns the identity of the resource.
+func (r *staticRouteResource) IdentitySpec() identity.Spec {
+	return identity.Spec{
+		Attributes: []identity.Attribute{
+			{
+				Name:        "edge_gateway_id",
+				Description: "The ID of the edge gateway.",
+			},
+			{
+				Name:        "id",
+				Description: "The ID of the static route.",
+			},
+		},
+	}
+}
+
+// MoveState returns the state movers from the equivalent resources of the VMware vcd provider.
+func (r *staticRouteResource) MoveState(_ context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		movestate.FromVCD("vcd_nsxt_edgegateway_static_route", movestate.Attributes{
+			"id":              "id",
+			"name":            "name",
+			"edge_gateway_id": "edge_gateway_id",
+		}),
+	}
+}
+
 // * CustomFuncs
 
 func (r *staticRouteResource) read(ctx context.Context, planOrState *StaticRouteModel) (stateRefreshed *StaticRouteModel, found bool, diags diag.Diagnostics) {
@@ -39,15 +39,19 @@ import (
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/metrics"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/edgegw"
 	cerrs "github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/errors"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/identity"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/movestate"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/mutex"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/org"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &vpnIPSecResource{}
-	_ resource.ResourceWithConfigure   = &vpnIPSecResource{}
-	_ resource.ResourceWithImportState = &vpnIPSecResource{}
+	_ resource.Resource                 = &vpnIPSecResource{}
+	_ resource.ResourceWithConfigure    = &vpnIPSecResource{}
+	_ resource.ResourceWithImportState  = &vpnIPSecResource{}
+	_ resource.ResourceWithMoveState    = &vpnIPSecResource{}
+	_ identity.ResourceWithIdentitySpec = &vpnIPSecResource{}
 )
 
 // NewVpnIpsecResource is a helper function to simplify the provider implementation.
@@ -139,10 +143,16 @@ func (r *vpnIPSecResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 	if vdcOrVDCGroup.IsVDCGroup() {
-		mutex.GlobalMutex.KvLock(ctx, vdcOrVDCGroup.GetID())
+		if err := mutex.GlobalMutex.KvLock(ctx, vdcOrVDCGroup.GetID()); err != nil {
+			resp.Diagnostics.AddError("Error acquiring the lock", err.Error())
+			return
+		}
 		defer mutex.GlobalMutex.KvUnlock(ctx, vdcOrVDCGroup.GetID())
 	} else {
-		mutex.GlobalMutex.KvLock(ctx, r.edgegw.GetID())
+		if err := mutex.GlobalMutex.KvLock(ctx, r.edgegw.GetID()); err != nil {
+			resp.Diagnostics.AddError("Error acquiring the lock", err.Error())
+			return
+		}
 		defer mutex.GlobalMutex.KvUnlock(ctx, r.edgegw.GetID())
 	}
 
@@ -156,7 +166,7 @@ func (r *vpnIPSecResource) Create(ctx context.Context, req resource.CreateReques
 	// Create VPN Config with default profile settings
 	createdIPSecVPNConfig, err := r.edgegw.NsxtEdgeGateway.CreateIpSecVpnTunnel(ipSecVPNConfig)
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating IPsec VPN Tunnel configuration", err.Error())
+		cerrs.AddError(&resp.Diagnostics, cerrs.ActionCreate, "IPsec VPN Tunnel configuration", err)
 		return
 	}
 
@@ -181,7 +191,7 @@ func (r *vpnIPSecResource) Create(ctx context.Context, req resource.CreateReques
 
 		// Update Tunnel Profile with custom settings
 		if _, err := createdIPSecVPNConfig.UpdateTunnelConnectionProperties(vpnTunnelSecProfile); err != nil {
-			resp.Diagnostics.AddError("Error updating IPsec VPN Tunnel Security Profile", err.Error())
+			cerrs.AddError(&resp.Diagnostics, cerrs.ActionUpdate, "IPsec VPN Tunnel Security Profile", err)
 			return
 		}
 	}
@@ -266,10 +276,16 @@ func (r *vpnIPSecResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 	if vdcOrVDCGroup.IsVDCGroup() {
-		mutex.GlobalMutex.KvLock(ctx, vdcOrVDCGroup.GetID())
+		if err := mutex.GlobalMutex.KvLock(ctx, vdcOrVDCGroup.GetID()); err != nil {
+			resp.Diagnostics.AddError("Error acquiring the lock", err.Error())
+			return
+		}
 		defer mutex.GlobalMutex.KvUnlock(ctx, vdcOrVDCGroup.GetID())
 	} else {
-		mutex.GlobalMutex.KvLock(ctx, r.edgegw.GetID())
+		if err := mutex.GlobalMutex.KvLock(ctx, r.edgegw.GetID()); err != nil {
+			resp.Diagnostics.AddError("Error acquiring the lock", err.Error())
+			return
+		}
 		defer mutex.GlobalMutex.KvUnlock(ctx, r.edgegw.GetID())
 	}
 
@@ -296,7 +312,7 @@ func (r *vpnIPSecResource) Update(ctx context.Context, req resource.UpdateReques
 
 	// Update VPN Tunnel
 	if _, err = existingIPSecVPNConfiguration.Update(planVPNTunnel); err != nil {
-		resp.Diagnostics.AddError("Error updating VPN Tunnel configuration", err.Error())
+		cerrs.AddError(&resp.Diagnostics, cerrs.ActionUpdate, "VPN Tunnel configuration", err)
 		return
 	}
 	if !plan.SecurityProfile.IsUnknown() {
@@ -308,7 +324,7 @@ func (r *vpnIPSecResource) Update(ctx context.Context, req resource.UpdateReques
 
 		// Update VPN Tunnel with CUSTOM Profile IPsec settings
 		if _, err = existingIPSecVPNConfiguration.UpdateTunnelConnectionProperties(planVPNIPSec); err != nil {
-			resp.Diagnostics.AddError("Error updating VPN Tunnel configuration", err.Error())
+			cerrs.AddError(&resp.Diagnostics, cerrs.ActionUpdate, "VPN Tunnel configuration", err)
 			return
 		}
 	}
@@ -352,10 +368,16 @@ func (r *vpnIPSecResource) Delete(ctx context.Context, req resource.DeleteReques
 		return
 	}
 	if vdcOrVDCGroup.IsVDCGroup() {
-		mutex.GlobalMutex.KvLock(ctx, vdcOrVDCGroup.GetID())
+		if err := mutex.GlobalMutex.KvLock(ctx, vdcOrVDCGroup.GetID()); err != nil {
+			resp.Diagnostics.AddError("Error acquiring the lock", err.Error())
+			return
+		}
 		defer mutex.GlobalMutex.KvUnlock(ctx, vdcOrVDCGroup.GetID())
 	} else {
-		mutex.GlobalMutex.KvLock(ctx, r.edgegw.GetID())
+		if err := mutex.GlobalMutex.KvLock(ctx, r.edgegw.GetID()); err != nil {
+			resp.Diagnostics.AddError("Error acquiring the lock", err.Error())
+			return
+		}
 		defer mutex.GlobalMutex.KvUnlock(ctx, r.edgegw.GetID())
 	}
 
@@ -437,6 +459,33 @@ func (r *vpnIPSecResource) ImportState(ctx context.Context, req resource.ImportS
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(edgeGatewayName), r.edgegw.GetName())...)
 }
 
+// IdentitySpec returns the identity of the resource.
+func (r *vpnIPSecResource) IdentitySpec() identity.Spec {
+	return identity.Spec{
+		Attributes: []identity.Attribute{
+			{
+				Name:        "edge_gateway_id",
+				Description: "The ID of the edge gateway.",
+			},
+			{
+				Name:        "id",
+				Description: "The ID of the IPsec VPN tunnel.",
+			},
+		},
+	}
+}
+
+// MoveState returns the state movers from the equivalent resources of the VMware vcd provider.
+func (r *vpnIPSecResource) MoveState(_ context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		movestate.FromVCD("vcd_nsxt_ipsec_vpn_tunnel", movestate.Attributes{
+			"id":              "id",
+			"name":            "name",
+			"edge_gateway_id": "edge_gateway_id",
+		}),
+	}
+}
+
 func (r *vpnIPSecResource) read(ctx context.Context, planOrState *VPNIPSecModel) (stateRefreshed *VPNIPSecModel, found bool, diags diag.Diagnostics) {
 	stateRefreshed = planOrState.Copy()
 
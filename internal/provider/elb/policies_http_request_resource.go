@@ -33,14 +33,17 @@ import (
 	"github.com/orange-cloudavenue/cloudavenue-sdk-go/v1/edgeloadbalancer"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/metrics"
+	cerrs "github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/errors"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/identity"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/mutex"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &PoliciesHTTPRequestResource{}
-	_ resource.ResourceWithConfigure   = &PoliciesHTTPRequestResource{}
-	_ resource.ResourceWithImportState = &PoliciesHTTPRequestResource{}
+	_ resource.Resource                 = &PoliciesHTTPRequestResource{}
+	_ resource.ResourceWithConfigure    = &PoliciesHTTPRequestResource{}
+	_ resource.ResourceWithImportState  = &PoliciesHTTPRequestResource{}
+	_ identity.ResourceWithIdentitySpec = &PoliciesHTTPRequestResource{}
 )
 
 // NewPoliciesHTTPRequestResource is a helper function to simplify the provider implementation.
@@ -60,7 +63,7 @@ func (r *PoliciesHTTPRequestResource) Init(_ context.Context, _ *PoliciesHTTPReq
 
 	r.elb, err = edgeloadbalancer.NewClient()
 	if err != nil {
-		diags.AddError("Error creating elb client", err.Error())
+		cerrs.AddError(&diags, cerrs.ActionCreate, "elb client", err)
 	}
 
 	return diags
@@ -122,7 +125,10 @@ func (r *PoliciesHTTPRequestResource) Create(ctx context.Context, req resource.C
 	}
 
 	// Lock the EdgeGateway
-	mutex.GlobalMutex.KvLock(ctx, private.EdgeGatewayID)
+	if err := mutex.GlobalMutex.KvLock(ctx, private.EdgeGatewayID); err != nil {
+		resp.Diagnostics.AddError("Error acquiring the lock", err.Error())
+		return
+	}
 	defer mutex.GlobalMutex.KvUnlock(ctx, private.EdgeGatewayID)
 
 	// Create the resource
@@ -213,7 +219,10 @@ func (r *PoliciesHTTPRequestResource) Update(ctx context.Context, req resource.U
 	}
 
 	// Lock the EdgeGateway
-	mutex.GlobalMutex.KvLock(ctx, private.EdgeGatewayID)
+	if err := mutex.GlobalMutex.KvLock(ctx, private.EdgeGatewayID); err != nil {
+		resp.Diagnostics.AddError("Error acquiring the lock", err.Error())
+		return
+	}
 	defer mutex.GlobalMutex.KvUnlock(ctx, private.EdgeGatewayID)
 
 	// Update the resource
@@ -266,12 +275,15 @@ func (r *PoliciesHTTPRequestResource) Delete(ctx context.Context, req resource.D
 	}
 
 	// Lock the EdgeGateway
-	mutex.GlobalMutex.KvLock(ctx, private.EdgeGatewayID)
+	if err := mutex.GlobalMutex.KvLock(ctx, private.EdgeGatewayID); err != nil {
+		resp.Diagnostics.AddError("Error acquiring the lock", err.Error())
+		return
+	}
 	defer mutex.GlobalMutex.KvUnlock(ctx, private.EdgeGatewayID)
 
 	// Delete the resource
 	if err := r.elb.DeletePoliciesHTTPRequest(ctx, state.VirtualServiceID.Get()); err != nil {
-		resp.Diagnostics.AddError("Error deleting policies http request", err.Error())
+		cerrs.AddError(&resp.Diagnostics, cerrs.ActionDelete, "policies http request", err)
 		return
 	}
 }
@@ -305,6 +317,18 @@ func (r *PoliciesHTTPRequestResource) ImportState(ctx context.Context, req resou
 	resp.Diagnostics.Append(resp.State.Set(ctx, stateRefreshed)...)
 }
 
+// IdentitySpec returns the identity of the resource.
+func (r *PoliciesHTTPRequestResource) IdentitySpec() identity.Spec {
+	return identity.Spec{
+		Attributes: []identity.Attribute{
+			{
+				Name:        "virtual_service_id",
+				Description: "The ID of the load balancer virtual service.",
+			},
+		},
+	}
+}
+
 // * CustomFuncs
 
 // read is a generic read function that can be used by the resource Create, Read and Update functions.
@@ -375,7 +399,7 @@ func (r *PoliciesHTTPRequestResource) createOrUpdate(ctx context.Context, goPlan
 
 	_, err := r.elb.UpdatePoliciesHTTPRequest(ctx, model)
 	if err != nil {
-		diags.AddError("Error updating policies http request", err.Error())
+		cerrs.AddError(&diags, cerrs.ActionUpdate, "policies http request", err)
 	}
 	return diags
 }
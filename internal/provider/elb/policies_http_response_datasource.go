@@ -30,6 +30,7 @@ import (
 	"github.com/orange-cloudavenue/cloudavenue-sdk-go/v1/edgeloadbalancer"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/metrics"
+	cerrs "github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/errors"
 )
 
 var (
@@ -52,7 +53,7 @@ func (d *PoliciesHTTPResponseDataSource) Init(_ context.Context, _ *PoliciesHTTP
 
 	d.elb, err = edgeloadbalancer.NewClient()
 	if err != nil {
-		diags.AddError("Error creating elb client", err.Error())
+		cerrs.AddError(&diags, cerrs.ActionCreate, "elb client", err)
 	}
 
 	return diags
@@ -33,14 +33,17 @@ import (
 	"github.com/orange-cloudavenue/cloudavenue-sdk-go/v1/edgeloadbalancer"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/metrics"
+	cerrs "github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/errors"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/identity"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/mutex"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &PoliciesHTTPSecurityResource{}
-	_ resource.ResourceWithConfigure   = &PoliciesHTTPSecurityResource{}
-	_ resource.ResourceWithImportState = &PoliciesHTTPSecurityResource{}
+	_ resource.Resource                 = &PoliciesHTTPSecurityResource{}
+	_ resource.ResourceWithConfigure    = &PoliciesHTTPSecurityResource{}
+	_ resource.ResourceWithImportState  = &PoliciesHTTPSecurityResource{}
+	_ identity.ResourceWithIdentitySpec = &PoliciesHTTPSecurityResource{}
 )
 
 // NewPoliciesHTTPSecurityResource is a helper function to simplify the provider implementation.
@@ -60,7 +63,7 @@ func (r *PoliciesHTTPSecurityResource) Init(ctx context.Context, rm *PoliciesHTT
 
 	r.elb, err = edgeloadbalancer.NewClient()
 	if err != nil {
-		diags.AddError("Error creating elb client", err.Error())
+		cerrs.AddError(&diags, cerrs.ActionCreate, "elb client", err)
 	}
 
 	return diags
@@ -122,7 +125,10 @@ func (r *PoliciesHTTPSecurityResource) Create(ctx context.Context, req resource.
 	}
 
 	// Lock the EdgeGateway
-	mutex.GlobalMutex.KvLock(ctx, private.EdgeGatewayID)
+	if err := mutex.GlobalMutex.KvLock(ctx, private.EdgeGatewayID); err != nil {
+		resp.Diagnostics.AddError("Error acquiring the lock", err.Error())
+		return
+	}
 	defer mutex.GlobalMutex.KvUnlock(ctx, private.EdgeGatewayID)
 
 	// Create the resource
@@ -213,7 +219,10 @@ func (r *PoliciesHTTPSecurityResource) Update(ctx context.Context, req resource.
 	}
 
 	// Lock the EdgeGateway
-	mutex.GlobalMutex.KvLock(ctx, private.EdgeGatewayID)
+	if err := mutex.GlobalMutex.KvLock(ctx, private.EdgeGatewayID); err != nil {
+		resp.Diagnostics.AddError("Error acquiring the lock", err.Error())
+		return
+	}
 	defer mutex.GlobalMutex.KvUnlock(ctx, private.EdgeGatewayID)
 
 	// Update the resource
@@ -266,12 +275,15 @@ func (r *PoliciesHTTPSecurityResource) Delete(ctx context.Context, req resource.
 	}
 
 	// Lock the EdgeGateway
-	mutex.GlobalMutex.KvLock(ctx, private.EdgeGatewayID)
+	if err := mutex.GlobalMutex.KvLock(ctx, private.EdgeGatewayID); err != nil {
+		resp.Diagnostics.AddError("Error acquiring the lock", err.Error())
+		return
+	}
 	defer mutex.GlobalMutex.KvUnlock(ctx, private.EdgeGatewayID)
 
 	// Delete the resource
 	if err := r.elb.DeletePoliciesHTTPSecurity(ctx, state.VirtualServiceID.Get()); err != nil {
-		resp.Diagnostics.AddError("Error deleting policies http security", err.Error())
+		cerrs.AddError(&resp.Diagnostics, cerrs.ActionDelete, "policies http security", err)
 		return
 	}
 }
@@ -305,6 +317,18 @@ func (r *PoliciesHTTPSecurityResource) ImportState(ctx context.Context, req reso
 	resp.Diagnostics.Append(resp.State.Set(ctx, stateRefreshed)...)
 }
 
+// IdentitySpec returns the identity of the resource.
+func (r *PoliciesHTTPSecurityResource) IdentitySpec() identity.Spec {
+	return identity.Spec{
+		Attributes: []identity.Attribute{
+			{
+				Name:        "virtual_service_id",
+				Description: "The ID of the load balancer virtual service.",
+			},
+		},
+	}
+}
+
 // * CustomFuncs
 
 // read is a generic read function that can be used by the resource Create, Read and Update functions.
@@ -381,7 +405,7 @@ func (r *PoliciesHTTPSecurityResource) createOrUpdate(ctx context.Context, goPla
 
 	_, err := r.elb.UpdatePoliciesHTTPSecurity(ctx, model)
 	if err != nil {
-		diags.AddError("Error updating policies http security", err.Error())
+		cerrs.AddError(&diags, cerrs.ActionUpdate, "policies http security", err)
 	}
 	return diags
 }
@@ -36,14 +36,19 @@ import (
 	"github.com/orange-cloudavenue/cloudavenue-sdk-go/v1/edgeloadbalancer"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/metrics"
+	cerrs "github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/errors"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/identity"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/movestate"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/mutex"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &PoolResource{}
-	_ resource.ResourceWithConfigure   = &PoolResource{}
-	_ resource.ResourceWithImportState = &PoolResource{}
+	_ resource.Resource                 = &PoolResource{}
+	_ resource.ResourceWithConfigure    = &PoolResource{}
+	_ resource.ResourceWithImportState  = &PoolResource{}
+	_ resource.ResourceWithMoveState    = &PoolResource{}
+	_ identity.ResourceWithIdentitySpec = &PoolResource{}
 )
 
 // NewPoolResource is a helper function to simplify the provider implementation.
@@ -64,7 +69,7 @@ func (r *PoolResource) Init(_ context.Context, rm *PoolModel) (diags diag.Diagno
 
 	r.elb, err = edgeloadbalancer.NewClient()
 	if err != nil {
-		diags.AddError("Error creating elb client", err.Error())
+		cerrs.AddError(&diags, cerrs.ActionCreate, "elb client", err)
 		return diags
 	}
 
@@ -74,7 +79,7 @@ func (r *PoolResource) Init(_ context.Context, rm *PoolModel) (diags diag.Diagno
 	}
 	r.edge, err = r.client.CAVSDK.V1.EdgeGateway.Get(eIDOrName)
 	if err != nil {
-		diags.AddError("Error creating edge client", err.Error())
+		cerrs.AddError(&diags, cerrs.ActionCreate, "edge client", err)
 		return diags
 	}
 
@@ -133,7 +138,10 @@ func (r *PoolResource) Create(ctx context.Context, req resource.CreateRequest, r
 		Implement the resource creation logic here.
 	*/
 
-	mutex.GlobalMutex.KvLock(ctx, plan.EdgeGatewayID.Get())
+	if err := mutex.GlobalMutex.KvLock(ctx, plan.EdgeGatewayID.Get()); err != nil {
+		resp.Diagnostics.AddError("Error acquiring the lock", err.Error())
+		return
+	}
 	defer mutex.GlobalMutex.KvUnlock(ctx, plan.EdgeGatewayID.Get())
 
 	model, d := plan.ToSDKPoolModelRequest(ctx, r.client)
@@ -144,7 +152,7 @@ func (r *PoolResource) Create(ctx context.Context, req resource.CreateRequest, r
 
 	poolCreated, err := r.elb.CreatePool(ctx, *model)
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating pool", err.Error())
+		cerrs.AddError(&resp.Diagnostics, cerrs.ActionCreate, "pool", err)
 		return
 	}
 
@@ -225,7 +233,10 @@ func (r *PoolResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		Implement the resource update here
 	*/
 
-	mutex.GlobalMutex.KvLock(ctx, plan.EdgeGatewayID.Get())
+	if err := mutex.GlobalMutex.KvLock(ctx, plan.EdgeGatewayID.Get()); err != nil {
+		resp.Diagnostics.AddError("Error acquiring the lock", err.Error())
+		return
+	}
 	defer mutex.GlobalMutex.KvUnlock(ctx, plan.EdgeGatewayID.Get())
 
 	model, d := plan.ToSDKPoolModelRequest(ctx, r.client)
@@ -236,7 +247,7 @@ func (r *PoolResource) Update(ctx context.Context, req resource.UpdateRequest, r
 
 	_, err := r.elb.UpdatePool(ctx, state.ID.Get(), *model)
 	if err != nil {
-		resp.Diagnostics.AddError("Error updating pool", err.Error())
+		cerrs.AddError(&resp.Diagnostics, cerrs.ActionUpdate, "pool", err)
 		return
 	}
 
@@ -277,11 +288,14 @@ func (r *PoolResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 		Implement the resource deletion here
 	*/
 
-	mutex.GlobalMutex.KvLock(ctx, state.EdgeGatewayID.Get())
+	if err := mutex.GlobalMutex.KvLock(ctx, state.EdgeGatewayID.Get()); err != nil {
+		resp.Diagnostics.AddError("Error acquiring the lock", err.Error())
+		return
+	}
 	defer mutex.GlobalMutex.KvUnlock(ctx, state.EdgeGatewayID.Get())
 
 	if err := r.elb.DeletePool(ctx, state.ID.Get()); err != nil {
-		resp.Diagnostics.AddError("Error deleting pool", err.Error())
+		cerrs.AddError(&resp.Diagnostics, cerrs.ActionDelete, "pool", err)
 		return
 	}
 }
@@ -346,6 +360,33 @@ func (r *PoolResource) ImportState(ctx context.Context, req resource.ImportState
 	resp.Diagnostics.Append(resp.State.Set(ctx, stateRefreshed)...)
 }
 
+// IdentitySpec returns the identity of the resource.
+func (r *PoolResource) IdentitySpec() identity.Spec {
+	return identity.Spec{
+		Attributes: []identity.Attribute{
+			{
+				Name:        "edge_gateway_id",
+				Description: "The ID of the edge gateway.",
+			},
+			{
+				Name:        "id",
+				Description: "The ID of the load balancer pool.",
+			},
+		},
+	}
+}
+
+// MoveState returns the state movers from the equivalent resources of the VMware vcd provider.
+func (r *PoolResource) MoveState(_ context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		movestate.FromVCD("vcd_nsxt_alb_pool", movestate.Attributes{
+			"id":              "id",
+			"name":            "name",
+			"edge_gateway_id": "edge_gateway_id",
+		}),
+	}
+}
+
 // * CustomFuncs
 
 // read is a generic read function that can be used by the resource Create, Read and Update functions.
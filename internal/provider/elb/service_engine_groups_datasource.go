@@ -31,6 +31,7 @@ import (
 	"github.com/orange-cloudavenue/cloudavenue-sdk-go/v1/edgeloadbalancer"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/metrics"
+	cerrs "github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/errors"
 )
 
 var (
@@ -53,7 +54,7 @@ func (d *serviceEngineGroupsDataSource) Init(_ context.Context, _ *serviceEngine
 
 	d.edgegwlb, err = edgeloadbalancer.NewClient()
 	if err != nil {
-		diags.AddError("Error creating edge load balancer client", err.Error())
+		cerrs.AddError(&diags, cerrs.ActionCreate, "edge load balancer client", err)
 	}
 
 	return diags
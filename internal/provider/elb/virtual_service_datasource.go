@@ -32,6 +32,7 @@ import (
 	"github.com/orange-cloudavenue/cloudavenue-sdk-go/v1/edgeloadbalancer"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/metrics"
+	cerrs "github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/errors"
 )
 
 var (
@@ -55,7 +56,7 @@ func (d *VirtualServiceDataSource) Init(_ context.Context, dm *VirtualServiceMod
 
 	d.elb, err = edgeloadbalancer.NewClient()
 	if err != nil {
-		diags.AddError("Error creating elb client", err.Error())
+		cerrs.AddError(&diags, cerrs.ActionCreate, "elb client", err)
 		return diags
 	}
 
@@ -65,7 +66,7 @@ func (d *VirtualServiceDataSource) Init(_ context.Context, dm *VirtualServiceMod
 	}
 	d.edge, err = d.client.CAVSDK.V1.EdgeGateway.Get(eIDOrName)
 	if err != nil {
-		diags.AddError("Error creating edge client", err.Error())
+		cerrs.AddError(&diags, cerrs.ActionCreate, "edge client", err)
 		return diags
 	}
 
@@ -36,6 +36,8 @@ import (
 	"github.com/orange-cloudavenue/cloudavenue-sdk-go/v1/edgeloadbalancer"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/metrics"
+	cerrs "github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/errors"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/identity"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/mutex"
 )
 
@@ -47,6 +49,7 @@ var (
 	// _ resource.ResourceWithModifyPlan     = &VirtualServiceResource{}
 	// _ resource.ResourceWithUpgradeState   = &VirtualServiceResource{}
 	// _ resource.ResourceWithValidateConfig = &VirtualServiceResource{}.
+	_ identity.ResourceWithIdentitySpec = &VirtualServiceResource{}
 )
 
 // NewVirtualServiceResource is a helper function to simplify the provider implementation.
@@ -67,7 +70,7 @@ func (r *VirtualServiceResource) Init(_ context.Context, rm *VirtualServiceModel
 
 	r.elb, err = edgeloadbalancer.NewClient()
 	if err != nil {
-		diags.AddError("Error creating elb client", err.Error())
+		cerrs.AddError(&diags, cerrs.ActionCreate, "elb client", err)
 		return diags
 	}
 
@@ -77,7 +80,7 @@ func (r *VirtualServiceResource) Init(_ context.Context, rm *VirtualServiceModel
 	}
 	r.edge, err = r.client.CAVSDK.V1.EdgeGateway.Get(eIDOrName)
 	if err != nil {
-		diags.AddError("Error creating edge client", err.Error())
+		cerrs.AddError(&diags, cerrs.ActionCreate, "edge client", err)
 		return diags
 	}
 
@@ -136,7 +139,10 @@ func (r *VirtualServiceResource) Create(ctx context.Context, req resource.Create
 		Implement the resource creation logic here.
 	*/
 
-	mutex.GlobalMutex.KvLock(ctx, plan.EdgeGatewayID.Get())
+	if err := mutex.GlobalMutex.KvLock(ctx, plan.EdgeGatewayID.Get()); err != nil {
+		resp.Diagnostics.AddError("Error acquiring the lock", err.Error())
+		return
+	}
 	defer mutex.GlobalMutex.KvUnlock(ctx, plan.EdgeGatewayID.Get())
 
 	modelRequest, d := plan.ToSDKVirtualServiceModelRequest(ctx, r.elb)
@@ -147,7 +153,7 @@ func (r *VirtualServiceResource) Create(ctx context.Context, req resource.Create
 
 	vsCreated, err := r.elb.CreateVirtualService(ctx, *modelRequest)
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating virtual service", err.Error())
+		cerrs.AddError(&resp.Diagnostics, cerrs.ActionCreate, "virtual service", err)
 		return
 	}
 
@@ -228,7 +234,10 @@ func (r *VirtualServiceResource) Update(ctx context.Context, req resource.Update
 		Implement the resource update here
 	*/
 
-	mutex.GlobalMutex.KvLock(ctx, plan.EdgeGatewayID.Get())
+	if err := mutex.GlobalMutex.KvLock(ctx, plan.EdgeGatewayID.Get()); err != nil {
+		resp.Diagnostics.AddError("Error acquiring the lock", err.Error())
+		return
+	}
 	defer mutex.GlobalMutex.KvUnlock(ctx, plan.EdgeGatewayID.Get())
 
 	modelRequest, d := plan.ToSDKVirtualServiceModelRequest(ctx, r.elb)
@@ -239,7 +248,7 @@ func (r *VirtualServiceResource) Update(ctx context.Context, req resource.Update
 
 	_, err := r.elb.UpdateVirtualService(ctx, state.ID.Get(), *modelRequest)
 	if err != nil {
-		resp.Diagnostics.AddError("Error updating virtual service", err.Error())
+		cerrs.AddError(&resp.Diagnostics, cerrs.ActionUpdate, "virtual service", err)
 		return
 	}
 
@@ -280,11 +289,14 @@ func (r *VirtualServiceResource) Delete(ctx context.Context, req resource.Delete
 		Implement the resource deletion here
 	*/
 
-	mutex.GlobalMutex.KvLock(ctx, state.EdgeGatewayID.Get())
+	if err := mutex.GlobalMutex.KvLock(ctx, state.EdgeGatewayID.Get()); err != nil {
+		resp.Diagnostics.AddError("Error acquiring the lock", err.Error())
+		return
+	}
 	defer mutex.GlobalMutex.KvUnlock(ctx, state.EdgeGatewayID.Get())
 
 	if err := r.elb.DeleteVirtualService(ctx, state.ID.Get()); err != nil {
-		resp.Diagnostics.AddError("Error deleting virtual service", err.Error())
+		cerrs.AddError(&resp.Diagnostics, cerrs.ActionDelete, "virtual service", err)
 	}
 }
 
@@ -348,6 +360,22 @@ func (r *VirtualServiceResource) ImportState(ctx context.Context, req resource.I
 	resp.Diagnostics.Append(resp.State.Set(ctx, stateRefreshed)...)
 }
 
+// IdentitySpec returns the identity of the resource.
+func (r *VirtualServiceResource) IdentitySpec() identity.Spec {
+	return identity.Spec{
+		Attributes: []identity.Attribute{
+			{
+				Name:        "edge_gateway_id",
+				Description: "The ID of the edge gateway.",
+			},
+			{
+				Name:        "id",
+				Description: "The ID of the load balancer virtual service.",
+			},
+		},
+	}
+}
+
 // * CustomFuncs
 
 // read is a generic read function that can be used by the resource Create, Read and Update functions.
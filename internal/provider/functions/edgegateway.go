@@ -0,0 +1,37 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package functions
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	v1 "github.com/orange-cloudavenue/cloudavenue-sdk-go/v1"
+)
+
+// edgeGatewayBandwidthRule returns the bandwidth rule of the given Tier-0 class service.
+func edgeGatewayBandwidthRule(classService string) (total int, allowed []int, err error) {
+	rule, ok := v1.EdgeGatewayAllowedBandwidth[v1.ClassService(classService)]
+	if !ok {
+		keys := make([]string, 0, len(v1.EdgeGatewayAllowedBandwidth))
+		for key := range v1.EdgeGatewayAllowedBandwidth {
+			keys = append(keys, string(key))
+		}
+		slices.Sort(keys)
+
+		return 0, nil, fmt.Errorf("%q is not a valid class service, expected one of: %s", classService, strings.Join(keys, ", "))
+	}
+
+	allowed = slices.Clone(rule.T1AllowedBandwidth)
+	slices.Sort(allowed)
+
+	return rule.T0TotalBandwidth, allowed, nil
+}
@@ -0,0 +1,72 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package functions
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &edgeGatewayAllowedBandwidthsFunction{}
+
+// NewEdgeGatewayAllowedBandwidthsFunction is a helper function to simplify the provider implementation.
+func NewEdgeGatewayAllowedBandwidthsFunction() function.Function {
+	return &edgeGatewayAllowedBandwidthsFunction{}
+}
+
+// edgeGatewayAllowedBandwidthsFunction is the function implementation.
+type edgeGatewayAllowedBandwidthsFunction struct{}
+
+// Metadata returns the function name.
+func (f *edgeGatewayAllowedBandwidthsFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "edgegateway_allowed_bandwidths"
+}
+
+// Definition defines the parameters and return type of the function.
+func (f *edgeGatewayAllowedBandwidthsFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Return the bandwidths allowed for an edge gateway",
+		MarkdownDescription: "Returns the sorted list of bandwidth values (in `Mbps`) that can be set on the `bandwidth` attribute of a `cloudavenue_edgegateway` attached to a Tier-0 VRF of the given class service (e.g. `VRF_STANDARD`).",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "class_service",
+				MarkdownDescription: "The class service of the Tier-0 VRF (e.g. `VRF_STANDARD`, `VRF_PREMIUM`, `VRF_DEDICATED_MEDIUM`, `VRF_DEDICATED_LARGE`).",
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.Int64Type,
+		},
+	}
+}
+
+// Run returns the allowed bandwidths.
+func (f *edgeGatewayAllowedBandwidthsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var classService string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &classService))
+	if resp.Error != nil {
+		return
+	}
+
+	_, allowed, err := edgeGatewayBandwidthRule(classService)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	result := make([]int64, len(allowed))
+	for i, bandwidth := range allowed {
+		result[i] = int64(bandwidth)
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
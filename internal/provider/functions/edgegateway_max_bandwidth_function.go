@@ -0,0 +1,64 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package functions
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &edgeGatewayMaxBandwidthFunction{}
+
+// NewEdgeGatewayMaxBandwidthFunction is a helper function to simplify the provider implementation.
+func NewEdgeGatewayMaxBandwidthFunction() function.Function {
+	return &edgeGatewayMaxBandwidthFunction{}
+}
+
+// edgeGatewayMaxBandwidthFunction is the function implementation.
+type edgeGatewayMaxBandwidthFunction struct{}
+
+// Metadata returns the function name.
+func (f *edgeGatewayMaxBandwidthFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "edgegateway_max_bandwidth"
+}
+
+// Definition defines the parameters and return type of the function.
+func (f *edgeGatewayMaxBandwidthFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Return the total bandwidth of a Tier-0 VRF",
+		MarkdownDescription: "Returns the total bandwidth (in `Mbps`) shared by all the edge gateways attached to a Tier-0 VRF of the given class service (e.g. `VRF_STANDARD`). The sum of the `bandwidth` of the edge gateways cannot exceed this value.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "class_service",
+				MarkdownDescription: "The class service of the Tier-0 VRF (e.g. `VRF_STANDARD`, `VRF_PREMIUM`, `VRF_DEDICATED_MEDIUM`, `VRF_DEDICATED_LARGE`).",
+			},
+		},
+		Return: function.Int64Return{},
+	}
+}
+
+// Run returns the total bandwidth.
+func (f *edgeGatewayMaxBandwidthFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var classService string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &classService))
+	if resp.Error != nil {
+		return
+	}
+
+	total, _, err := edgeGatewayBandwidthRule(classService)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, int64(total)))
+}
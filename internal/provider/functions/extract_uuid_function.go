@@ -0,0 +1,67 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package functions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common"
+)
+
+var _ function.Function = &extractUUIDFunction{}
+
+// NewExtractUUIDFunction is a helper function to simplify the provider implementation.
+func NewExtractUUIDFunction() function.Function {
+	return &extractUUIDFunction{}
+}
+
+// extractUUIDFunction is the function implementation.
+type extractUUIDFunction struct{}
+
+// Metadata returns the function name.
+func (f *extractUUIDFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "extract_uuid"
+}
+
+// Definition defines the parameters and return type of the function.
+func (f *extractUUIDFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Extract a UUID from a string",
+		MarkdownDescription: "Extracts the UUID contained in a string such as a URN (`urn:vcloud:vm:<uuid>`) or an API URL (`https://.../api/vApp/vm-<uuid>`). If several UUIDs are found, the last one is returned.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "input",
+				MarkdownDescription: "The string containing the UUID.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run extracts the UUID.
+func (f *extractUUIDFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var value string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &value))
+	if resp.Error != nil {
+		return
+	}
+
+	result := common.ExtractUUID(value)
+	if result == "" {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("no UUID found in %q", value))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
@@ -0,0 +1,295 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package functions_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/functions"
+)
+
+const (
+	testUUID = "f5b44e4f-bbca-4c76-9a4c-1d8a9f5d2e11"
+	testURN  = "urn:vcloud:vm:" + testUUID
+)
+
+// runFunction validates the definition of the function and runs it with the given arguments.
+func runFunction(ctx context.Context, t *testing.T, f function.Function, args ...attr.Value) *function.RunResponse {
+	t.Helper()
+
+	defResp := &function.DefinitionResponse{}
+	f.Definition(ctx, function.DefinitionRequest{}, defResp)
+	if defResp.Diagnostics.HasError() {
+		t.Fatalf("Definition method diagnostics: %+v", defResp.Diagnostics)
+	}
+
+	validateResp := &function.DefinitionValidateResponse{}
+	defResp.Definition.ValidateImplementation(ctx, function.DefinitionValidateRequest{FuncName: "test"}, validateResp)
+	if validateResp.Diagnostics.HasError() {
+		t.Fatalf("Definition validation diagnostics: %+v", validateResp.Diagnostics)
+	}
+
+	result, funcErr := defResp.Definition.Return.NewResultData(ctx)
+	if funcErr != nil {
+		t.Fatalf("unable to create result data: %s", funcErr)
+	}
+
+	resp := &function.RunResponse{Result: result}
+	f.Run(ctx, function.RunRequest{Arguments: function.NewArgumentsData(args)}, resp)
+
+	return resp
+}
+
+func TestURNParseFunction(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+
+	resp := runFunction(ctx, t, functions.NewURNParseFunction(), types.StringValue(testURN))
+	if resp.Error != nil {
+		t.Fatalf("unexpected error: %s", resp.Error)
+	}
+
+	expected := types.ObjectValueMust(
+		map[string]attr.Type{"type": types.StringType, "uuid": types.StringType},
+		map[string]attr.Value{"type": types.StringValue("vm"), "uuid": types.StringValue(testUUID)},
+	)
+	if !resp.Result.Value().Equal(expected) {
+		t.Fatalf("expected %s, got %s", expected, resp.Result.Value())
+	}
+
+	if resp := runFunction(ctx, t, functions.NewURNParseFunction(), types.StringValue(testUUID)); resp.Error == nil {
+		t.Fatal("expected an error for a value that is not a URN")
+	}
+}
+
+func TestURNTypeFunction(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+
+	resp := runFunction(ctx, t, functions.NewURNTypeFunction(), types.StringValue("urn:vcloud:vdcGroup:"+testUUID))
+	if resp.Error != nil {
+		t.Fatalf("unexpected error: %s", resp.Error)
+	}
+
+	if !resp.Result.Value().Equal(types.StringValue("vdcGroup")) {
+		t.Fatalf("expected vdcGroup, got %s", resp.Result.Value())
+	}
+}
+
+func TestURNBuildFunction(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+
+	tests := []struct {
+		name    string
+		urnType string
+		uuid    string
+		want    string
+		wantErr bool
+	}{
+		{
+			name:    "valid",
+			urnType: "vm",
+			uuid:    testUUID,
+			want:    testURN,
+		},
+		{
+			name:    "invalid-uuid",
+			urnType: "vm",
+			uuid:    "not-a-uuid",
+			wantErr: true,
+		},
+		{
+			name:    "invalid-type",
+			urnType: "vm:",
+			uuid:    testUUID,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := runFunction(ctx, t, functions.NewURNBuildFunction(), types.StringValue(tt.urnType), types.StringValue(tt.uuid))
+			if (resp.Error != nil) != tt.wantErr {
+				t.Fatalf("expected error %v, got %v", tt.wantErr, resp.Error)
+			}
+			if !tt.wantErr && !resp.Result.Value().Equal(types.StringValue(tt.want)) {
+				t.Fatalf("expected %s, got %s", tt.want, resp.Result.Value())
+			}
+		})
+	}
+}
+
+func TestURNIsValidFunction(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+
+	tests := []struct {
+		name    string
+		urn     string
+		urnType string
+		want    bool
+	}{
+		{name: "any-type", urn: testURN, urnType: "", want: true},
+		{name: "matching-type", urn: testURN, urnType: "vm", want: true},
+		{name: "other-type", urn: testURN, urnType: "vapp", want: false},
+		{name: "not-a-urn", urn: testUUID, urnType: "", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := runFunction(ctx, t, functions.NewURNIsValidFunction(), types.StringValue(tt.urn), types.StringValue(tt.urnType))
+			if resp.Error != nil {
+				t.Fatalf("unexpected error: %s", resp.Error)
+			}
+			if !resp.Result.Value().Equal(types.BoolValue(tt.want)) {
+				t.Fatalf("expected %t, got %s", tt.want, resp.Result.Value())
+			}
+		})
+	}
+}
+
+func TestExtractUUIDFunction(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+
+	resp := runFunction(ctx, t, functions.NewExtractUUIDFunction(), types.StringValue("https://example.com/api/vApp/vm-"+testUUID))
+	if resp.Error != nil {
+		t.Fatalf("unexpected error: %s", resp.Error)
+	}
+
+	if !resp.Result.Value().Equal(types.StringValue(testUUID)) {
+		t.Fatalf("expected %s, got %s", testUUID, resp.Result.Value())
+	}
+
+	if resp := runFunction(ctx, t, functions.NewExtractUUIDFunction(), types.StringValue("no uuid here")); resp.Error == nil {
+		t.Fatal("expected an error when no UUID is found")
+	}
+}
+
+func TestEdgeGatewayBandwidthFunctions(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+
+	resp := runFunction(ctx, t, functions.NewEdgeGatewayAllowedBandwidthsFunction(), types.StringValue("VRF_STANDARD"))
+	if resp.Error != nil {
+		t.Fatalf("unexpected error: %s", resp.Error)
+	}
+
+	bandwidths, ok := resp.Result.Value().(types.List)
+	if !ok || len(bandwidths.Elements()) == 0 {
+		t.Fatalf("expected a non-empty list of bandwidths, got %s", resp.Result.Value())
+	}
+
+	resp = runFunction(ctx, t, functions.NewEdgeGatewayMaxBandwidthFunction(), types.StringValue("VRF_STANDARD"))
+	if resp.Error != nil {
+		t.Fatalf("unexpected error: %s", resp.Error)
+	}
+
+	if resp := runFunction(ctx, t, functions.NewEdgeGatewayAllowedBandwidthsFunction(), types.StringValue("VRF_UNKNOWN")); resp.Error == nil {
+		t.Fatal("expected an error for an unknown class service")
+	}
+}
+
+func TestVDCValidateFunction(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+
+	storageProfileType := types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"class":   types.StringType,
+			"limit":   types.Int64Type,
+			"default": types.BoolType,
+		},
+	}
+
+	vdc := types.ObjectValueMust(
+		map[string]attr.Type{
+			"service_class":         types.StringType,
+			"disponibility_class":   types.StringType,
+			"billing_model":         types.StringType,
+			"cpu_speed_in_mhz":      types.Int64Type,
+			"cpu_allocated":         types.Int64Type,
+			"memory_allocated":      types.Int64Type,
+			"storage_billing_model": types.StringType,
+			"storage_profiles":      types.ListType{ElemType: storageProfileType},
+		},
+		map[string]attr.Value{
+			"service_class":         types.StringValue("UNKNOWN"),
+			"disponibility_class":   types.StringValue("ONE-ROOM"),
+			"billing_model":         types.StringValue("PAYG"),
+			"cpu_speed_in_mhz":      types.Int64Value(2200),
+			"cpu_allocated":         types.Int64Value(11000),
+			"memory_allocated":      types.Int64Value(16),
+			"storage_billing_model": types.StringValue("PAYG"),
+			"storage_profiles":      types.ListValueMust(storageProfileType, []attr.Value{}),
+		},
+	)
+
+	resp := runFunction(ctx, t, functions.NewVDCValidateFunction(), vdc)
+	if resp.Error != nil {
+		t.Fatalf("unexpected error: %s", resp.Error)
+	}
+
+	if resp.Result.Value().Equal(types.StringValue("")) {
+		t.Fatal("expected a validation error for an unknown service class")
+	}
+}
+
+func TestVDCLimitsFunction(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+
+	resp := runFunction(ctx, t, functions.NewVDCLimitsFunction(), types.StringValue("STD"), types.StringValue("PAYG"))
+	if resp.Error != nil {
+		t.Fatalf("unexpected error: %s", resp.Error)
+	}
+
+	limits, ok := resp.Result.Value().(types.Object)
+	if !ok {
+		t.Fatalf("expected an object, got %s", resp.Result.Value())
+	}
+	cpuAllocated, ok := limits.Attributes()["cpu_allocated"].(types.Object)
+	if !ok {
+		t.Fatalf("expected a cpu_allocated object, got %s", limits)
+	}
+	minValue, _ := cpuAllocated.Attributes()["min"].(types.Int64)
+	maxValue, _ := cpuAllocated.Attributes()["max"].(types.Int64)
+	if minValue.ValueInt64() <= 0 || maxValue.ValueInt64() < minValue.ValueInt64() {
+		t.Fatalf("expected a valid cpu_allocated range, got %s", cpuAllocated)
+	}
+	if classes, _ := limits.Attributes()["disponibility_classes"].(types.List); len(classes.Elements()) == 0 {
+		t.Fatalf("expected a non-empty list of disponibility classes, got %s", limits)
+	}
+
+	if resp := runFunction(ctx, t, functions.NewVDCLimitsFunction(), types.StringValue("UNKNOWN"), types.StringValue("PAYG")); resp.Error == nil {
+		t.Fatal("expected an error for an unknown service class")
+	}
+	if resp := runFunction(ctx, t, functions.NewVDCLimitsFunction(), types.StringValue("VOIP"), types.StringValue("PAYG")); resp.Error == nil {
+		t.Fatal("expected an error for a billing model not available for the service class")
+	}
+}
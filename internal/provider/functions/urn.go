@@ -0,0 +1,55 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+// Package functions provides the provider-defined functions.
+package functions
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/orange-cloudavenue/cloudavenue-sdk-go/pkg/urn"
+)
+
+// urnPrefix is the prefix shared by every Cloud Avenue URN (e.g. `urn:vcloud:vm:<uuid>`).
+const urnPrefix = "urn:vcloud:"
+
+// urnModel is the object returned by the urn_parse function.
+type urnModel struct {
+	Type string `tfsdk:"type"`
+	UUID string `tfsdk:"uuid"`
+}
+
+// parseURN splits a URN into its type and UUID. The URN is validated by the
+// SDK, as the IDs of the resources.
+func parseURN(value string) (*urnModel, error) {
+	urnType, id, ok := strings.Cut(strings.TrimPrefix(value, urnPrefix), ":")
+	if !urn.IsValid(value) || !ok || urnType == "" || id != urn.ExtractUUID(value) {
+		return nil, fmt.Errorf("%q is not a valid URN, expected format is %s<type>:<uuid>", value, urnPrefix)
+	}
+
+	return &urnModel{
+		Type: urnType,
+		UUID: id,
+	}, nil
+}
+
+// buildURN builds a URN from a type and a UUID.
+func buildURN(urnType, id string) (string, error) {
+	if !urn.IsUUIDV4(id) {
+		return "", fmt.Errorf("%q is not a valid UUID", id)
+	}
+
+	value := urn.Normalize(urn.URN(urnPrefix+urnType+":"), id).String()
+	if urnType == "" || strings.Contains(urnType, ":") || !urn.IsValid(value) {
+		return "", fmt.Errorf("%q is not a valid URN type", urnType)
+	}
+
+	return value, nil
+}
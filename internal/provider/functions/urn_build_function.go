@@ -0,0 +1,68 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package functions
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &urnBuildFunction{}
+
+// NewURNBuildFunction is a helper function to simplify the provider implementation.
+func NewURNBuildFunction() function.Function {
+	return &urnBuildFunction{}
+}
+
+// urnBuildFunction is the function implementation.
+type urnBuildFunction struct{}
+
+// Metadata returns the function name.
+func (f *urnBuildFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "urn_build"
+}
+
+// Definition defines the parameters and return type of the function.
+func (f *urnBuildFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Build a Cloud Avenue URN",
+		MarkdownDescription: "Builds a Cloud Avenue URN from a type (e.g. `vm`) and a UUID. The result has the format `urn:vcloud:<type>:<uuid>`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "type",
+				MarkdownDescription: "The type of the URN (e.g. `vm`, `vapp`, `gateway`, `network`, `vdcGroup`).",
+			},
+			function.StringParameter{
+				Name:                "uuid",
+				MarkdownDescription: "The UUID of the object.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run builds the URN.
+func (f *urnBuildFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var urnType, id string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &urnType, &id))
+	if resp.Error != nil {
+		return
+	}
+
+	result, err := buildURN(urnType, id)
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
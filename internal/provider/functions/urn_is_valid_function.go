@@ -0,0 +1,65 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package functions
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &urnIsValidFunction{}
+
+// NewURNIsValidFunction is a helper function to simplify the provider implementation.
+func NewURNIsValidFunction() function.Function {
+	return &urnIsValidFunction{}
+}
+
+// urnIsValidFunction is the function implementation.
+type urnIsValidFunction struct{}
+
+// Metadata returns the function name.
+func (f *urnIsValidFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "urn_is_valid"
+}
+
+// Definition defines the parameters and return type of the function.
+func (f *urnIsValidFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Check whether a value is a valid Cloud Avenue URN",
+		MarkdownDescription: "Returns `true` if the value is a valid Cloud Avenue URN. If `type` is not empty, the URN must also be of this type (e.g. `vm`). Designed to be used in `precondition` and `validation` blocks.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "urn",
+				MarkdownDescription: "The value to check.",
+			},
+			function.StringParameter{
+				Name:                "type",
+				MarkdownDescription: "The expected type of the URN. Use an empty string to accept any type.",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+// Run checks the URN.
+func (f *urnIsValidFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var value, urnType string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &value, &urnType))
+	if resp.Error != nil {
+		return
+	}
+
+	result, err := parseURN(value)
+	valid := err == nil && (urnType == "" || result.Type == urnType)
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, valid))
+}
@@ -0,0 +1,71 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package functions
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &urnParseFunction{}
+
+// NewURNParseFunction is a helper function to simplify the provider implementation.
+func NewURNParseFunction() function.Function {
+	return &urnParseFunction{}
+}
+
+// urnParseFunction is the function implementation.
+type urnParseFunction struct{}
+
+// Metadata returns the function name.
+func (f *urnParseFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "urn_parse"
+}
+
+// Definition defines the parameters and return type of the function.
+func (f *urnParseFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Parse a Cloud Avenue URN",
+		MarkdownDescription: "Parses a Cloud Avenue URN (e.g. `urn:vcloud:vm:<uuid>`) and returns an object containing its `type` (e.g. `vm`) and its `uuid`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "urn",
+				MarkdownDescription: "The URN to parse.",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: map[string]attr.Type{
+				"type": types.StringType,
+				"uuid": types.StringType,
+			},
+		},
+	}
+}
+
+// Run parses the URN.
+func (f *urnParseFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var value string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &value))
+	if resp.Error != nil {
+		return
+	}
+
+	result, err := parseURN(value)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
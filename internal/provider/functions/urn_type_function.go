@@ -0,0 +1,64 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package functions
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &urnTypeFunction{}
+
+// NewURNTypeFunction is a helper function to simplify the provider implementation.
+func NewURNTypeFunction() function.Function {
+	return &urnTypeFunction{}
+}
+
+// urnTypeFunction is the function implementation.
+type urnTypeFunction struct{}
+
+// Metadata returns the function name.
+func (f *urnTypeFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "urn_type"
+}
+
+// Definition defines the parameters and return type of the function.
+func (f *urnTypeFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Return the type of a Cloud Avenue URN",
+		MarkdownDescription: "Returns the type of a Cloud Avenue URN (e.g. `gateway` for `urn:vcloud:gateway:<uuid>`).",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "urn",
+				MarkdownDescription: "The URN to inspect.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run returns the type of the URN.
+func (f *urnTypeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var value string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &value))
+	if resp.Error != nil {
+		return
+	}
+
+	result, err := parseURN(value)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result.Type))
+}
@@ -0,0 +1,274 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package functions
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/orange-cloudavenue/cloudavenue-sdk-go/v1/infrapi/rules"
+)
+
+type (
+	// vdcLimits are the limits of a VDC for a service class and a billing
+	// model.
+	vdcLimits struct {
+		DisponibilityClasses []string `tfsdk:"disponibility_classes"`
+		StorageBillingModels []string `tfsdk:"storage_billing_models"`
+		CPUSpeedInMhz        vdcRange `tfsdk:"cpu_speed_in_mhz"`
+		CPUAllocated         vdcRange `tfsdk:"cpu_allocated"`
+		MemoryAllocated      vdcRange `tfsdk:"memory_allocated"`
+	}
+
+	// vdcRange is the range of the values allowed for an attribute of a VDC.
+	vdcRange struct {
+		Min      int64 `tfsdk:"min"`
+		Max      int64 `tfsdk:"max"`
+		Editable bool  `tfsdk:"editable"`
+	}
+)
+
+// vdcRules returns the VDC limits by service class and billing model. The
+// SDK only exposes the rules as the markdown tables of the documentation of
+// the cloudavenue_vdc resource (generated by cmd/vdc-doc), they are parsed
+// once. An unrecognised layout of the tables is an error, the limits are never
+// guessed.
+var vdcRules = sync.OnceValues(func() (map[string]map[string]vdcLimits, error) {
+	all, err := parseVDCRules(rules.GetRulesDetails())
+	if err != nil {
+		return nil, err
+	}
+
+	for _, serviceClass := range rules.ALLServiceClasses {
+		if _, ok := all[string(serviceClass)]; !ok {
+			return nil, fmt.Errorf("service class %s not found in the VDC rules", serviceClass)
+		}
+	}
+	return all, nil
+})
+
+// vdcRulesColumns are the columns of the table of a service class.
+var vdcRulesColumns = []string{
+	"BillingModels",
+	"StorageBillingModels",
+	"DisponibilityClasses",
+	"CPUInMhz",
+	"CPUAllocated",
+	"MemoryAllocated",
+}
+
+// vdcLimitsRule returns the limits of the given service class and billing
+// model. The index of the invalid argument is returned with the error.
+func vdcLimitsRule(serviceClass, billingModel string) (limits vdcLimits, argument int, err error) {
+	all, err := vdcRules()
+	if err != nil {
+		return vdcLimits{}, -1, fmt.Errorf("unable to read the VDC rules: %w", err)
+	}
+
+	billingModels, ok := all[serviceClass]
+	if !ok {
+		return vdcLimits{}, 0, fmt.Errorf("%q is not a valid service class, expected one of: %s", serviceClass, strings.Join(sortedKeys(all), ", "))
+	}
+
+	limits, ok = billingModels[billingModel]
+	if !ok {
+		return vdcLimits{}, 1, fmt.Errorf("%q is not a valid billing model for the service class %s, expected one of: %s", billingModel, serviceClass, strings.Join(sortedKeys(billingModels), ", "))
+	}
+
+	return limits, 0, nil
+}
+
+// parseVDCRules parses the markdown tables of the VDC rules. Each service
+// class has a "### ServiceClass <name>" title followed by a table with a row
+// per billing model. A table without the expected columns, without the
+// separator row or without rows is an error.
+func parseVDCRules(details string) (map[string]map[string]vdcLimits, error) {
+	var (
+		all          = make(map[string]map[string]vdcLimits)
+		serviceClass string
+		columns      map[string]int
+		separator    bool
+	)
+
+	// checkTable returns an error if the table of the current service class
+	// has no row.
+	checkTable := func() error {
+		if serviceClass != "" && len(all[serviceClass]) == 0 {
+			return fmt.Errorf("service class %s: no billing model found", serviceClass)
+		}
+		return nil
+	}
+
+	for line := range strings.Lines(details) {
+		line = strings.TrimSpace(line)
+
+		if name, ok := strings.CutPrefix(line, "### ServiceClass "); ok {
+			if err := checkTable(); err != nil {
+				return nil, err
+			}
+			serviceClass = strings.TrimSpace(name)
+			if _, ok := all[serviceClass]; ok {
+				return nil, fmt.Errorf("service class %s: duplicated table", serviceClass)
+			}
+			all[serviceClass] = make(map[string]vdcLimits)
+			columns, separator = nil, false
+			continue
+		}
+		if serviceClass == "" || !strings.HasPrefix(line, "|") {
+			continue
+		}
+
+		cells := tableCells(line)
+		switch {
+		case columns == nil:
+			columns = make(map[string]int, len(cells))
+			for i, cell := range cells {
+				// The unit follows the name of the column, e.g. "CPUInMhz (Mhz)".
+				name, _, _ := strings.Cut(cell, " ")
+				columns[name] = i
+			}
+			for _, name := range vdcRulesColumns {
+				if _, ok := columns[name]; !ok {
+					return nil, fmt.Errorf("service class %s: unrecognised table header %q, column %s not found", serviceClass, line, name)
+				}
+			}
+			continue
+		case !separator:
+			if !strings.HasPrefix(cells[0], "---") {
+				return nil, fmt.Errorf("service class %s: unrecognised table, expected the separator row after the header, got %q", serviceClass, line)
+			}
+			separator = true
+			continue
+		case len(cells) != len(columns):
+			return nil, fmt.Errorf("service class %s: unrecognised table row %q, expected %d cells, got %d", serviceClass, line, len(columns), len(cells))
+		}
+
+		limits, billingModel, err := parseVDCRulesRow(cells, columns)
+		if err != nil {
+			return nil, fmt.Errorf("service class %s: %w", serviceClass, err)
+		}
+		if _, ok := all[serviceClass][billingModel]; ok {
+			return nil, fmt.Errorf("service class %s: duplicated billing model %s", serviceClass, billingModel)
+		}
+		all[serviceClass][billingModel] = limits
+	}
+
+	if err := checkTable(); err != nil {
+		return nil, err
+	}
+	if len(all) == 0 {
+		return nil, errors.New("no service class found")
+	}
+	return all, nil
+}
+
+// parseVDCRulesRow parses the row of a billing model.
+func parseVDCRulesRow(cells []string, columns map[string]int) (limits vdcLimits, billingModel string, err error) {
+	cell := func(name string) (string, error) {
+		i, ok := columns[name]
+		if !ok || i >= len(cells) {
+			return "", fmt.Errorf("column %s not found", name)
+		}
+		return cells[i], nil
+	}
+
+	if billingModel, err = cell("BillingModels"); err != nil {
+		return limits, "", err
+	}
+
+	for name, list := range map[string]*[]string{
+		"DisponibilityClasses": &limits.DisponibilityClasses,
+		"StorageBillingModels": &limits.StorageBillingModels,
+	} {
+		value, err := cell(name)
+		if err != nil {
+			return limits, "", err
+		}
+		*list = strings.Split(value, ", ")
+	}
+
+	for name, r := range map[string]*vdcRange{
+		"CPUInMhz":        &limits.CPUSpeedInMhz,
+		"CPUAllocated":    &limits.CPUAllocated,
+		"MemoryAllocated": &limits.MemoryAllocated,
+	} {
+		value, err := cell(name)
+		if err != nil {
+			return limits, "", err
+		}
+		if *r, err = parseVDCRange(value); err != nil {
+			return limits, "", fmt.Errorf("billing model %s, %s: %w", billingModel, name, err)
+		}
+	}
+
+	return limits, billingModel, nil
+}
+
+// parseVDCRange parses a range of values: "equal: 2200" or
+// "min: 3000, max: 2500000". The editable values are prefixed with "**". A
+// range without both bounds or with a min greater than the max is an error.
+func parseVDCRange(value string) (r vdcRange, err error) {
+	value, r.Editable = strings.CutPrefix(value, "**")
+
+	// bounds counts the bounds of the range, an "equal" value sets both.
+	bounds := 0
+	for part := range strings.SplitSeq(strings.TrimSpace(value), ",") {
+		key, number, ok := strings.Cut(part, ":")
+		if !ok {
+			return r, fmt.Errorf("invalid range %q", value)
+		}
+		n, err := strconv.ParseInt(strings.TrimSpace(number), 10, 64)
+		if err != nil {
+			return r, fmt.Errorf("invalid range %q: %w", value, err)
+		}
+
+		switch strings.TrimSpace(key) {
+		case "equal":
+			r.Min, r.Max = n, n
+			bounds += 2
+		case "min":
+			r.Min = n
+			bounds++
+		case "max":
+			r.Max = n
+			bounds++
+		default:
+			return r, fmt.Errorf("invalid range %q", value)
+		}
+	}
+
+	if bounds != 2 || r.Min > r.Max {
+		return r, fmt.Errorf("invalid range %q", value)
+	}
+	return r, nil
+}
+
+// tableCells returns the trimmed cells of a markdown table row.
+func tableCells(line string) []string {
+	cells := strings.Split(strings.Trim(line, "|"), "|")
+	for i := range cells {
+		cells[i] = strings.TrimSpace(cells[i])
+	}
+	return cells
+}
+
+// sortedKeys returns the sorted keys of the map.
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	return keys
+}
@@ -0,0 +1,92 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package functions
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &vdcLimitsFunction{}
+
+// NewVDCLimitsFunction is a helper function to simplify the provider implementation.
+func NewVDCLimitsFunction() function.Function {
+	return &vdcLimitsFunction{}
+}
+
+// vdcLimitsFunction is the function implementation.
+type vdcLimitsFunction struct{}
+
+var (
+	vdcLimitsRangeAttrTypes = map[string]attr.Type{
+		"min":      types.Int64Type,
+		"max":      types.Int64Type,
+		"editable": types.BoolType,
+	}
+
+	vdcLimitsAttrTypes = map[string]attr.Type{
+		"disponibility_classes":  types.ListType{ElemType: types.StringType},
+		"storage_billing_models": types.ListType{ElemType: types.StringType},
+		"cpu_speed_in_mhz":       types.ObjectType{AttrTypes: vdcLimitsRangeAttrTypes},
+		"cpu_allocated":          types.ObjectType{AttrTypes: vdcLimitsRangeAttrTypes},
+		"memory_allocated":       types.ObjectType{AttrTypes: vdcLimitsRangeAttrTypes},
+	}
+)
+
+// Metadata returns the function name.
+func (f *vdcLimitsFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "vdc_limits"
+}
+
+// Definition defines the parameters and return type of the function.
+func (f *vdcLimitsFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Return the limits of a VDC for a service class and a billing model",
+		MarkdownDescription: "Returns the limits applied by the `cloudavenue_vdc` resource to a VDC of the given service class and billing model: the allowed `disponibility_classes` and `storage_billing_models`, and the `min` and `max` values of `cpu_speed_in_mhz` (in `MHz`), `cpu_allocated` (in `MHz`) and `memory_allocated` (in `GB`). `editable` is `false` when the value cannot be changed after the creation of the VDC. See [Rules](https://registry.terraform.io/providers/orange-cloudavenue/cloudavenue/latest/docs/resources/vdc#rules) for more information.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "service_class",
+				MarkdownDescription: "The service class of the VDC (e.g. `ECO`, `STD`, `HP`, `VOIP`).",
+			},
+			function.StringParameter{
+				Name:                "billing_model",
+				MarkdownDescription: "The billing model of the VDC (e.g. `PAYG`, `DRAAS`, `RESERVED`).",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: vdcLimitsAttrTypes,
+		},
+	}
+}
+
+// Run returns the limits of the VDC.
+func (f *vdcLimitsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var serviceClass, billingModel string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &serviceClass, &billingModel))
+	if resp.Error != nil {
+		return
+	}
+
+	limits, argument, err := vdcLimitsRule(serviceClass, billingModel)
+	if err != nil {
+		if argument < 0 {
+			resp.Error = function.NewFuncError(err.Error())
+			return
+		}
+		resp.Error = function.NewArgumentFuncError(int64(argument), err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, limits))
+}
@@ -0,0 +1,137 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package functions
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// testVDCRules are the VDC rules as rendered by the SDK.
+const testVDCRules = `
+## Rules
+### ServiceClass ECO
+| BillingModels | StorageBillingModels | DisponibilityClasses | CPUInMhz (Mhz)          | CPUAllocated (Mhz)         | MemoryAllocated (Gb) |
+| ------------- | -------------------- | -------------------- | ----------------------- | -------------------------- | -------------------- |
+| RESERVED      | PAYG, RESERVED       | ONE-ROOM, DUAL-ROOM  | ** min: 1200, max: 2200 | ** min: 3000, max: 2500000 | ** min: 1, max: 5120 |
+| PAYG          | PAYG, RESERVED       | ONE-ROOM, DUAL-ROOM  | equal: 2200             | ** min: 11000, max: 440000 | ** min: 1, max: 5120 |
+
+### ServiceClass VOIP
+| BillingModels | StorageBillingModels | DisponibilityClasses              | CPUInMhz (Mhz) | CPUAllocated (Mhz)         | MemoryAllocated (Gb) |
+| ------------- | -------------------- | --------------------------------- | -------------- | -------------------------- | -------------------- |
+| RESERVED      | PAYG, RESERVED       | ONE-ROOM, HA-DUAL-ROOM, DUAL-ROOM | equal: 3000    | ** min: 3000, max: 2500000 | ** min: 1, max: 5120 |
+`
+
+func TestParseVDCRules(t *testing.T) {
+	t.Parallel()
+
+	all, err := parseVDCRules(testVDCRules)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	want := vdcLimits{
+		DisponibilityClasses: []string{"ONE-ROOM", "DUAL-ROOM"},
+		StorageBillingModels: []string{"PAYG", "RESERVED"},
+		CPUSpeedInMhz:        vdcRange{Min: 1200, Max: 2200, Editable: true},
+		CPUAllocated:         vdcRange{Min: 3000, Max: 2500000, Editable: true},
+		MemoryAllocated:      vdcRange{Min: 1, Max: 5120, Editable: true},
+	}
+	if got := all["ECO"]["RESERVED"]; !reflect.DeepEqual(got, want) {
+		t.Fatalf("expected ECO RESERVED limits %+v, got %+v", want, got)
+	}
+	if got := all["VOIP"]["RESERVED"].CPUSpeedInMhz; got != (vdcRange{Min: 3000, Max: 3000}) {
+		t.Fatalf("expected VOIP RESERVED cpu speed equal to 3000, got %+v", got)
+	}
+	if len(all) != 2 || len(all["ECO"]) != 2 {
+		t.Fatalf("expected 2 service classes and 2 ECO billing models, got %+v", all)
+	}
+}
+
+func TestParseVDCRulesUnrecognisedLayout(t *testing.T) {
+	t.Parallel()
+
+	const (
+		header    = "| BillingModels | StorageBillingModels | DisponibilityClasses | CPUInMhz (Mhz) | CPUAllocated (Mhz) | MemoryAllocated (Gb) |\n"
+		separator = "| --- | --- | --- | --- | --- | --- |\n"
+		row       = "| PAYG | PAYG | ONE-ROOM | equal: 2200 | ** min: 11000, max: 440000 | ** min: 1, max: 5120 |\n"
+	)
+
+	tests := []struct {
+		name    string
+		details string
+	}{
+		{
+			name:    "No service class",
+			details: "## Rules\n",
+		},
+		{
+			name:    "Missing column",
+			details: "### ServiceClass ECO\n" + strings.Replace(header, "CPUInMhz", "CPUSpeed", 1) + separator + row,
+		},
+		{
+			name:    "Missing separator",
+			details: "### ServiceClass ECO\n" + header + row,
+		},
+		{
+			name:    "No billing model",
+			details: "### ServiceClass ECO\n" + header + separator + "### ServiceClass STD\n" + header + separator + row,
+		},
+		{
+			name:    "Extra cell",
+			details: "### ServiceClass ECO\n" + header + separator + strings.TrimSuffix(row, "\n") + " 1 |\n",
+		},
+		{
+			name:    "Duplicated billing model",
+			details: "### ServiceClass ECO\n" + header + separator + row + row,
+		},
+		{
+			name:    "Invalid range",
+			details: "### ServiceClass ECO\n" + header + separator + strings.Replace(row, "equal: 2200", "2200 Mhz", 1),
+		},
+		{
+			name:    "Range without max",
+			details: "### ServiceClass ECO\n" + header + separator + strings.Replace(row, "equal: 2200", "min: 2200", 1),
+		},
+		{
+			name:    "Min greater than max",
+			details: "### ServiceClass ECO\n" + header + separator + strings.Replace(row, "min: 11000, max: 440000", "min: 440000, max: 11000", 1),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if all, err := parseVDCRules(tt.details); err == nil {
+				t.Fatalf("expected an error, got %+v", all)
+			}
+		})
+	}
+}
+
+// TestVDCRulesSDK parses the rules of the pinned SDK.
+func TestVDCRulesSDK(t *testing.T) {
+	t.Parallel()
+
+	all, err := vdcRules()
+	if err != nil {
+		t.Fatalf("unable to parse the VDC rules of the SDK: %s", err)
+	}
+
+	limits, ok := all["ECO"]["RESERVED"]
+	if !ok {
+		t.Fatalf("expected the ECO RESERVED limits, got %+v", all)
+	}
+	if want := (vdcRange{Min: 1200, Max: 2200, Editable: true}); limits.CPUSpeedInMhz != want {
+		t.Fatalf("expected ECO RESERVED cpu speed %+v, got %+v", want, limits.CPUSpeedInMhz)
+	}
+}
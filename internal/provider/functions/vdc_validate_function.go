@@ -0,0 +1,124 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package functions
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/orange-cloudavenue/cloudavenue-sdk-go/v1/infrapi/rules"
+)
+
+var _ function.Function = &vdcValidateFunction{}
+
+// NewVDCValidateFunction is a helper function to simplify the provider implementation.
+func NewVDCValidateFunction() function.Function {
+	return &vdcValidateFunction{}
+}
+
+// vdcValidateFunction is the function implementation.
+type vdcValidateFunction struct{}
+
+type (
+	vdcValidateModel struct {
+		ServiceClass        string                           `tfsdk:"service_class"`
+		DisponibilityClass  string                           `tfsdk:"disponibility_class"`
+		BillingModel        string                           `tfsdk:"billing_model"`
+		VCPUInMhz           int64                            `tfsdk:"cpu_speed_in_mhz"`
+		CPUAllocated        int64                            `tfsdk:"cpu_allocated"`
+		MemoryAllocated     int64                            `tfsdk:"memory_allocated"`
+		StorageBillingModel string                           `tfsdk:"storage_billing_model"`
+		StorageProfiles     []vdcValidateModelStorageProfile `tfsdk:"storage_profiles"`
+	}
+
+	vdcValidateModelStorageProfile struct {
+		Class   string `tfsdk:"class"`
+		Limit   int64  `tfsdk:"limit"`
+		Default bool   `tfsdk:"default"`
+	}
+)
+
+// Metadata returns the function name.
+func (f *vdcValidateFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "vdc_validate"
+}
+
+// Definition defines the parameters and return type of the function.
+func (f *vdcValidateFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Validate a VDC sizing against the Cloud Avenue rules",
+		MarkdownDescription: "Validates the sizing of a VDC (service class, disponibility class, billing models, CPU, memory and storage profiles) against the rules applied by the `cloudavenue_vdc` resource. Returns an empty string if the sizing is valid, otherwise the reason why it is not. See [Rules](https://registry.terraform.io/providers/orange-cloudavenue/cloudavenue/latest/docs/resources/vdc#rules) for more information.",
+		Parameters: []function.Parameter{
+			function.ObjectParameter{
+				Name:                "vdc",
+				MarkdownDescription: "An object with the same attributes as the `cloudavenue_vdc` resource: `service_class`, `disponibility_class`, `billing_model`, `cpu_speed_in_mhz`, `cpu_allocated`, `memory_allocated`, `storage_billing_model` and `storage_profiles` (a list of objects with `class`, `limit` and `default`).",
+				AttributeTypes: map[string]attr.Type{
+					"service_class":         types.StringType,
+					"disponibility_class":   types.StringType,
+					"billing_model":         types.StringType,
+					"cpu_speed_in_mhz":      types.Int64Type,
+					"cpu_allocated":         types.Int64Type,
+					"memory_allocated":      types.Int64Type,
+					"storage_billing_model": types.StringType,
+					"storage_profiles": types.ListType{
+						ElemType: types.ObjectType{
+							AttrTypes: map[string]attr.Type{
+								"class":   types.StringType,
+								"limit":   types.Int64Type,
+								"default": types.BoolType,
+							},
+						},
+					},
+				},
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run validates the VDC sizing.
+func (f *vdcValidateFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var vdc vdcValidateModel
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &vdc))
+	if resp.Error != nil {
+		return
+	}
+
+	storageProfiles := make(map[rules.StorageProfileClass]struct {
+		Limit   int
+		Default bool
+	})
+	for _, sP := range vdc.StorageProfiles {
+		storageProfiles[rules.StorageProfileClass(sP.Class)] = struct {
+			Limit   int
+			Default bool
+		}{Limit: int(sP.Limit), Default: sP.Default}
+	}
+
+	result := ""
+	if err := rules.Validate(rules.ValidateData{
+		ServiceClass:        rules.ServiceClass(vdc.ServiceClass),
+		DisponibilityClass:  rules.DisponibilityClass(vdc.DisponibilityClass),
+		BillingModel:        rules.BillingModel(vdc.BillingModel),
+		VCPUInMhz:           int(vdc.VCPUInMhz),
+		CPUAllocated:        int(vdc.CPUAllocated),
+		MemoryAllocated:     int(vdc.MemoryAllocated),
+		StorageBillingModel: rules.BillingModel(vdc.StorageBillingModel),
+		StorageProfiles:     storageProfiles,
+	}, false); err != nil {
+		result = err.Error()
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
@@ -37,16 +37,20 @@ import (
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/metrics"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/adminorg"
 	cerrs "github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/errors"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/identity"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/movestate"
 )
 
 const roleSubsystem = "iam.role"
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &roleResource{}
-	_ resource.ResourceWithConfigure   = &roleResource{}
-	_ resource.ResourceWithImportState = &roleResource{}
-	_ role                             = &roleResource{}
+	_ resource.Resource                 = &roleResource{}
+	_ resource.ResourceWithConfigure    = &roleResource{}
+	_ resource.ResourceWithImportState  = &roleResource{}
+	_ role                              = &roleResource{}
+	_ resource.ResourceWithMoveState    = &roleResource{}
+	_ identity.ResourceWithIdentitySpec = &roleResource{}
 )
 
 // NewroleResource is a helper function to simplify the provider implementation.
@@ -161,7 +165,7 @@ func (r *roleResource) Create(ctx context.Context, req resource.CreateRequest, r
 	})
 	if err != nil {
 		tflog.SubsystemError(ctx, roleSubsystem, "Role create failed", map[string]interface{}{attrName: plan.Name.ValueString()})
-		resp.Diagnostics.AddError("Error creating role", err.Error())
+		cerrs.AddError(&resp.Diagnostics, cerrs.ActionCreate, "role", err)
 		return
 	}
 	if len(rights) > 0 {
@@ -285,7 +289,7 @@ func (r *roleResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 	err = role.Delete()
 	if err != nil {
 		tflog.SubsystemError(ctx, roleSubsystem, "Role delete failed", map[string]interface{}{attrName: state.Name.ValueString()})
-		resp.Diagnostics.AddError("Error deleting role", err.Error())
+		cerrs.AddError(&resp.Diagnostics, cerrs.ActionDelete, "role", err)
 		return
 	}
 }
@@ -329,7 +333,7 @@ func (r *roleResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		role.Role.Description = plan.Description.Get()
 		if _, err := role.Update(); err != nil {
 			tflog.SubsystemError(ctx, roleSubsystem, "Role attribute update failed", map[string]interface{}{attrName: plan.Name.ValueString()})
-			resp.Diagnostics.AddError("Error updating role", err.Error())
+			cerrs.AddError(&resp.Diagnostics, cerrs.ActionUpdate, "role", err)
 			return
 		}
 	}
@@ -372,7 +376,7 @@ func (r *roleResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	// Update the role rights
 	if len(rights) > 0 {
 		if err := role.UpdateRights(rights); err != nil {
-			resp.Diagnostics.AddError("Error updating role rights", err.Error())
+			cerrs.AddError(&resp.Diagnostics, cerrs.ActionUpdate, "role rights", err)
 			return
 		}
 	} else {
@@ -407,6 +411,30 @@ func (r *roleResource) ImportState(ctx context.Context, req resource.ImportState
 	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
 }
 
+// IdentitySpec returns the identity of the resource.
+// The role is imported by its name, which can be updated.
+func (r *roleResource) IdentitySpec() identity.Spec {
+	return identity.Spec{
+		Attributes: []identity.Attribute{
+			{
+				Name:        "name",
+				Description: "The name of the role.",
+			},
+		},
+		Mutable: true,
+	}
+}
+
+// MoveState returns the state movers from the equivalent resources of the VMware vcd provider.
+func (r *roleResource) MoveState(_ context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		movestate.FromVCD("vcd_role", movestate.Attributes{
+			"id":   "id",
+			"name": "name",
+		}),
+	}
+}
+
 func (r *roleResource) GetRole() (*govcd.Role, error) {
 	return r.role.GetRole(r.adminOrg)
 }
@@ -0,0 +1,175 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package iam
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/metrics"
+	cerrs "github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/errors"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/org"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/readonly"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ ephemeral.EphemeralResource              = &tokenEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &tokenEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithClose     = &tokenEphemeralResource{}
+)
+
+// privateKeyToken is the key used to store the token ID in the ephemeral private data.
+const privateKeyToken = "token"
+
+// NewTokenEphemeralResource is a helper function to simplify the provider implementation.
+func NewTokenEphemeralResource() ephemeral.EphemeralResource {
+	return &tokenEphemeralResource{}
+}
+
+// tokenEphemeralResource is the ephemeral resource implementation.
+type tokenEphemeralResource struct {
+	client *client.CloudAvenue
+	org    org.Org
+}
+
+// Init Initializes the ephemeral resource.
+func (r *tokenEphemeralResource) Init(_ context.Context) (diags diag.Diagnostics) {
+	r.org, diags = org.Init(r.client)
+	return diags
+}
+
+// Metadata returns the ephemeral resource type name.
+func (r *tokenEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + categoryName + "_token"
+}
+
+// Schema defines the schema for the ephemeral resource.
+func (r *tokenEphemeralResource) Schema(ctx context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = tokenEphemeralSchema(ctx)
+}
+
+func (r *tokenEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.CloudAvenue)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *client.CloudAvenue, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+// Open creates a new API token and returns it without persisting it.
+func (r *tokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	defer metrics.New("cloudavenue_iam_token", r.client.GetOrgName(), metrics.Open)()
+
+	// Opening the ephemeral resource creates a token.
+	if r.client.IsReadOnly() {
+		resp.Diagnostics.Append(readonly.Error("cloudavenue_iam_token", "opened"))
+		return
+	}
+
+	config := &TokenEphemeralModel{}
+
+	// Retrieve values from config
+	resp.Diagnostics.Append(req.Config.Get(ctx, config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Init the ephemeral resource
+	resp.Diagnostics.Append(r.Init(ctx)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	token, err := r.client.Vmware.CreateToken(r.org.GetName(), config.Name.Get())
+	if err != nil {
+		cerrs.AddError(&resp.Diagnostics, cerrs.ActionCreate, "token", err)
+		return
+	}
+
+	// Close is not called when Open fails, revoke the token to avoid leaking it.
+	defer func() {
+		if !resp.Diagnostics.HasError() {
+			return
+		}
+		if errDelete := token.Delete(); errDelete != nil {
+			cerrs.AddError(&resp.Diagnostics, cerrs.ActionDelete, "token", errDelete)
+		}
+	}()
+
+	tokenString, err := token.GetInitialApiToken()
+	if err != nil {
+		resp.Diagnostics.AddError("Error getting token", err.Error())
+		return
+	}
+
+	private, err := json.Marshal(tokenEphemeralPrivate{ID: token.Token.ID})
+	if err != nil {
+		resp.Diagnostics.AddError("Error marshalling token private data", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, privateKeyToken, private)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config.ID.Set(token.Token.ID)
+	config.Token.Set(tokenString.RefreshToken)
+	config.AccessToken.Set(tokenString.AccessToken)
+
+	// Set the result
+	resp.Diagnostics.Append(resp.Result.Set(ctx, config)...)
+}
+
+// Close revokes the API token created by Open.
+func (r *tokenEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	defer metrics.New("cloudavenue_iam_token", r.client.GetOrgName(), metrics.Close)()
+
+	raw, d := req.Private.GetKey(ctx, privateKeyToken)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() || raw == nil {
+		return
+	}
+
+	private := tokenEphemeralPrivate{}
+	if err := json.Unmarshal(raw, &private); err != nil {
+		resp.Diagnostics.AddError("Error unmarshalling token private data", err.Error())
+		return
+	}
+
+	token, err := r.client.Vmware.GetTokenById(private.ID)
+	if err != nil {
+		if cerrs.IsNotFound(err) {
+			// Token already revoked
+			return
+		}
+		cerrs.AddError(&resp.Diagnostics, cerrs.ActionRead, "token", err)
+		return
+	}
+
+	if err := token.Delete(); err != nil {
+		cerrs.AddError(&resp.Diagnostics, cerrs.ActionDelete, "token", err)
+		return
+	}
+}
@@ -32,13 +32,15 @@ import (
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/metrics"
 	cerrs "github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/errors"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/identity"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/org"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource              = &tokenResource{}
-	_ resource.ResourceWithConfigure = &tokenResource{}
+	_ resource.Resource                 = &tokenResource{}
+	_ resource.ResourceWithConfigure    = &tokenResource{}
+	_ identity.ResourceWithIdentitySpec = &tokenResource{}
 )
 
 // NewTokenResource is a helper function to simplify the provider implementation.
@@ -109,7 +111,7 @@ func (r *tokenResource) Create(ctx context.Context, req resource.CreateRequest,
 
 	token, err := r.client.Vmware.CreateToken(r.org.GetName(), plan.Name.Get())
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating token", err.Error())
+		cerrs.AddError(&resp.Diagnostics, cerrs.ActionCreate, "token", err)
 		return
 	}
 
@@ -226,7 +228,19 @@ func (r *tokenResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	}
 
 	if err := token.Delete(); err != nil {
-		resp.Diagnostics.AddError("Error deleting token", err.Error())
+		cerrs.AddError(&resp.Diagnostics, cerrs.ActionDelete, "token", err)
 		return
 	}
 }
+
+// IdentitySpec returns the identity of the resource.
+func (r *tokenResource) IdentitySpec() identity.Spec {
+	return identity.Spec{
+		Attributes: []identity.Attribute{
+			{
+				Name:        "id",
+				Description: "The ID of the API token.",
+			},
+		},
+	}
+}
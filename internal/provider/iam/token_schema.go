@@ -22,7 +22,9 @@ import (
 	"context"
 
 	superschema "github.com/orange-cloudavenue/terraform-plugin-framework-superschema"
+	supertypes "github.com/orange-cloudavenue/terraform-plugin-framework-supertypes"
 
+	schemaE "github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
 	schemaR "github.com/hashicorp/terraform-plugin-framework/resource/schema"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
@@ -35,7 +37,7 @@ import (
 func tokenSchema(_ context.Context) superschema.Schema {
 	return superschema.Schema{
 		Resource: superschema.SchemaDetails{
-			MarkdownDescription: "The `cloudavenue_iam_token` resource allows you to manage API tokens for a specific user. \n\n !> **Warning:** This resource print or store the token in clear text.",
+			MarkdownDescription: "The `cloudavenue_iam_token` resource allows you to manage API tokens for a specific user. \n\n !> **Warning:** This resource print or store the token in clear text. \n\n -> **Note:** Use the `cloudavenue_iam_token` ephemeral resource to generate a token without storing it in the state or on disk.",
 		},
 		Attributes: map[string]superschema.Attribute{
 			"id": superschema.SuperStringAttribute{
@@ -114,3 +116,33 @@ func tokenSchema(_ context.Context) superschema.Schema {
 		},
 	}
 }
+
+func tokenEphemeralSchema(_ context.Context) schemaE.Schema {
+	return schemaE.Schema{
+		MarkdownDescription: "The `cloudavenue_iam_token` ephemeral resource allows you to generate an API token for the current user during a Terraform run. The token is never stored in the state or on disk and is revoked when the ephemeral resource is closed.",
+		Attributes: map[string]schemaE.Attribute{
+			"id": schemaE.StringAttribute{
+				MarkdownDescription: "The unique ID of the API token for a specific user.",
+				Computed:            true,
+				CustomType:          supertypes.StringType{},
+			},
+			attrName: schemaE.StringAttribute{
+				MarkdownDescription: "The unique name of the API token for a specific user.",
+				Required:            true,
+				CustomType:          supertypes.StringType{},
+			},
+			"token": schemaE.StringAttribute{
+				MarkdownDescription: "The API token for a specific user. This is the long-lived token used to authenticate against the Cloud Avenue API.",
+				Computed:            true,
+				Sensitive:           true,
+				CustomType:          supertypes.StringType{},
+			},
+			"access_token": schemaE.StringAttribute{
+				MarkdownDescription: "The short-lived bearer token obtained when the API token was generated.",
+				Computed:            true,
+				Sensitive:           true,
+				CustomType:          supertypes.StringType{},
+			},
+		},
+	}
+}
@@ -0,0 +1,40 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package iam_test
+
+import (
+	"testing"
+
+	fwephemeral "github.com/hashicorp/terraform-plugin-framework/ephemeral"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/iam"
+)
+
+// Unit test for the schema of the ephemeral resource cloudavenue_iam_token.
+func TestTokenEphemeralResourceSchema(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	schemaResponse := &fwephemeral.SchemaResponse{}
+
+	// Instantiate the ephemeral.EphemeralResource and call its Schema method
+	iam.NewTokenEphemeralResource().Schema(ctx, fwephemeral.SchemaRequest{}, schemaResponse)
+
+	if schemaResponse.Diagnostics.HasError() {
+		t.Fatalf("Schema method diagnostics: %+v", schemaResponse.Diagnostics)
+	}
+
+	// Validate the schema
+	diagnostics := schemaResponse.Schema.ValidateImplementation(ctx)
+
+	if diagnostics.HasError() {
+		t.Fatalf("Schema validation diagnostics: %+v", diagnostics)
+	}
+}
@@ -39,3 +39,15 @@ func (rm *TokenModel) Copy() *TokenModel {
 	utils.ModelCopy(rm, x)
 	return x
 }
+
+type TokenEphemeralModel struct {
+	AccessToken supertypes.StringValue `tfsdk:"access_token"`
+	ID          supertypes.StringValue `tfsdk:"id"`
+	Name        supertypes.StringValue `tfsdk:"name"`
+	Token       supertypes.StringValue `tfsdk:"token"`
+}
+
+// tokenEphemeralPrivate is the private data stored between Open and Close.
+type tokenEphemeralPrivate struct {
+	ID string `json:"id"`
+}
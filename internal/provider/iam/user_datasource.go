@@ -123,7 +123,7 @@ func (d *userDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 			resp.Diagnostics.AddError("User not found", err.Error())
 			return
 		}
-		resp.Diagnostics.AddError("Error reading user", err.Error())
+		cerrs.AddError(&resp.Diagnostics, cerrs.ActionRead, "user", err)
 		return
 	}
 
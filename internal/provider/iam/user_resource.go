@@ -31,13 +31,17 @@ import (
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/metrics"
 	cerrs "github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/errors"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/identity"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/movestate"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &userResource{}
-	_ resource.ResourceWithConfigure   = &userResource{}
-	_ resource.ResourceWithImportState = &userResource{}
+	_ resource.Resource                 = &userResource{}
+	_ resource.ResourceWithConfigure    = &userResource{}
+	_ resource.ResourceWithImportState  = &userResource{}
+	_ resource.ResourceWithMoveState    = &userResource{}
+	_ identity.ResourceWithIdentitySpec = &userResource{}
 )
 
 // NewuserResource is a helper function to simplify the provider implementation.
@@ -126,7 +130,7 @@ func (r *userResource) Create(ctx context.Context, req resource.CreateRequest, r
 			resp.Diagnostics.AddError("User not found after create", fmt.Sprintf("User with name %s not found after create", plan.Name.Get()))
 			return
 		}
-		resp.Diagnostics.AddError("Error creating user", err.Error())
+		cerrs.AddError(&resp.Diagnostics, cerrs.ActionCreate, "user", err)
 		return
 	}
 
@@ -219,7 +223,7 @@ func (r *userResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	user.User.StoredVMQuota = plan.StoredVMQuota.GetInt()
 
 	if err := user.Update(); err != nil {
-		resp.Diagnostics.AddError("Error updating user", err.Error())
+		cerrs.AddError(&resp.Diagnostics, cerrs.ActionUpdate, "user", err)
 		return
 	}
 
@@ -273,7 +277,7 @@ func (r *userResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 	}
 
 	if err = user.Delete(state.TakeOwnership.Get()); err != nil {
-		resp.Diagnostics.AddError("Error deleting user", err.Error())
+		cerrs.AddError(&resp.Diagnostics, cerrs.ActionDelete, "user", err)
 	}
 }
 
@@ -321,6 +325,28 @@ func (r *userResource) ImportState(ctx context.Context, req resource.ImportState
 	resp.Diagnostics.Append(resp.State.Set(ctx, stateRefreshed)...)
 }
 
+// IdentitySpec returns the identity of the resource.
+func (r *userResource) IdentitySpec() identity.Spec {
+	return identity.Spec{
+		Attributes: []identity.Attribute{
+			{
+				Name:        "name",
+				Description: "The name of the user.",
+			},
+		},
+	}
+}
+
+// MoveState returns the state movers from the equivalent resources of the VMware vcd provider.
+func (r *userResource) MoveState(_ context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		movestate.FromVCD("vcd_org_user", movestate.Attributes{
+			"id":   "id",
+			"name": "name",
+		}),
+	}
+}
+
 // * CustomFuncs
 
 // read is a generic read function that can be used by the resource Create, Read and Update functions.
@@ -30,13 +30,15 @@ import (
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/metrics"
 	cerrs "github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/errors"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/identity"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &UserSAMLResource{}
-	_ resource.ResourceWithConfigure   = &UserSAMLResource{}
-	_ resource.ResourceWithImportState = &UserSAMLResource{}
+	_ resource.Resource                 = &UserSAMLResource{}
+	_ resource.ResourceWithConfigure    = &UserSAMLResource{}
+	_ resource.ResourceWithImportState  = &UserSAMLResource{}
+	_ identity.ResourceWithIdentitySpec = &UserSAMLResource{}
 )
 
 // NewUserSAMLResource is a helper function to simplify the provider implementation.
@@ -121,7 +123,7 @@ func (r *UserSAMLResource) Create(ctx context.Context, req resource.CreateReques
 			resp.Diagnostics.AddError("User not found after create", fmt.Sprintf("User with name %s not found after create", plan.UserName.Get()))
 			return
 		}
-		resp.Diagnostics.AddError("Error creating user SAML", err.Error())
+		cerrs.AddError(&resp.Diagnostics, cerrs.ActionCreate, "user SAML", err)
 		return
 	}
 
@@ -211,7 +213,7 @@ func (r *UserSAMLResource) Update(ctx context.Context, req resource.UpdateReques
 	user.User.StoredVMQuota = plan.StoredVMQuota.GetInt()
 
 	if err := user.Update(); err != nil {
-		resp.Diagnostics.AddError("Error updating user", err.Error())
+		cerrs.AddError(&resp.Diagnostics, cerrs.ActionUpdate, "user", err)
 		return
 	}
 
@@ -262,7 +264,7 @@ func (r *UserSAMLResource) Delete(ctx context.Context, req resource.DeleteReques
 	}
 
 	if err = user.Delete(state.TakeOwnership.Get()); err != nil {
-		resp.Diagnostics.AddError("Error deleting user", err.Error())
+		cerrs.AddError(&resp.Diagnostics, cerrs.ActionDelete, "user", err)
 	}
 }
 
@@ -310,6 +312,18 @@ func (r *UserSAMLResource) ImportState(ctx context.Context, req resource.ImportS
 	resp.Diagnostics.Append(resp.State.Set(ctx, stateRefreshed)...)
 }
 
+// IdentitySpec returns the identity of the resource.
+func (r *UserSAMLResource) IdentitySpec() identity.Spec {
+	return identity.Spec{
+		Attributes: []identity.Attribute{
+			{
+				Name:        "user_name",
+				Description: "The username of the user in the SAML provider.",
+			},
+		},
+	}
+}
+
 // * CustomFuncs
 
 // read is a generic read function that can be used by the resource Create, Read and Update functions.
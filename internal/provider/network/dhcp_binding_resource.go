@@ -34,15 +34,19 @@ import (
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/metrics"
 	cerrs "github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/errors"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/identity"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/movestate"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/mutex"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/org"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &dhcpBindingResource{}
-	_ resource.ResourceWithConfigure   = &dhcpBindingResource{}
-	_ resource.ResourceWithImportState = &dhcpBindingResource{}
+	_ resource.Resource                 = &dhcpBindingResource{}
+	_ resource.ResourceWithConfigure    = &dhcpBindingResource{}
+	_ resource.ResourceWithImportState  = &dhcpBindingResource{}
+	_ resource.ResourceWithMoveState    = &dhcpBindingResource{}
+	_ identity.ResourceWithIdentitySpec = &dhcpBindingResource{}
 )
 
 // NewDhcpBindingResource is a helper function to simplify the provider implementation.
@@ -112,7 +116,10 @@ func (r *dhcpBindingResource) Create(ctx context.Context, req resource.CreateReq
 		Implement the resource creation logic here.
 	*/
 
-	mutex.GlobalMutex.KvLock(ctx, plan.OrgNetworkID.Get())
+	if err := mutex.GlobalMutex.KvLock(ctx, plan.OrgNetworkID.Get()); err != nil {
+		resp.Diagnostics.AddError("Error acquiring the lock", err.Error())
+		return
+	}
 	defer mutex.GlobalMutex.KvUnlock(ctx, plan.OrgNetworkID.Get())
 
 	orgNetwork, err := r.org.GetOpenApiOrgVdcNetworkById(plan.OrgNetworkID.Get())
@@ -213,7 +220,10 @@ func (r *dhcpBindingResource) Update(ctx context.Context, req resource.UpdateReq
 		Implement the resource update here
 	*/
 
-	mutex.GlobalMutex.KvLock(ctx, plan.OrgNetworkID.Get())
+	if err := mutex.GlobalMutex.KvLock(ctx, plan.OrgNetworkID.Get()); err != nil {
+		resp.Diagnostics.AddError("Error acquiring the lock", err.Error())
+		return
+	}
 	defer mutex.GlobalMutex.KvUnlock(ctx, plan.OrgNetworkID.Get())
 
 	orgNetwork, err := r.org.GetOpenApiOrgVdcNetworkById(plan.OrgNetworkID.Get())
@@ -271,7 +281,10 @@ func (r *dhcpBindingResource) Delete(ctx context.Context, req resource.DeleteReq
 		Implement the resource deletion here
 	*/
 
-	mutex.GlobalMutex.KvLock(ctx, state.OrgNetworkID.Get())
+	if err := mutex.GlobalMutex.KvLock(ctx, state.OrgNetworkID.Get()); err != nil {
+		resp.Diagnostics.AddError("Error acquiring the lock", err.Error())
+		return
+	}
 	defer mutex.GlobalMutex.KvUnlock(ctx, state.OrgNetworkID.Get())
 
 	orgNetwork, err := r.org.GetOpenApiOrgVdcNetworkById(state.OrgNetworkID.Get())
@@ -376,3 +389,32 @@ func (r *dhcpBindingResource) ImportState(ctx context.Context, req resource.Impo
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("org_network_id"), orgNetworkID)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), dhcpBinding.OpenApiOrgVdcNetworkDhcpBinding.Name)...)
 }
+
+// IdentitySpec returns the identity of the resource.
+// The DHCP binding is imported by its name, which can be updated.
+func (r *dhcpBindingResource) IdentitySpec() identity.Spec {
+	return identity.Spec{
+		Attributes: []identity.Attribute{
+			{
+				Name:        "org_network_id",
+				Description: "The ID of the organization network.",
+			},
+			{
+				Name:        "name",
+				Description: "The name of the DHCP binding.",
+			},
+		},
+		Mutable: true,
+	}
+}
+
+// MoveState returns the state movers from the equivalent resources of the VMware vcd provider.
+func (r *dhcpBindingResource) MoveState(_ context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		movestate.FromVCD("vcd_nsxt_network_dhcp_binding", movestate.Attributes{
+			"id":             "id",
+			"name":           "name",
+			"org_network_id": "org_network_id",
+		}),
+	}
+}
@@ -103,7 +103,10 @@ func (d *dhcpDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 		org:    d.org,
 	}
 
-	mutex.GlobalMutex.KvLock(ctx, config.OrgNetworkID.ValueString())
+	if err := mutex.GlobalMutex.KvLock(ctx, config.OrgNetworkID.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Error acquiring the lock", err.Error())
+		return
+	}
 	defer mutex.GlobalMutex.KvUnlock(ctx, config.OrgNetworkID.ValueString())
 
 	// Read data from the API
@@ -34,6 +34,8 @@ import (
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/metrics"
 	cerrs "github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/errors"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/identity"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/movestate"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/mutex"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/org"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/pkg/utils"
@@ -41,9 +43,11 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &dhcpResource{}
-	_ resource.ResourceWithConfigure   = &dhcpResource{}
-	_ resource.ResourceWithImportState = &dhcpResource{}
+	_ resource.Resource                 = &dhcpResource{}
+	_ resource.ResourceWithConfigure    = &dhcpResource{}
+	_ resource.ResourceWithImportState  = &dhcpResource{}
+	_ resource.ResourceWithMoveState    = &dhcpResource{}
+	_ identity.ResourceWithIdentitySpec = &dhcpResource{}
 )
 
 // NewDhcpResource is a helper function to simplify the provider implementation.
@@ -112,7 +116,10 @@ func (r *dhcpResource) Create(ctx context.Context, req resource.CreateRequest, r
 		Implement the resource creation logic here.
 	*/
 
-	mutex.GlobalMutex.KvLock(ctx, plan.OrgNetworkID.ValueString())
+	if err := mutex.GlobalMutex.KvLock(ctx, plan.OrgNetworkID.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Error acquiring the lock", err.Error())
+		return
+	}
 	defer mutex.GlobalMutex.KvUnlock(ctx, plan.OrgNetworkID.ValueString())
 
 	resp.Diagnostics.Append(r.createUpdateDHCP(ctx, plan)...)
@@ -157,7 +164,10 @@ func (r *dhcpResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		Implement the resource read here
 	*/
 
-	mutex.GlobalMutex.KvLock(ctx, state.OrgNetworkID.ValueString())
+	if err := mutex.GlobalMutex.KvLock(ctx, state.OrgNetworkID.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Error acquiring the lock", err.Error())
+		return
+	}
 	defer mutex.GlobalMutex.KvUnlock(ctx, state.OrgNetworkID.ValueString())
 
 	stateRefreshed, found, d := r.read(ctx, state)
@@ -200,7 +210,10 @@ func (r *dhcpResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		Implement the resource update here
 	*/
 
-	mutex.GlobalMutex.KvLock(ctx, plan.OrgNetworkID.ValueString())
+	if err := mutex.GlobalMutex.KvLock(ctx, plan.OrgNetworkID.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Error acquiring the lock", err.Error())
+		return
+	}
 	defer mutex.GlobalMutex.KvUnlock(ctx, plan.OrgNetworkID.ValueString())
 
 	resp.Diagnostics.Append(r.createUpdateDHCP(ctx, plan)...)
@@ -244,11 +257,14 @@ func (r *dhcpResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 		Implement the resource deletion here
 	*/
 
-	mutex.GlobalMutex.KvLock(ctx, state.OrgNetworkID.ValueString())
+	if err := mutex.GlobalMutex.KvLock(ctx, state.OrgNetworkID.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Error acquiring the lock", err.Error())
+		return
+	}
 	defer mutex.GlobalMutex.KvUnlock(ctx, state.OrgNetworkID.ValueString())
 
 	if err := r.org.DeleteNetworkDHCP(state.OrgNetworkID.ValueString()); err != nil {
-		resp.Diagnostics.AddError("Error deleting dhcp", err.Error())
+		cerrs.AddError(&resp.Diagnostics, cerrs.ActionDelete, "dhcp", err)
 	}
 }
 
@@ -259,10 +275,31 @@ func (r *dhcpResource) ImportState(ctx context.Context, req resource.ImportState
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("org_network_id"), req.ID)...)
 }
 
+// IdentitySpec returns the identity of the resource.
+func (r *dhcpResource) IdentitySpec() identity.Spec {
+	return identity.Spec{
+		Attributes: []identity.Attribute{
+			{
+				Name:        "org_network_id",
+				Description: "The ID of the organization network.",
+			},
+		},
+	}
+}
+
+// MoveState returns the state movers from the equivalent resources of the VMware vcd provider.
+func (r *dhcpResource) MoveState(_ context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		movestate.FromVCD("vcd_nsxt_network_dhcp", movestate.Attributes{
+			"org_network_id": "org_network_id",
+		}),
+	}
+}
+
 // createUpdateDhcp The dhcp has no create method in the API, so we use the update method.
 func (r *dhcpResource) createUpdateDHCP(ctx context.Context, rm *dhcpModel) (diags diag.Diagnostics) {
 	if err := r.org.UpdateNetworkDHCP(rm.OrgNetworkID.ValueString(), rm.toNetworkDHCP(ctx)); err != nil {
-		diags.AddError("Error updating dhcp", err.Error())
+		cerrs.AddError(&diags, cerrs.ActionUpdate, "dhcp", err)
 	}
 
 	return diags
@@ -0,0 +1,130 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package network
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/metrics"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/edgegw"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/listresource"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/org"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ list.ListResource              = &networkRoutedListResource{}
+	_ list.ListResourceWithConfigure = &networkRoutedListResource{}
+)
+
+// NewNetworkRoutedListResource is a helper function to simplify the provider implementation.
+func NewNetworkRoutedListResource() list.ListResource {
+	return &networkRoutedListResource{
+		Base: listresource.NewBase(NewNetworkRoutedResource),
+	}
+}
+
+// networkRoutedListResource is the list resource implementation.
+type networkRoutedListResource struct {
+	listresource.Base
+}
+
+type networkRoutedListResourceModel struct {
+	EdgeGatewayID   types.String `tfsdk:"edge_gateway_id"`
+	EdgeGatewayName types.String `tfsdk:"edge_gateway_name"`
+}
+
+// ListResourceConfigSchema defines the schema of the list resource configuration.
+func (r *networkRoutedListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{
+		MarkdownDescription: "The `cloudavenue_network_routed` list resource allows you to list the routed networks of the organization.",
+		Attributes: map[string]listschema.Attribute{
+			"edge_gateway_id": listschema.StringAttribute{
+				MarkdownDescription: "The ID of the edge gateway. If set, only the networks connected to this edge gateway are listed.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("edge_gateway_name")),
+				},
+			},
+			"edge_gateway_name": listschema.StringAttribute{
+				MarkdownDescription: "The name of the edge gateway. If set, only the networks connected to this edge gateway are listed.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+// List lists the routed networks of the organization.
+func (r *networkRoutedListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	defer metrics.New("cloudavenue_network_routed", r.Client.GetOrgName(), metrics.List)()
+
+	config := &networkRoutedListResourceModel{}
+	if diags := req.Config.Get(ctx, config); diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	o, diags := org.Init(r.Client)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	// Only the networks of this edge gateway are listed if it is set.
+	edgeGatewayID := ""
+	if !config.EdgeGatewayID.IsNull() || !config.EdgeGatewayName.IsNull() {
+		edge, err := o.GetEdgeGateway(edgegw.BaseEdgeGW{
+			ID:   config.EdgeGatewayID,
+			Name: config.EdgeGatewayName,
+		})
+		if err != nil {
+			stream.Results = listresource.Error("Error retrieving Edge Gateway", err)
+			return
+		}
+		edgeGatewayID = edge.GetID()
+	}
+
+	networks, err := o.GetAllOpenApiOrgVdcNetworks(nil)
+	if err != nil {
+		stream.Results = listresource.Error("Unable to list routed networks", err)
+		return
+	}
+
+	items := make([]listresource.Item, 0, len(networks))
+	for _, network := range networks {
+		if !network.IsRouted() || network.OpenApiOrgVdcNetwork.Connection == nil {
+			continue
+		}
+
+		routerRef := network.OpenApiOrgVdcNetwork.Connection.RouterRef
+		if edgeGatewayID != "" && routerRef.ID != edgeGatewayID {
+			continue
+		}
+
+		items = append(items, listresource.Item{
+			DisplayName: routerRef.Name + "/" + network.OpenApiOrgVdcNetwork.Name,
+			Attributes: map[string]string{
+				"id":              network.OpenApiOrgVdcNetwork.ID,
+				"name":            network.OpenApiOrgVdcNetwork.Name,
+				"edge_gateway_id": routerRef.ID,
+			},
+		})
+	}
+
+	stream.Results = r.Results(ctx, req, items)
+}
@@ -37,17 +37,28 @@ import (
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/metrics"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/edgegw"
 	cerrs "github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/errors"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/identity"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/movestate"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/mutex"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/org"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &networkRoutedResource{}
-	_ resource.ResourceWithConfigure   = &networkRoutedResource{}
-	_ resource.ResourceWithImportState = &networkRoutedResource{}
+	_ resource.Resource                 = &networkRoutedResource{}
+	_ resource.ResourceWithConfigure    = &networkRoutedResource{}
+	_ resource.ResourceWithImportState  = &networkRoutedResource{}
+	_ resource.ResourceWithMoveState    = &networkRoutedResource{}
+	_ identity.ResourceWithIdentitySpec = &networkRoutedResource{}
 )
 
+// routedAttributePaths are the attributes causing the API errors of a
+// routed network.
+var routedAttributePaths = cerrs.AttributePaths{
+	cerrs.KindConflict:         path.Root("name"),
+	cerrs.KindInvalidReference: path.Root("edge_gateway_id"),
+}
+
 // NewNetworkRoutedResource is a helper function to simplify the provider implementation.
 func NewNetworkRoutedResource() resource.Resource {
 	return &networkRoutedResource{}
@@ -137,10 +148,16 @@ func (r *networkRoutedResource) Create(ctx context.Context, req resource.CreateR
 	}
 
 	if vdcOrVDCGroup.IsVDCGroup() {
-		mutex.GlobalMutex.KvLock(ctx, vdcOrVDCGroup.GetID())
+		if err := mutex.GlobalMutex.KvLock(ctx, vdcOrVDCGroup.GetID()); err != nil {
+			resp.Diagnostics.AddError("Error acquiring the lock", err.Error())
+			return
+		}
 		defer mutex.GlobalMutex.KvUnlock(ctx, vdcOrVDCGroup.GetID())
 	} else {
-		mutex.GlobalMutex.KvLock(ctx, r.edgegw.GetID())
+		if err := mutex.GlobalMutex.KvLock(ctx, r.edgegw.GetID()); err != nil {
+			resp.Diagnostics.AddError("Error acquiring the lock", err.Error())
+			return
+		}
 		defer mutex.GlobalMutex.KvUnlock(ctx, r.edgegw.GetID())
 	}
 
@@ -154,7 +171,7 @@ func (r *networkRoutedResource) Create(ctx context.Context, req resource.CreateR
 	// Create Network
 	orgNetwork, err := r.org.CreateOpenApiOrgVdcNetwork(orgVDCNetworkConfig)
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating routing network", err.Error())
+		cerrs.AddAttributeError(&resp.Diagnostics, routedAttributePaths, cerrs.ActionCreate, "routing network", err)
 		return
 	}
 
@@ -233,10 +250,16 @@ func (r *networkRoutedResource) Update(ctx context.Context, req resource.UpdateR
 	}
 
 	if vdcOrVDCGroup.IsVDCGroup() {
-		mutex.GlobalMutex.KvLock(ctx, vdcOrVDCGroup.GetID())
+		if err := mutex.GlobalMutex.KvLock(ctx, vdcOrVDCGroup.GetID()); err != nil {
+			resp.Diagnostics.AddError("Error acquiring the lock", err.Error())
+			return
+		}
 		defer mutex.GlobalMutex.KvUnlock(ctx, vdcOrVDCGroup.GetID())
 	} else {
-		mutex.GlobalMutex.KvLock(ctx, r.edgegw.GetID())
+		if err := mutex.GlobalMutex.KvLock(ctx, r.edgegw.GetID()); err != nil {
+			resp.Diagnostics.AddError("Error acquiring the lock", err.Error())
+			return
+		}
 		defer mutex.GlobalMutex.KvUnlock(ctx, r.edgegw.GetID())
 	}
 
@@ -261,7 +284,7 @@ func (r *networkRoutedResource) Update(ctx context.Context, req resource.UpdateR
 	// Update network
 	_, err = orgNetwork.Update(orgVDCNetworkConfig)
 	if err != nil {
-		resp.Diagnostics.AddError("Error updating routing network", err.Error())
+		cerrs.AddAttributeError(&resp.Diagnostics, routedAttributePaths, cerrs.ActionUpdate, "routing network", err)
 		return
 	}
 
@@ -304,10 +327,16 @@ func (r *networkRoutedResource) Delete(ctx context.Context, req resource.DeleteR
 	}
 
 	if vdcOrVDCGroup.IsVDCGroup() {
-		mutex.GlobalMutex.KvLock(ctx, vdcOrVDCGroup.GetID())
+		if err := mutex.GlobalMutex.KvLock(ctx, vdcOrVDCGroup.GetID()); err != nil {
+			resp.Diagnostics.AddError("Error acquiring the lock", err.Error())
+			return
+		}
 		defer mutex.GlobalMutex.KvUnlock(ctx, vdcOrVDCGroup.GetID())
 	} else {
-		mutex.GlobalMutex.KvLock(ctx, r.edgegw.GetID())
+		if err := mutex.GlobalMutex.KvLock(ctx, r.edgegw.GetID()); err != nil {
+			resp.Diagnostics.AddError("Error acquiring the lock", err.Error())
+			return
+		}
 		defer mutex.GlobalMutex.KvUnlock(ctx, r.edgegw.GetID())
 	}
 
@@ -323,7 +352,7 @@ func (r *networkRoutedResource) Delete(ctx context.Context, req resource.DeleteR
 	}
 
 	if err := orgNetwork.Delete(); err != nil {
-		resp.Diagnostics.AddError("Error deleting routing network", err.Error())
+		cerrs.AddError(&resp.Diagnostics, cerrs.ActionDelete, "routing network", err)
 	}
 }
 
@@ -383,6 +412,35 @@ func (r *networkRoutedResource) ImportState(ctx context.Context, req resource.Im
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("edge_gateway_name"), r.edgegw.GetName())...)
 }
 
+// IdentitySpec returns the identity of the resource.
+// The network is imported by its name, which can be updated.
+func (r *networkRoutedResource) IdentitySpec() identity.Spec {
+	return identity.Spec{
+		Attributes: []identity.Attribute{
+			{
+				Name:        "edge_gateway_id",
+				Description: "The ID of the edge gateway.",
+			},
+			{
+				Name:        "name",
+				Description: "The name of the routed network.",
+			},
+		},
+		Mutable: true,
+	}
+}
+
+// MoveState returns the state movers from the equivalent resources of the VMware vcd provider.
+func (r *networkRoutedResource) MoveState(_ context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		movestate.FromVCD("vcd_network_routed_v2", movestate.Attributes{
+			"id":              "id",
+			"name":            "name",
+			"edge_gateway_id": "edge_gateway_id",
+		}),
+	}
+}
+
 func (r *networkRoutedResource) read(ctx context.Context, planOrState *RoutedModel) (stateRefreshed *RoutedModel, found bool, diags diag.Diagnostics) {
 	stateRefreshed = planOrState.Copy()
 
@@ -31,13 +31,15 @@ import (
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/metrics"
 	cerrs "github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/errors"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/identity"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &CertificateLibraryResource{}
-	_ resource.ResourceWithConfigure   = &CertificateLibraryResource{}
-	_ resource.ResourceWithImportState = &CertificateLibraryResource{}
+	_ resource.Resource                 = &CertificateLibraryResource{}
+	_ resource.ResourceWithConfigure    = &CertificateLibraryResource{}
+	_ resource.ResourceWithImportState  = &CertificateLibraryResource{}
+	_ identity.ResourceWithIdentitySpec = &CertificateLibraryResource{}
 )
 
 // NewCertificateLibraryResource is a helper function to simplify the provider implementation.
@@ -286,6 +288,18 @@ func (r *CertificateLibraryResource) ImportState(ctx context.Context, req resour
 	resp.Diagnostics.Append(resp.State.Set(ctx, stateRefreshed)...)
 }
 
+// IdentitySpec returns the identity of the resource.
+func (r *CertificateLibraryResource) IdentitySpec() identity.Spec {
+	return identity.Spec{
+		Attributes: []identity.Attribute{
+			{
+				Name:        "id",
+				Description: "The ID of the certificate.",
+			},
+		},
+	}
+}
+
 // * CustomFuncs
 
 // read is a generic read function that can be used by the resource Create, Read and Update functions.
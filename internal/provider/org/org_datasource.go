@@ -30,6 +30,7 @@ import (
 	"github.com/orange-cloudavenue/cloudavenue-sdk-go/v1/org"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/metrics"
+	cerrs "github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/errors"
 )
 
 var (
@@ -52,7 +53,7 @@ func (d *OrgDataSource) Init(_ context.Context, _ *OrgModel) (diags diag.Diagnos
 
 	d.org, err = org.NewClient()
 	if err != nil {
-		diags.AddError("Error creating org client", err.Error())
+		cerrs.AddError(&diags, cerrs.ActionCreate, "org client", err)
 		return diags
 	}
 	return diags
@@ -32,13 +32,15 @@ import (
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/metrics"
 	cerrs "github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/errors"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/identity"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &OrgResource{}
-	_ resource.ResourceWithConfigure   = &OrgResource{}
-	_ resource.ResourceWithImportState = &OrgResource{}
+	_ resource.Resource                 = &OrgResource{}
+	_ resource.ResourceWithConfigure    = &OrgResource{}
+	_ resource.ResourceWithImportState  = &OrgResource{}
+	_ identity.ResourceWithIdentitySpec = &OrgResource{}
 )
 
 // NewOrgResource is a helper function to simplify the provider implementation.
@@ -58,7 +60,7 @@ func (r *OrgResource) Init(_ context.Context, _ *OrgModel) (diags diag.Diagnosti
 
 	r.org, err = org.NewClient()
 	if err != nil {
-		diags.AddError("Error creating org client", err.Error())
+		cerrs.AddError(&diags, cerrs.ActionCreate, "org client", err)
 		return diags
 	}
 	return diags
@@ -196,7 +198,7 @@ func (r *OrgResource) Update(ctx context.Context, req resource.UpdateRequest, re
 
 	job, err := r.org.UpdateProperties(ctx, reqP)
 	if err != nil {
-		resp.Diagnostics.AddError("Error updating properties", err.Error())
+		cerrs.AddError(&resp.Diagnostics, cerrs.ActionUpdate, "properties", err)
 		return
 	}
 
@@ -279,6 +281,12 @@ func (r *OrgResource) ImportState(ctx context.Context, _ resource.ImportStateReq
 	resp.Diagnostics.Append(resp.State.Set(ctx, stateRefreshed)...)
 }
 
+// IdentitySpec returns the identity of the resource.
+// The organization is the one of the provider, it has no other attribute.
+func (r *OrgResource) IdentitySpec() identity.Spec {
+	return identity.Spec{}
+}
+
 // * CustomFuncs
 
 // read is a generic read function that can be used by the resource Create, Read and Update functions.
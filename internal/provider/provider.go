@@ -22,7 +22,13 @@ package provider
 import (
 	"context"
 	"errors"
+	"os"
+	"strings"
 
+	"github.com/aws/aws-sdk-go/service/s3"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
@@ -34,11 +40,17 @@ import (
 	clientnetbackup "github.com/orange-cloudavenue/cloudavenue-sdk-go/pkg/clients/netbackup"
 	caverrors "github.com/orange-cloudavenue/cloudavenue-sdk-go/pkg/errors"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/metrics"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/mutex"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ provider.Provider = &cloudavenueProvider{}
+	_ provider.Provider                       = &cloudavenueProvider{}
+	_ provider.ProviderWithEphemeralResources = &cloudavenueProvider{}
+	_ provider.ProviderWithFunctions          = &cloudavenueProvider{}
+	_ provider.ProviderWithListResources      = &cloudavenueProvider{}
+	_ provider.ProviderWithActions            = &cloudavenueProvider{}
 )
 
 // cloudavenueProvider is the provider implementation.
@@ -51,6 +63,18 @@ type cloudavenueProvider struct {
 
 const providerSubsystem = "provider"
 
+// httpSubsystem is the tflog subsystem of the HTTP exchanges with the API,
+// logged at the TRACE level. Its level can be set with the
+// TF_LOG_PROVIDER_CLOUDAVENUE_HTTP environment variable.
+const httpSubsystem = "http"
+
+const (
+	// envAPIToken is the environment variable used to set the API token.
+	envAPIToken = "CLOUDAVENUE_API_TOKEN"
+	// envPassword is the environment variable used to set the password.
+	envPassword = "CLOUDAVENUE_PASSWORD"
+)
+
 // New is a helper function to simplify provider server and testing implementation.
 func New(version string) func() provider.Provider {
 	return func() provider.Provider {
@@ -79,14 +103,82 @@ func (p *cloudavenueProvider) Configure(ctx context.Context, req provider.Config
 		return
 	}
 
+	// Fill the unset settings with the values of the profile, if any.
+	if profileName := providerProfileName(config, os.Getenv); profileName != "" {
+		profileFile, err := providerProfileFile(config, os.Getenv)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("profile_file"), "Unable to locate profile file", err.Error())
+			return
+		}
+
+		profile, err := loadProviderProfile(profileFile, profileName)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("profile"), "Unable to load profile", err.Error())
+			return
+		}
+		profile.applyTo(&config, os.Getenv)
+	}
+
+	// The API token can be provided by the environment variable.
+	apiToken, d := providerAPIToken(config, os.Getenv)
+	config.APIToken = apiToken
+
 	ctx = providerLogContext(ctx, config)
 	if tflog.IsDebug(ctx) {
 		tflog.SubsystemDebug(ctx, providerSubsystem, "Configuring provider")
 	}
 
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		tflog.SubsystemError(ctx, providerSubsystem, "Provider configuration has conflicting authentication methods")
+		return
+	}
+
+	retryPolicy, d := providerRetryPolicy(ctx, config)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readOnly, d := providerReadOnly(config, os.Getenv)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tlsConfig, d := providerTLSConfig(config, os.Getenv)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	lockTimeout, d := providerLockTimeout(config)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	mutex.SetTimeout(lockTimeout)
+
+	metricsConfig, d := providerMetricsConfig(ctx, config, os.Getenv)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := metrics.Configure(metricsConfig); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("metrics"), "Unable to configure metrics", err.Error())
+		return
+	}
+
 	cloudAvenue := client.CloudAvenue{
 		// This is a new SDK Cloudavenue
-		CAVSDKOpts: providerClientOpts(config),
+		CAVSDKOpts:     providerClientOpts(config),
+		RetryPolicy:    retryPolicy,
+		ThrottlePolicy: providerThrottlePolicy(config),
+		HTTPLogger:     providerHTTPLogger(ctx, config),
+		ReadOnly:       readOnly,
+		S3Endpoint:     providerS3URL(config, os.Getenv),
+		TLSConfig:      tlsConfig,
 	}
 
 	// Note: config.CoreAPI (CLOUDAVENUE_CORE_API) contains the Cloud Avenue API endpoint
@@ -119,14 +211,27 @@ func (p *cloudavenueProvider) Configure(ctx context.Context, req provider.Config
 		return
 	}
 
+	lockBackend, d := providerLockBackend(ctx, config, func() *s3.S3 { return cA.S3().S3 })
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	mutex.GlobalMutex.SetBackend(lockBackend)
+
 	if tflog.IsDebug(ctx) {
 		tflog.SubsystemDebug(ctx, providerSubsystem, "Provider client configured")
 	}
+	if readOnly {
+		tflog.SubsystemInfo(ctx, providerSubsystem, "Provider is read-only, the changes of the resources are rejected")
+	}
 
-	// Make the CloudAvenue client available during DataSource and Resource
-	// type Configure methods.
+	// Make the CloudAvenue client available during DataSource, Resource,
+	// EphemeralResource, ListResource and Action type Configure methods.
 	resp.DataSourceData = cA
 	resp.ResourceData = cA
+	resp.EphemeralResourceData = cA
+	resp.ListResourceData = cA
+	resp.ActionData = cA
 }
 
 func emptyOrValue(value basetypes.StringValue) string {
@@ -148,12 +253,76 @@ func providerLogContext(ctx context.Context, config cloudavenueProviderModel) co
 	ctx = tflog.SetField(ctx, "core_api", loggableString(config.CoreAPI))
 	ctx = tflog.SetField(ctx, "org", loggableString(config.Org))
 	ctx = tflog.SetField(ctx, "vdc", loggableString(config.VDC))
+	ctx = tflog.SetField(ctx, "auth_method", providerAuthMethod(config))
+	ctx = tflog.SetField(ctx, "profile", loggableString(config.Profile))
 
 	return tflog.NewSubsystem(ctx, providerSubsystem, tflog.WithRootFields())
 }
 
+// providerHTTPLogger returns the logger of the HTTP exchanges with the API.
+// The credentials of the provider configuration are masked in addition to
+// the redaction done by the logger.
+func providerHTTPLogger(ctx context.Context, config cloudavenueProviderModel) *client.HTTPLogger {
+	ctx = tflog.NewSubsystem(ctx, httpSubsystem, tflog.WithLevelFromEnv("TF_LOG_PROVIDER_CLOUDAVENUE", strings.ToUpper(httpSubsystem)))
+
+	for _, secret := range []types.String{config.Password, config.APIToken, config.NetBackupPassword} {
+		if v := emptyOrValue(secret); v != "" {
+			ctx = tflog.SubsystemMaskAllFieldValuesStrings(ctx, httpSubsystem, v)
+		}
+	}
+
+	return &client.HTTPLogger{
+		Context:   ctx,
+		Subsystem: httpSubsystem,
+	}
+}
+
+// providerAPIToken returns the API token, or null to authenticate with the
+// password. The api_token and password attributes (or the profile) take
+// precedence over the CLOUDAVENUE_API_TOKEN and CLOUDAVENUE_PASSWORD
+// environment variables, which are only read when neither attribute is set.
+// Both methods set at the same level conflict.
+func providerAPIToken(config cloudavenueProviderModel, getenv func(string) string) (types.String, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	switch token, password := emptyOrValue(config.APIToken), emptyOrValue(config.Password); {
+	case token != "" && password != "":
+		diags.AddAttributeError(
+			path.Root("api_token"),
+			"Conflicting authentication methods",
+			"Only one of api_token or password can be set.",
+		)
+		return types.StringNull(), diags
+	case token != "":
+		return config.APIToken, diags
+	case password != "":
+		return types.StringNull(), diags
+	}
+
+	token := getenv(envAPIToken)
+	if token == "" {
+		return types.StringNull(), diags
+	}
+	if getenv(envPassword) != "" {
+		diags.AddError(
+			"Conflicting authentication methods",
+			"Only one of "+envAPIToken+" or "+envPassword+" can be set when neither api_token nor password is set in the provider configuration.",
+		)
+		return types.StringNull(), diags
+	}
+	return types.StringValue(token), diags
+}
+
+// providerAuthMethod returns the authentication method used by the provider.
+func providerAuthMethod(config cloudavenueProviderModel) string {
+	if emptyOrValue(config.APIToken) != "" {
+		return "api_token"
+	}
+	return "password"
+}
+
 func providerClientOpts(config cloudavenueProviderModel) *casdk.ClientOpts {
-	return &casdk.ClientOpts{
+	opts := &casdk.ClientOpts{
 		Netbackup: &clientnetbackup.Opts{
 			Endpoint: emptyOrValue(config.NetBackupURL),
 			Username: emptyOrValue(config.NetBackupUser),
@@ -163,11 +332,19 @@ func providerClientOpts(config cloudavenueProviderModel) *casdk.ClientOpts {
 			URL:      emptyOrValue(config.URL),
 			CoreAPI:  emptyOrValue(config.CoreAPI),
 			Username: emptyOrValue(config.User),
-			Password: emptyOrValue(config.Password),
 			Org:      emptyOrValue(config.Org),
 			VDC:      emptyOrValue(config.VDC),
 		},
 	}
+
+	// The API token and the password authentication are mutually exclusive.
+	if providerAuthMethod(config) == "api_token" {
+		opts.CloudAvenue.APIToken = emptyOrValue(config.APIToken)
+	} else {
+		opts.CloudAvenue.Password = emptyOrValue(config.Password)
+	}
+
+	return opts
 }
 
 // All methods below are commented out because they are not implemented yet.
@@ -180,18 +357,6 @@ func providerClientOpts(config cloudavenueProviderModel) *casdk.ClientOpts {
 //
 // // ! tfprotov6.ProviderServer interface methods
 // //
-// // GetResourceIdentitySchemas returns the schemas for the provider's resource identity types.
-// func (p *cloudavenueProvider) GetResourceIdentitySchemas(_ context.Context, req *tfprotov6.GetResourceIdentitySchemasRequest) (*tfprotov6.GetResourceIdentitySchemasResponse, error) {
-// 	resp := &tfprotov6.GetResourceIdentitySchemasResponse{}
-// 	return resp, nil
-// }
-
-// // UpgradeResourceIdentity upgrades the resource identity type for the provider's resources.
-// func (p *cloudavenueProvider) UpgradeResourceIdentity(_ context.Context, req *tfprotov6.UpgradeResourceIdentityRequest) (*tfprotov6.UpgradeResourceIdentityResponse, error) {
-// 	resp := &tfprotov6.UpgradeResourceIdentityResponse{}
-// 	return resp, nil
-// }
-
 // // ApplyResourceChange applies the changes to the provider's resources.
 // func (p *cloudavenueProvider) ApplyResourceChange(_ context.Context, req *tfprotov6.ApplyResourceChangeRequest) (*tfprotov6.ApplyResourceChangeResponse, error) {
 // 	resp := &tfprotov6.ApplyResourceChangeResponse{}
@@ -204,42 +369,18 @@ func providerClientOpts(config cloudavenueProviderModel) *casdk.ClientOpts {
 // 	return resp, nil
 // }
 
-// // CallFunction is a no-op for the provider.
-// func (p *cloudavenueProvider) CallFunction(_ context.Context, req *tfprotov6.CallFunctionRequest) (*tfprotov6.CallFunctionResponse, error) {
-// 	resp := &tfprotov6.CallFunctionResponse{}
-// 	return resp, nil
-// }
-
 // // MoveResourceState moves the state of the provider's resources.
 // func (p *cloudavenueProvider) MoveResourceState(_ context.Context, req *tfprotov6.MoveResourceStateRequest) (*tfprotov6.MoveResourceStateResponse, error) {
 // 	resp := &tfprotov6.MoveResourceStateResponse{}
 // 	return resp, nil
 // }
 
-// // CloseEphemeralResource closes the ephemeral resource for the provider.
-// func (p *cloudavenueProvider) CloseEphemeralResource(_ context.Context, req *tfprotov6.CloseEphemeralResourceRequest) (*tfprotov6.CloseEphemeralResourceResponse, error) {
-// 	resp := &tfprotov6.CloseEphemeralResourceResponse{}
-// 	return resp, nil
-// }
-
-// // OpenEphemeralResource opens the ephemeral resource for the provider.
-// func (p *cloudavenueProvider) OpenEphemeralResource(_ context.Context, req *tfprotov6.OpenEphemeralResourceRequest) (*tfprotov6.OpenEphemeralResourceResponse, error) {
-// 	resp := &tfprotov6.OpenEphemeralResourceResponse{}
-// 	return resp, nil
-// }
-
 // // ConfigureProvider configures the provider with the given configuration.
 // func (p *cloudavenueProvider) ConfigureProvider(ctx context.Context, req *tfprotov6.ConfigureProviderRequest) (*tfprotov6.ConfigureProviderResponse, error) {
 // 	resp := &tfprotov6.ConfigureProviderResponse{}
 // 	return resp, nil
 // }
 
-// // GetFunction gets the function for the provider.
-// func (p *cloudavenueProvider) GetFunctions(_ context.Context, req *tfprotov6.GetFunctionsRequest) (*tfprotov6.GetFunctionsResponse, error) {
-// 	resp := &tfprotov6.GetFunctionsResponse{}
-// 	return resp, nil
-// }
-
 // // PlanResourceChange plans the changes to the provider's resources.
 // func (p *cloudavenueProvider) PlanResourceChange(_ context.Context, req *tfprotov6.PlanResourceChangeRequest) (*tfprotov6.PlanResourceChangeResponse, error) {
 // 	resp := &tfprotov6.PlanResourceChangeResponse{}
@@ -288,23 +429,11 @@ func providerClientOpts(config cloudavenueProviderModel) *casdk.ClientOpts {
 // 	return resp, nil
 // }
 
-// // RenewEphemeralResource renews the ephemeral resource for the provider.
-// func (p *cloudavenueProvider) RenewEphemeralResource(_ context.Context, req *tfprotov6.RenewEphemeralResourceRequest) (*tfprotov6.RenewEphemeralResourceResponse, error) {
-// 	resp := &tfprotov6.RenewEphemeralResourceResponse{}
-// 	return resp, nil
-// }
-
 // // StopProvider stops the provider.
 // func (p *cloudavenueProvider) StopProvider(_ context.Context, req *tfprotov6.StopProviderRequest) (*tfprotov6.StopProviderResponse, error) {
 // 	return nil, status.Errorf(codes.Unimplemented, "method Stop not implemented")
 // }
 
-// // ValidateEphemeralResourceConfig validates the configuration of the ephemeral resource for the provider.
-// func (p *cloudavenueProvider) ValidateEphemeralResourceConfig(_ context.Context, req *tfprotov6.ValidateEphemeralResourceConfigRequest) (*tfprotov6.ValidateEphemeralResourceConfigResponse, error) {
-// 	resp := &tfprotov6.ValidateEphemeralResourceConfigResponse{}
-// 	return resp, nil
-// }
-
 // // ValidateProviderConfig validates the configuration of the provider.
 // func (p *cloudavenueProvider) ValidateProviderConfig(_ context.Context, req *tfprotov6.ValidateProviderConfigRequest) (*tfprotov6.ValidateProviderConfigResponse, error) {
 // 	resp := &tfprotov6.ValidateProviderConfigResponse{}
@@ -0,0 +1,30 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/vapp"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/vm"
+)
+
+// Actions defines the actions implemented in the provider.
+func (p *cloudavenueProvider) Actions(_ context.Context) []func() action.Action {
+	return []func() action.Action{
+		// * vApp
+		vapp.NewVAppPowerAction,
+
+		// * VM
+		vm.NewVMPowerAction,
+	}
+}
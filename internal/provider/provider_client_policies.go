@@ -0,0 +1,113 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/mutex"
+)
+
+// providerThrottlePolicy returns the rate limits defined in the provider
+// configuration.
+func providerThrottlePolicy(config cloudavenueProviderModel) *client.ThrottlePolicy {
+	policy := &client.ThrottlePolicy{}
+
+	if !config.MaxConcurrentRequests.IsNull() && !config.MaxConcurrentRequests.IsUnknown() {
+		policy.MaxConcurrentRequests = int(config.MaxConcurrentRequests.ValueInt64())
+	}
+
+	if !config.RequestsPerSecond.IsNull() && !config.RequestsPerSecond.IsUnknown() {
+		policy.RequestsPerSecond = config.RequestsPerSecond.ValueFloat64()
+	}
+
+	return policy
+}
+
+// providerLockTimeout returns the maximum time an operation waits for the lock
+// of an object, defined by the lock_timeout attribute of the provider
+// configuration. It returns 0, no timeout, when lock_timeout is not set.
+func providerLockTimeout(config cloudavenueProviderModel) (time.Duration, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if d, ok := providerRetryDuration(config.LockTimeout, path.Root("lock_timeout"), &diags); ok {
+		return d, diags
+	}
+	return 0, diags
+}
+
+// providerRetryPolicy returns the retry policy defined by the retry block of
+// the provider configuration. The unset attributes keep their default value.
+func providerRetryPolicy(ctx context.Context, config cloudavenueProviderModel) (*client.RetryPolicy, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	policy := client.DefaultRetryPolicy()
+	if config.Retry == nil {
+		return policy, diags
+	}
+
+	if !config.Retry.MaxAttempts.IsNull() && !config.Retry.MaxAttempts.IsUnknown() {
+		policy.MaxAttempts = int(config.Retry.MaxAttempts.ValueInt64())
+	}
+
+	if d, ok := providerRetryDuration(config.Retry.MinBackoff, path.Root("retry").AtName("min_backoff"), &diags); ok {
+		policy.MinBackoff = d
+	}
+
+	if d, ok := providerRetryDuration(config.Retry.MaxBackoff, path.Root("retry").AtName("max_backoff"), &diags); ok {
+		policy.MaxBackoff = d
+	}
+
+	if policy.MinBackoff > policy.MaxBackoff {
+		diags.AddAttributeError(
+			path.Root("retry").AtName("min_backoff"),
+			"Invalid retry backoff",
+			"min_backoff ("+policy.MinBackoff.String()+") must be lower than or equal to max_backoff ("+policy.MaxBackoff.String()+").",
+		)
+	}
+
+	if !config.Retry.RetryableStatusCodes.IsNull() && !config.Retry.RetryableStatusCodes.IsUnknown() {
+		codes := make([]int64, 0, len(config.Retry.RetryableStatusCodes.Elements()))
+		diags.Append(config.Retry.RetryableStatusCodes.ElementsAs(ctx, &codes, false)...)
+
+		policy.RetryableStatusCodes = make([]int, 0, len(codes))
+		for _, code := range codes {
+			policy.RetryableStatusCodes = append(policy.RetryableStatusCodes, int(code))
+		}
+	}
+
+	if !config.Retry.RetryOnBusyEntity.IsNull() && !config.Retry.RetryOnBusyEntity.IsUnknown() {
+		policy.RetryOnBusyEntity = config.Retry.RetryOnBusyEntity.ValueBool()
+	}
+
+	return policy, diags
+}
+
+// providerRetryDuration parses a duration attribute of the provider
+// configuration.
+// It returns false if the attribute is unset or invalid.
+func providerRetryDuration(value types.String, p path.Path, diags *diag.Diagnostics) (time.Duration, bool) {
+	if value.IsNull() || value.IsUnknown() {
+		return 0, false
+	}
+
+	d, err := time.ParseDuration(value.ValueString())
+	if err != nil {
+		diags.AddAttributeError(p, "Invalid duration", err.Error())
+		return 0, false
+	}
+	return d, true
+}
@@ -0,0 +1,30 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/iam"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/s3"
+)
+
+// EphemeralResources defines the ephemeral resources implemented in the provider.
+func (p *cloudavenueProvider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		// * IAM
+		iam.NewTokenEphemeralResource,
+
+		// * S3
+		s3.NewCredentialEphemeralResource,
+	}
+}
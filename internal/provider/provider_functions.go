@@ -0,0 +1,38 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/functions"
+)
+
+// Functions defines the provider-defined functions implemented in the provider.
+func (p *cloudavenueProvider) Functions(_ context.Context) []func() function.Function {
+	return []func() function.Function{
+		// * URN
+		functions.NewURNParseFunction,
+		functions.NewURNTypeFunction,
+		functions.NewURNBuildFunction,
+		functions.NewURNIsValidFunction,
+		functions.NewExtractUUIDFunction,
+
+		// * EdgeGateway
+		functions.NewEdgeGatewayAllowedBandwidthsFunction,
+		functions.NewEdgeGatewayMaxBandwidthFunction,
+
+		// * VDC
+		functions.NewVDCValidateFunction,
+		functions.NewVDCLimitsFunction,
+	}
+}
@@ -0,0 +1,52 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/catalog"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/edgegw"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/network"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/s3"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/vapp"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/vdc"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/vm"
+)
+
+// ListResources defines the list resources implemented in the provider.
+func (p *cloudavenueProvider) ListResources(_ context.Context) []func() list.ListResource {
+	return []func() list.ListResource{
+		// * Catalog
+		catalog.NewCatalogListResource,
+
+		// * EdgeGateway
+		edgegw.NewEdgeGatewayListResource,
+		edgegw.NewFirewallListResource,
+		edgegw.NewNATRuleListResource,
+
+		// * Network
+		network.NewNetworkRoutedListResource,
+
+		// * S3
+		s3.NewBucketListResource,
+
+		// * vApp
+		vapp.NewVappListResource,
+
+		// * VDC
+		vdc.NewVDCListResource,
+
+		// * VM
+		vm.NewVMListResource,
+	}
+}
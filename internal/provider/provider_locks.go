@@ -0,0 +1,87 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package provider
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/service/s3"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/mutex"
+)
+
+// defaultLockPrefix is the default prefix of the lock objects in the S3
+// bucket.
+const defaultLockPrefix = "terraform-provider-cloudavenue/locks/"
+
+// providerLockBackend returns the backend defined by the lock_backend block
+// of the provider configuration, or nil without this block. The S3 client is
+// only requested by the s3 backend.
+func providerLockBackend(ctx context.Context, config cloudavenueProviderModel, s3Client func() *s3.S3) (mutex.Backend, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if config.LockBackend == nil {
+		return nil, diags
+	}
+
+	ttl := mutex.DefaultLockTTL
+	if d, ok := providerRetryDuration(config.LockBackend.TTL, path.Root("lock_backend").AtName("ttl"), &diags); ok {
+		ttl = d
+	}
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	switch mutex.BackendType(config.LockBackend.Type.ValueString()) {
+	case mutex.BackendFile:
+		if config.LockBackend.Directory.ValueString() == "" {
+			diags.AddAttributeError(
+				path.Root("lock_backend").AtName("directory"),
+				"Missing lock directory",
+				"directory is required when the lock backend type is file.",
+			)
+			return nil, diags
+		}
+
+		backend, err := mutex.NewFileBackend(config.LockBackend.Directory.ValueString(), ttl)
+		if err != nil {
+			diags.AddAttributeError(path.Root("lock_backend").AtName("directory"), "Unable to configure the lock backend", err.Error())
+			return nil, diags
+		}
+		return backend, diags
+
+	case mutex.BackendS3:
+		if config.LockBackend.Bucket.ValueString() == "" {
+			diags.AddAttributeError(
+				path.Root("lock_backend").AtName("bucket"),
+				"Missing lock bucket",
+				"bucket is required when the lock backend type is s3.",
+			)
+			return nil, diags
+		}
+
+		prefix := defaultLockPrefix
+		if !config.LockBackend.Prefix.IsNull() && !config.LockBackend.Prefix.IsUnknown() {
+			prefix = config.LockBackend.Prefix.ValueString()
+		}
+
+		backend, err := mutex.NewS3Backend(ctx, s3Client(), config.LockBackend.Bucket.ValueString(), prefix, ttl)
+		if err != nil {
+			diags.AddAttributeError(path.Root("lock_backend"), "Unable to configure the lock backend", err.Error())
+			return nil, diags
+		}
+		return backend, diags
+	}
+
+	return nil, diags
+}
@@ -0,0 +1,69 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/metrics"
+)
+
+// envOTLPEndpoint is the standard OpenTelemetry environment variable of the
+// OTLP collector endpoint.
+const envOTLPEndpoint = "OTEL_EXPORTER_OTLP_ENDPOINT"
+
+// providerMetricsConfig returns the metrics configuration defined by the
+// metrics block of the provider configuration.
+func providerMetricsConfig(ctx context.Context, config cloudavenueProviderModel, getenv func(string) string) (metrics.Config, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if config.Metrics == nil {
+		return metrics.Config{Sink: metrics.SinkAnalytics}, diags
+	}
+
+	cfg := metrics.Config{
+		Sink:         metrics.SinkType(config.Metrics.Sink.ValueString()),
+		FilePath:     config.Metrics.FilePath.ValueString(),
+		OTLPEndpoint: config.Metrics.OTLPEndpoint.ValueString(),
+	}
+
+	switch cfg.Sink {
+	case metrics.SinkFile:
+		if cfg.FilePath == "" {
+			diags.AddAttributeError(
+				path.Root("metrics").AtName("file_path"),
+				"Missing metrics file path",
+				"file_path is required when the metrics sink is file.",
+			)
+		}
+	case metrics.SinkOTLP:
+		if cfg.OTLPEndpoint == "" {
+			cfg.OTLPEndpoint = getenv(envOTLPEndpoint)
+		}
+		if cfg.OTLPEndpoint == "" {
+			diags.AddAttributeError(
+				path.Root("metrics").AtName("otlp_endpoint"),
+				"Missing OTLP endpoint",
+				"otlp_endpoint (or the "+envOTLPEndpoint+" environment variable) is required when the metrics sink is otlp.",
+			)
+		}
+
+		if !config.Metrics.OTLPHeaders.IsNull() && !config.Metrics.OTLPHeaders.IsUnknown() {
+			cfg.OTLPHeaders = map[string]string{}
+			diags.Append(config.Metrics.OTLPHeaders.ElementsAs(ctx, &cfg.OTLPHeaders, false)...)
+		}
+	case metrics.SinkAnalytics, metrics.SinkNone:
+	}
+
+	return cfg, diags
+}
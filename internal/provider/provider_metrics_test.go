@@ -0,0 +1,111 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/metrics"
+)
+
+func TestProviderMetricsConfig(t *testing.T) {
+	t.Parallel()
+
+	headers := types.MapValueMust(types.StringType, map[string]attr.Value{
+		"Authorization": types.StringValue("Bearer token"),
+	})
+
+	tests := []struct {
+		name    string
+		metrics *cloudavenueProviderMetricsModel
+		env     map[string]string
+		want    metrics.Config
+		wantErr bool
+	}{
+		{
+			name: "No metrics block",
+			want: metrics.Config{Sink: metrics.SinkAnalytics},
+		},
+		{
+			name:    "None",
+			metrics: &cloudavenueProviderMetricsModel{Sink: types.StringValue("none"), OTLPHeaders: types.MapNull(types.StringType)},
+			want:    metrics.Config{Sink: metrics.SinkNone},
+		},
+		{
+			name: "File",
+			metrics: &cloudavenueProviderMetricsModel{
+				Sink:        types.StringValue("file"),
+				FilePath:    types.StringValue("/tmp/metrics.jsonl"),
+				OTLPHeaders: types.MapNull(types.StringType),
+			},
+			want: metrics.Config{Sink: metrics.SinkFile, FilePath: "/tmp/metrics.jsonl"},
+		},
+		{
+			name:    "File without path",
+			metrics: &cloudavenueProviderMetricsModel{Sink: types.StringValue("file"), OTLPHeaders: types.MapNull(types.StringType)},
+			wantErr: true,
+		},
+		{
+			name: "OTLP",
+			metrics: &cloudavenueProviderMetricsModel{
+				Sink:         types.StringValue("otlp"),
+				OTLPEndpoint: types.StringValue("http://localhost:4318"),
+				OTLPHeaders:  headers,
+			},
+			want: metrics.Config{
+				Sink:         metrics.SinkOTLP,
+				OTLPEndpoint: "http://localhost:4318",
+				OTLPHeaders:  map[string]string{"Authorization": "Bearer token"},
+			},
+		},
+		{
+			name:    "OTLP endpoint from environment",
+			metrics: &cloudavenueProviderMetricsModel{Sink: types.StringValue("otlp"), OTLPHeaders: types.MapNull(types.StringType)},
+			env:     map[string]string{envOTLPEndpoint: "http://collector:4318"},
+			want:    metrics.Config{Sink: metrics.SinkOTLP, OTLPEndpoint: "http://collector:4318"},
+		},
+		{
+			name:    "OTLP without endpoint",
+			metrics: &cloudavenueProviderMetricsModel{Sink: types.StringValue("otlp"), OTLPHeaders: types.MapNull(types.StringType)},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			getenv := func(key string) string { return tt.env[key] }
+
+			got, diags := providerMetricsConfig(t.Context(), cloudavenueProviderModel{Metrics: tt.metrics}, getenv)
+			if diags.HasError() != tt.wantErr {
+				t.Fatalf("unexpected diagnostics: %+v", diags)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			if got.Sink != tt.want.Sink || got.FilePath != tt.want.FilePath || got.OTLPEndpoint != tt.want.OTLPEndpoint {
+				t.Errorf("providerMetricsConfig() = %+v, want %+v", got, tt.want)
+			}
+			if len(got.OTLPHeaders) != len(tt.want.OTLPHeaders) {
+				t.Fatalf("headers = %v, want %v", got.OTLPHeaders, tt.want.OTLPHeaders)
+			}
+			for k, v := range tt.want.OTLPHeaders {
+				if got.OTLPHeaders[k] != v {
+					t.Errorf("header %s = %q, want %q", k, got.OTLPHeaders[k], v)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,139 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	// envProfile is the environment variable used to set the profile name.
+	envProfile = "CLOUDAVENUE_PROFILE"
+	// envProfileFile is the environment variable used to set the profile file.
+	envProfileFile = "CLOUDAVENUE_PROFILE_FILE"
+
+	// defaultProfileFile is the default profile file, relative to the user home directory.
+	defaultProfileFile = ".cloudavenue/credentials"
+)
+
+// providerProfile is a named set of provider settings read from the profile file.
+//
+// The profile file is a YAML document where each top-level key is a profile name:
+//
+//	production:
+//	  org: cav01ev01ocb0001234
+//	  vdc: vdc-production
+//	  user: terraform
+//	  password: secret
+type providerProfile struct {
+	URL               string `yaml:"url"`
+	CoreAPI           string `yaml:"core_api"`
+	Org               string `yaml:"org"`
+	VDC               string `yaml:"vdc"`
+	User              string `yaml:"user"`
+	Password          string `yaml:"password"`
+	APIToken          string `yaml:"api_token"`
+	NetBackupURL      string `yaml:"netbackup_url"`
+	NetBackupUser     string `yaml:"netbackup_user"`
+	NetBackupPassword string `yaml:"netbackup_password"`
+}
+
+// providerProfileName returns the profile name from the provider configuration
+// or from the CLOUDAVENUE_PROFILE environment variable.
+func providerProfileName(config cloudavenueProviderModel, getenv func(string) string) string {
+	if v := emptyOrValue(config.Profile); v != "" {
+		return v
+	}
+	return getenv(envProfile)
+}
+
+// providerProfileFile returns the path of the profile file from the provider configuration,
+// the CLOUDAVENUE_PROFILE_FILE environment variable or the default location.
+func providerProfileFile(config cloudavenueProviderModel, getenv func(string) string) (string, error) {
+	if v := emptyOrValue(config.ProfileFile); v != "" {
+		return v, nil
+	}
+	if v := getenv(envProfileFile); v != "" {
+		return v, nil
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("unable to find the home directory: %w", err)
+	}
+	return filepath.Join(home, defaultProfileFile), nil
+}
+
+// loadProviderProfile reads the profile named name from the profile file.
+func loadProviderProfile(file, name string) (*providerProfile, error) {
+	content, err := os.ReadFile(file) //nolint:gosec // G304: the profile file is provided by the user on purpose
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, fmt.Errorf("profile file %s does not exist", file)
+		}
+		return nil, fmt.Errorf("unable to read profile file %s: %w", file, err)
+	}
+
+	profiles := make(map[string]*providerProfile)
+	if err := yaml.Unmarshal(content, &profiles); err != nil {
+		return nil, fmt.Errorf("unable to parse profile file %s: %w", file, err)
+	}
+
+	profile, ok := profiles[name]
+	if !ok || profile == nil {
+		names := make([]string, 0, len(profiles))
+		for n := range profiles {
+			names = append(names, n)
+		}
+		slices.Sort(names)
+		return nil, fmt.Errorf("profile %q not found in %s (available profiles: %s)", name, file, strings.Join(names, ", "))
+	}
+
+	return profile, nil
+}
+
+// applyTo fills the provider configuration with the profile values.
+// A profile value is only used when the setting is set neither in the provider
+// configuration nor in the corresponding environment variable.
+func (p *providerProfile) applyTo(config *cloudavenueProviderModel, getenv func(string) string) {
+	fill := func(attribute *types.String, env, value string) {
+		if emptyOrValue(*attribute) != "" || getenv(env) != "" || value == "" {
+			return
+		}
+		*attribute = types.StringValue(value)
+	}
+
+	fill(&config.URL, "CLOUDAVENUE_URL", p.URL)
+	fill(&config.CoreAPI, "CLOUDAVENUE_CORE_API", p.CoreAPI)
+	fill(&config.Org, "CLOUDAVENUE_ORG", p.Org)
+	fill(&config.VDC, "CLOUDAVENUE_VDC", p.VDC)
+	fill(&config.User, "CLOUDAVENUE_USERNAME", p.User)
+	fill(&config.NetBackupURL, "NETBACKUP_URL", p.NetBackupURL)
+	fill(&config.NetBackupUser, "NETBACKUP_USERNAME", p.NetBackupUser)
+	fill(&config.NetBackupPassword, "NETBACKUP_PASSWORD", p.NetBackupPassword)
+
+	// The authentication method is taken from the profile only if none is
+	// already defined, to avoid mixing a password and an API token.
+	if emptyOrValue(config.Password) != "" || getenv(envPassword) != "" ||
+		emptyOrValue(config.APIToken) != "" || getenv(envAPIToken) != "" {
+		return
+	}
+	fill(&config.Password, envPassword, p.Password)
+	fill(&config.APIToken, envAPIToken, p.APIToken)
+}
@@ -0,0 +1,178 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package provider
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const testProfileFile = `
+production:
+  org: cav01ev01ocb0000001
+  vdc: prod-vdc
+  user: prod-user
+  password: prod-password
+  url: https://prod.example.com
+staging:
+  org: cav01ev01ocb0000002
+  vdc: staging-vdc
+  api_token: staging-token
+`
+
+func writeTestProfileFile(t *testing.T) string {
+	t.Helper()
+
+	file := filepath.Join(t.TempDir(), "credentials")
+	if err := os.WriteFile(file, []byte(testProfileFile), 0o600); err != nil {
+		t.Fatalf("unable to write profile file: %s", err)
+	}
+	return file
+}
+
+func TestLoadProviderProfile(t *testing.T) {
+	t.Parallel()
+
+	file := writeTestProfileFile(t)
+
+	profile, err := loadProviderProfile(file, "production")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if profile.Org != "cav01ev01ocb0000001" || profile.VDC != "prod-vdc" || profile.User != "prod-user" {
+		t.Fatalf("unexpected profile content: %+v", profile)
+	}
+
+	if _, err := loadProviderProfile(file, "unknown"); err == nil {
+		t.Fatal("expected an error for an unknown profile")
+	}
+
+	if _, err := loadProviderProfile(filepath.Join(t.TempDir(), "missing"), "production"); err == nil {
+		t.Fatal("expected an error for a missing profile file")
+	}
+}
+
+func TestProviderProfilePrecedence(t *testing.T) {
+	t.Parallel()
+
+	profile, err := loadProviderProfile(writeTestProfileFile(t), "production")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	env := map[string]string{
+		"CLOUDAVENUE_USERNAME": "env-user",
+	}
+	getenv := func(key string) string { return env[key] }
+
+	config := cloudavenueProviderModel{
+		Org:      types.StringValue("cav01ev01ocb0009999"),
+		User:     types.StringNull(),
+		Password: types.StringNull(),
+		APIToken: types.StringNull(),
+		URL:      types.StringNull(),
+	}
+	profile.applyTo(&config, getenv)
+
+	// Explicit attribute wins over the profile.
+	if config.Org.ValueString() != "cav01ev01ocb0009999" {
+		t.Fatalf("expected org from the provider configuration, got %q", config.Org.ValueString())
+	}
+	// Environment variable wins over the profile (value is read later by the SDK).
+	if !config.User.IsNull() {
+		t.Fatalf("expected user to be left to the environment variable, got %q", config.User.ValueString())
+	}
+	// Profile fills the remaining settings.
+	if config.URL.ValueString() != "https://prod.example.com" {
+		t.Fatalf("expected url from the profile, got %q", config.URL.ValueString())
+	}
+	if config.Password.ValueString() != "prod-password" {
+		t.Fatalf("expected password from the profile, got %q", config.Password.ValueString())
+	}
+}
+
+func TestProviderProfileDoesNotMixAuthenticationMethods(t *testing.T) {
+	t.Parallel()
+
+	profile, err := loadProviderProfile(writeTestProfileFile(t), "staging")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	config := cloudavenueProviderModel{
+		Password: types.StringValue("explicit-password"),
+		APIToken: types.StringNull(),
+	}
+	profile.applyTo(&config, func(string) string { return "" })
+
+	if !config.APIToken.IsNull() {
+		t.Fatalf("expected api_token not to be read from the profile when a password is set, got %q", config.APIToken.ValueString())
+	}
+}
+
+func TestProviderProfileVDC(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		config types.String
+		env    string
+		want   types.String
+	}{
+		{
+			name:   "From the profile",
+			config: types.StringNull(),
+			want:   types.StringValue("staging-vdc"),
+		},
+		{
+			name:   "Attribute wins over the profile",
+			config: types.StringValue("explicit-vdc"),
+			want:   types.StringValue("explicit-vdc"),
+		},
+		{
+			name:   "Environment variable wins over the profile",
+			config: types.StringNull(),
+			env:    "env-vdc",
+			want:   types.StringNull(),
+		},
+	}
+
+	file := writeTestProfileFile(t)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			profile, err := loadProviderProfile(file, "staging")
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			config := cloudavenueProviderModel{
+				VDC:      tt.config,
+				Password: types.StringNull(),
+				APIToken: types.StringNull(),
+			}
+			profile.applyTo(&config, func(key string) string {
+				if key == "CLOUDAVENUE_VDC" {
+					return tt.env
+				}
+				return ""
+			})
+
+			if !config.VDC.Equal(tt.want) {
+				t.Fatalf("expected vdc %s, got %s", tt.want, config.VDC)
+			}
+		})
+	}
+}
@@ -0,0 +1,48 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package provider
+
+import (
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// envReadOnly is the environment variable used to make the provider
+// read-only.
+const envReadOnly = "CLOUDAVENUE_READ_ONLY"
+
+// providerReadOnly reports whether the provider is read-only. The read_only
+// attribute takes precedence over the CLOUDAVENUE_READ_ONLY environment
+// variable.
+func providerReadOnly(config cloudavenueProviderModel, getenv func(string) string) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if !config.ReadOnly.IsNull() && !config.ReadOnly.IsUnknown() {
+		return config.ReadOnly.ValueBool(), diags
+	}
+
+	v := getenv(envReadOnly)
+	if v == "" {
+		return false, diags
+	}
+
+	readOnly, err := strconv.ParseBool(v)
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("read_only"),
+			"Invalid "+envReadOnly+" environment variable",
+			envReadOnly+" must be a boolean (true or false), got "+strconv.Quote(v)+".",
+		)
+		return false, diags
+	}
+	return readOnly, diags
+}
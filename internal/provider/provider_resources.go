@@ -25,6 +25,8 @@ import (
 
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/backup"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/catalog"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/identity"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/readonly"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/edgegw"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/elb"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/iam"
@@ -40,8 +42,10 @@ import (
 )
 
 // Resources defines the resources implemented in the provider.
+// The resources are wrapped to reject their changes when the provider is
+// read-only and to expose their resource identity.
 func (p *cloudavenueProvider) Resources(_ context.Context) []func() resource.Resource {
-	return []func() resource.Resource{
+	return identity.Wrap(readonly.Wrap([]func() resource.Resource{
 		// * EdgeGateway
 		edgegw.NewEdgeGatewayResource,
 		edgegw.NewFirewallResource,
@@ -129,5 +133,5 @@ func (p *cloudavenueProvider) Resources(_ context.Context) []func() resource.Res
 		// * ORG
 		org.NewOrgResource,
 		org.NewCertificateLibraryResource,
-	}
+	}))
 }
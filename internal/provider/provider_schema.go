@@ -13,10 +13,18 @@ import (
 	"context"
 	"regexp"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/metrics"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/mutex"
 )
 
 func providerSchema(_ context.Context) schema.Schema {
@@ -48,10 +56,19 @@ func providerSchema(_ context.Context) schema.Schema {
 				Optional:            true,
 			},
 			"password": schema.StringAttribute{
-				MarkdownDescription: "The password to use to connect to the Cloud Avenue API. Can also be set with the `CLOUDAVENUE_PASSWORD` environment variable.",
+				MarkdownDescription: "The password to use to connect to the Cloud Avenue API. Can also be set with the `CLOUDAVENUE_PASSWORD` environment variable. Conflicts with `api_token`.",
 				Sensitive:           true,
 				Optional:            true,
 			},
+			"api_token": schema.StringAttribute{
+				MarkdownDescription: "The API token to use to connect to the Cloud Avenue API instead of `user`/`password`. API tokens can be generated with the `cloudavenue_iam_token` resource or ephemeral resource. Can also be set with the `CLOUDAVENUE_API_TOKEN` environment variable. Conflicts with `password`.",
+				Sensitive:           true,
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.ConflictsWith(path.MatchRoot("password")),
+				},
+			},
 			"org": schema.StringAttribute{
 				MarkdownDescription: "The organization used on Cloud Avenue API. Can also be set with the `CLOUDAVENUE_ORG` environment variable.",
 				Optional:            true,
@@ -80,6 +97,182 @@ func providerSchema(_ context.Context) schema.Schema {
 				Sensitive:           true,
 				Optional:            true,
 			},
+			"s3_url": schema.StringAttribute{
+				MarkdownDescription: "The URL of the S3 API, overriding the endpoint of the Cloud Avenue S3 service. Useful for the tests against an S3 compatible server. Can also be set with the `CLOUDAVENUE_S3_URL` environment variable.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(
+						regexp.MustCompile(`^https?:\/\/\S+\w$`),
+						"must be a valid URL (http or https) and not end with a trailing slash",
+					),
+				},
+			},
+			"ca_file": schema.StringAttribute{
+				MarkdownDescription: "The path of a PEM file of CA certificates trusted in addition to the system ones to connect to the VMware Cloud Director and S3 APIs. Can also be set with the `CLOUDAVENUE_CA_FILE` environment variable.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"insecure": schema.BoolAttribute{
+				MarkdownDescription: "Skip the verification of the certificates of the VMware Cloud Director and S3 APIs. Only for the tests, never use it in production. Can also be set with the `CLOUDAVENUE_INSECURE` environment variable. Defaults to `false`.",
+				Optional:            true,
+			},
+			"profile": schema.StringAttribute{
+				MarkdownDescription: "The name of the profile to read from the profile file. Settings of the profile are only used when they are set neither in the provider configuration nor in the environment variables. Can also be set with the `CLOUDAVENUE_PROFILE` environment variable.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"profile_file": schema.StringAttribute{
+				MarkdownDescription: "The path of the profile file. Defaults to `~/.cloudavenue/credentials`. Can also be set with the `CLOUDAVENUE_PROFILE_FILE` environment variable.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"max_concurrent_requests": schema.Int64Attribute{
+				MarkdownDescription: "The maximum number of concurrent requests sent to the Cloud Avenue API. Requests above this limit are queued. Unlimited if not set.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"requests_per_second": schema.Float64Attribute{
+				MarkdownDescription: "The maximum number of requests per second sent to the Cloud Avenue API. Requests above this limit are queued. Unlimited if not set.",
+				Optional:            true,
+				Validators: []validator.Float64{
+					float64validator.AtLeast(0.1),
+				},
+			},
+			"read_only": schema.BoolAttribute{
+				MarkdownDescription: "Make the provider read-only: the resources and the data sources can be read, but the creation, update and deletion of the resources, the actions and the ephemeral resources creating a token or a credential fail. Useful for the drift detection jobs using production credentials. Can also be set with the `CLOUDAVENUE_READ_ONLY` environment variable. Defaults to `false`.",
+				Optional:            true,
+			},
+			"lock_timeout": schema.StringAttribute{
+				MarkdownDescription: "The maximum time an operation waits for another operation on the same object (edge gateway, VDC group, vApp...) to complete, as a duration (e.g. `10m`, `1h`). The operation fails after this time instead of blocking the apply. By default, or when set to `0s`, the operation waits without limit and the wait is only aborted by the cancellation of Terraform.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(durationRegex, "must be a valid duration (e.g. 10m, 1h)"),
+				},
+			},
+			"lock_backend": schema.SingleNestedAttribute{
+				MarkdownDescription: "The backend sharing the locks of the edge gateways, VDC groups and networks with the other Terraform runs, so that several workspaces can change the same object concurrently. Without this block, the operations are only serialized within a Terraform run.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"type": schema.StringAttribute{
+						MarkdownDescription: "The type of backend. `file` stores the locks as files in a directory shared by the Terraform runs (e.g. a network file system) and `s3` stores them as objects in a Cloud Avenue S3 bucket.",
+						Required:            true,
+						Validators: []validator.String{
+							stringvalidator.OneOf(mutex.BackendTypesString()...),
+						},
+					},
+					"directory": schema.StringAttribute{
+						MarkdownDescription: "The directory of the lock files. Required if `type` is `file`.",
+						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+					},
+					"bucket": schema.StringAttribute{
+						MarkdownDescription: "The name of the S3 bucket of the lock objects. Required if `type` is `s3`.",
+						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+					},
+					"prefix": schema.StringAttribute{
+						MarkdownDescription: "The prefix of the lock objects in the S3 bucket. Defaults to `" + defaultLockPrefix + "`.",
+						Optional:            true,
+					},
+					"ttl": schema.StringAttribute{
+						MarkdownDescription: "The time after which a lock that is no longer refreshed by its owner, for example after a crash, is considered stale and can be taken by another Terraform run, as a duration (e.g. `5m`). Defaults to `5m`.",
+						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.RegexMatches(durationRegex, "must be a valid duration (e.g. 30s, 5m)"),
+						},
+					},
+				},
+			},
+			"retry": schema.SingleNestedAttribute{
+				MarkdownDescription: "The retry policy applied to the transient errors returned by the Cloud Avenue API (HTTP 429, 5xx and busy entities).",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"max_attempts": schema.Int64Attribute{
+						MarkdownDescription: "The maximum number of attempts of a request, including the first one. Set to `1` to disable the retries. Defaults to `5`.",
+						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.Between(1, 100),
+						},
+					},
+					"min_backoff": schema.StringAttribute{
+						MarkdownDescription: "The wait time before the first retry, as a duration (e.g. `500ms`, `2s`). The wait time doubles at each retry. Defaults to `1s`.",
+						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.RegexMatches(durationRegex, "must be a valid duration (e.g. 500ms, 2s, 1m)"),
+						},
+					},
+					"max_backoff": schema.StringAttribute{
+						MarkdownDescription: "The maximum wait time between two attempts, as a duration (e.g. `30s`, `1m`). Defaults to `30s`.",
+						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.RegexMatches(durationRegex, "must be a valid duration (e.g. 500ms, 2s, 1m)"),
+						},
+					},
+					"retryable_status_codes": schema.ListAttribute{
+						MarkdownDescription: "The HTTP status codes that are retried. Codes other than `429` and `503` are only retried for idempotent requests (`GET`, `HEAD`, `OPTIONS`, `PUT` and `DELETE`). Defaults to `[429, 500, 502, 503, 504]`.",
+						ElementType:         types.Int64Type,
+						Optional:            true,
+						Validators: []validator.List{
+							listvalidator.ValueInt64sAre(int64validator.Between(400, 599)),
+						},
+					},
+					"retry_on_busy_entity": schema.BoolAttribute{
+						MarkdownDescription: "Retry the requests rejected because the targeted entity is busy completing another operation. Defaults to `true`.",
+						Optional:            true,
+					},
+				},
+			},
+			"metrics": schema.SingleNestedAttribute{
+				MarkdownDescription: "The destination of the metrics of the provider. Each metric is the time to execute an action (create, read, update, delete, import...) on a resource. Without this block, the metrics are sent to the Cloud Avenue analytics service.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"sink": schema.StringAttribute{
+						MarkdownDescription: "The destination of the metrics. `analytics` sends them to the Cloud Avenue analytics service, `none` disables them, `file` appends them to a local file as JSON lines and `otlp` exports them to an OpenTelemetry collector with the OTLP/HTTP protocol.",
+						Required:            true,
+						Validators: []validator.String{
+							stringvalidator.OneOf(metrics.SinkTypesString()...),
+						},
+					},
+					"file_path": schema.StringAttribute{
+						MarkdownDescription: "The path of the file the metrics are appended to. Required if `sink` is `file`.",
+						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+					},
+					"otlp_endpoint": schema.StringAttribute{
+						MarkdownDescription: "The base URL of the OpenTelemetry collector (e.g. `http://localhost:4318`). The metrics are sent to its `/v1/metrics` path. Required if `sink` is `otlp`. Can also be set with the `OTEL_EXPORTER_OTLP_ENDPOINT` environment variable.",
+						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.RegexMatches(
+								regexp.MustCompile(`^https?:\/\/\S+$`),
+								"must be a valid URL (http or https)",
+							),
+						},
+					},
+					"otlp_headers": schema.MapAttribute{
+						MarkdownDescription: "The HTTP headers added to the requests sent to the OpenTelemetry collector, for example to authenticate.",
+						ElementType:         types.StringType,
+						Optional:            true,
+						Sensitive:           true,
+					},
+				},
+			},
 		},
 	}
 }
+
+// durationRegex matches the durations accepted by time.ParseDuration.
+var durationRegex = regexp.MustCompile(`^([0-9]+(\.[0-9]+)?(ns|us|µs|ms|s|m|h))+$`)
@@ -10,11 +10,23 @@
 package provider
 
 import (
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
+	"github.com/aws/aws-sdk-go/service/s3"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	fwprovider "github.com/hashicorp/terraform-plugin-framework/provider"
 	fwschema "github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/mutex"
 )
 
 func TestProviderSchema(t *testing.T) {
@@ -125,3 +137,371 @@ func TestProviderClientOptsKeepsCoreAPIEmptyWhenUnset(t *testing.T) {
 		t.Fatalf("expected url to remain unchanged, got %q", opts.CloudAvenue.URL)
 	}
 }
+
+func TestProviderClientOptsMapsAPIToken(t *testing.T) {
+	t.Parallel()
+
+	opts := providerClientOpts(cloudavenueProviderModel{
+		URL:      types.StringValue("https://vcd.example.com"),
+		APIToken: types.StringValue("test-api-token"),
+		Password: types.StringNull(),
+		Org:      types.StringValue("cav01ev01ocb0001234"),
+	})
+
+	if opts.CloudAvenue.APIToken != "test-api-token" {
+		t.Fatalf("expected api_token to map to SDK APIToken, got %q", opts.CloudAvenue.APIToken)
+	}
+
+	if opts.CloudAvenue.Password != "" {
+		t.Fatalf("expected empty password when api_token is set, got %q", opts.CloudAvenue.Password)
+	}
+}
+
+func TestProviderClientOptsIgnoresEmptyAPIToken(t *testing.T) {
+	t.Parallel()
+
+	opts := providerClientOpts(cloudavenueProviderModel{
+		User:     types.StringValue("test-user"),
+		Password: types.StringValue("test-password"),
+		APIToken: types.StringNull(),
+		Org:      types.StringValue("cav01ev01ocb0001234"),
+	})
+
+	if opts.CloudAvenue.APIToken != "" {
+		t.Fatalf("expected empty api_token when unset, got %q", opts.CloudAvenue.APIToken)
+	}
+
+	if opts.CloudAvenue.Password != "test-password" {
+		t.Fatalf("expected password to map to SDK password, got %q", opts.CloudAvenue.Password)
+	}
+}
+
+func TestProviderAPIToken(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		token    types.String
+		password types.String
+		env      map[string]string
+		want     types.String
+		wantErr  bool
+	}{
+		{name: "Unset", token: types.StringNull(), password: types.StringNull(), want: types.StringNull()},
+		{name: "Attribute", token: types.StringValue("attr"), password: types.StringNull(), want: types.StringValue("attr")},
+		{
+			name: "Attribute over environment variable", token: types.StringValue("attr"), password: types.StringNull(),
+			env: map[string]string{envAPIToken: "env"}, want: types.StringValue("attr"),
+		},
+		{
+			name: "Attribute with a password in the environment", token: types.StringValue("attr"), password: types.StringNull(),
+			env: map[string]string{envPassword: "secret"}, want: types.StringValue("attr"),
+		},
+		{
+			name: "Password attribute over environment variable", token: types.StringNull(), password: types.StringValue("secret"),
+			env: map[string]string{envAPIToken: "env"}, want: types.StringNull(),
+		},
+		{
+			name: "Environment variable", token: types.StringNull(), password: types.StringNull(),
+			env: map[string]string{envAPIToken: "env"}, want: types.StringValue("env"),
+		},
+		{name: "Both attributes", token: types.StringValue("attr"), password: types.StringValue("secret"), wantErr: true},
+		{
+			name: "Both environment variables", token: types.StringNull(), password: types.StringNull(),
+			env: map[string]string{envAPIToken: "env", envPassword: "secret"}, wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			getenv := func(key string) string { return tt.env[key] }
+
+			got, diags := providerAPIToken(cloudavenueProviderModel{APIToken: tt.token, Password: tt.password}, getenv)
+			if diags.HasError() != tt.wantErr {
+				t.Fatalf("unexpected diagnostics: %+v", diags)
+			}
+			if !tt.wantErr && !got.Equal(tt.want) {
+				t.Fatalf("expected api token %s, got %s", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestProviderRetryPolicyDefaults(t *testing.T) {
+	t.Parallel()
+
+	policy, diags := providerRetryPolicy(t.Context(), cloudavenueProviderModel{})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %+v", diags)
+	}
+
+	if policy.MaxAttempts != client.DefaultRetryPolicy().MaxAttempts {
+		t.Fatalf("expected default max attempts, got %d", policy.MaxAttempts)
+	}
+}
+
+func TestProviderRetryPolicyMapsRetryBlock(t *testing.T) {
+	t.Parallel()
+
+	policy, diags := providerRetryPolicy(t.Context(), cloudavenueProviderModel{
+		Retry: &cloudavenueProviderRetryModel{
+			MaxAttempts:          types.Int64Value(3),
+			MinBackoff:           types.StringValue("500ms"),
+			MaxBackoff:           types.StringNull(),
+			RetryableStatusCodes: types.ListValueMust(types.Int64Type, []attr.Value{types.Int64Value(429)}),
+			RetryOnBusyEntity:    types.BoolValue(false),
+		},
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %+v", diags)
+	}
+
+	if policy.MaxAttempts != 3 {
+		t.Fatalf("expected max attempts 3, got %d", policy.MaxAttempts)
+	}
+	if policy.MinBackoff != 500*time.Millisecond {
+		t.Fatalf("expected min backoff 500ms, got %s", policy.MinBackoff)
+	}
+	if policy.MaxBackoff != client.DefaultRetryPolicy().MaxBackoff {
+		t.Fatalf("expected default max backoff, got %s", policy.MaxBackoff)
+	}
+	if len(policy.RetryableStatusCodes) != 1 || policy.RetryableStatusCodes[0] != 429 {
+		t.Fatalf("expected retryable status codes [429], got %v", policy.RetryableStatusCodes)
+	}
+	if policy.RetryOnBusyEntity {
+		t.Fatal("expected retry on busy entity to be disabled")
+	}
+}
+
+func TestProviderRetryPolicyRejectsInvalidBackoff(t *testing.T) {
+	t.Parallel()
+
+	_, diags := providerRetryPolicy(t.Context(), cloudavenueProviderModel{
+		Retry: &cloudavenueProviderRetryModel{
+			MinBackoff: types.StringValue("1m"),
+			MaxBackoff: types.StringValue("10s"),
+		},
+	})
+	if !diags.HasError() {
+		t.Fatal("expected an error when min_backoff is greater than max_backoff")
+	}
+}
+
+func TestProviderLockTimeout(t *testing.T) {
+	t.Parallel()
+
+	timeout, diags := providerLockTimeout(cloudavenueProviderModel{LockTimeout: types.StringNull()})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %+v", diags)
+	}
+	if timeout != 0 {
+		t.Fatalf("expected no lock timeout by default, got %s", timeout)
+	}
+
+	timeout, diags = providerLockTimeout(cloudavenueProviderModel{LockTimeout: types.StringValue("10m")})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %+v", diags)
+	}
+	if timeout != 10*time.Minute {
+		t.Fatalf("expected lock timeout 10m, got %s", timeout)
+	}
+
+	timeout, diags = providerLockTimeout(cloudavenueProviderModel{LockTimeout: types.StringValue("0s")})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %+v", diags)
+	}
+	if timeout != 0 {
+		t.Fatalf("expected no lock timeout, got %s", timeout)
+	}
+}
+
+func TestProviderLockBackend(t *testing.T) {
+	t.Parallel()
+
+	noS3Client := func() *s3.S3 {
+		t.Fatal("unexpected S3 client request")
+		return nil
+	}
+
+	backend, diags := providerLockBackend(t.Context(), cloudavenueProviderModel{}, noS3Client)
+	if diags.HasError() || backend != nil {
+		t.Fatalf("expected no backend without lock_backend, got %v (%+v)", backend, diags)
+	}
+
+	backend, diags = providerLockBackend(t.Context(), cloudavenueProviderModel{
+		LockBackend: &cloudavenueProviderLockModel{
+			Type:      types.StringValue(string(mutex.BackendFile)),
+			Directory: types.StringValue(t.TempDir()),
+			TTL:       types.StringValue("2m"),
+		},
+	}, noS3Client)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %+v", diags)
+	}
+	if _, ok := backend.(*mutex.FileBackend); !ok {
+		t.Fatalf("expected a file backend, got %T", backend)
+	}
+
+	_, diags = providerLockBackend(t.Context(), cloudavenueProviderModel{
+		LockBackend: &cloudavenueProviderLockModel{
+			Type: types.StringValue(string(mutex.BackendFile)),
+		},
+	}, noS3Client)
+	if !diags.HasError() {
+		t.Fatal("expected an error without directory")
+	}
+
+	_, diags = providerLockBackend(t.Context(), cloudavenueProviderModel{
+		LockBackend: &cloudavenueProviderLockModel{
+			Type: types.StringValue(string(mutex.BackendS3)),
+		},
+	}, noS3Client)
+	if !diags.HasError() {
+		t.Fatal("expected an error without bucket")
+	}
+}
+
+func TestProviderReadOnly(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		value   types.Bool
+		env     string
+		want    bool
+		wantErr bool
+	}{
+		{name: "Default", value: types.BoolNull()},
+		{name: "Attribute", value: types.BoolValue(true), want: true},
+		{name: "Environment variable", value: types.BoolNull(), env: "true", want: true},
+		{name: "Attribute over environment variable", value: types.BoolValue(false), env: "true"},
+		{name: "Invalid environment variable", value: types.BoolNull(), env: "yes", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			getenv := func(key string) string {
+				if key == envReadOnly {
+					return tt.env
+				}
+				return ""
+			}
+
+			got, diags := providerReadOnly(cloudavenueProviderModel{ReadOnly: tt.value}, getenv)
+			if diags.HasError() != tt.wantErr {
+				t.Fatalf("unexpected diagnostics: %+v", diags)
+			}
+			if got != tt.want {
+				t.Fatalf("expected read only %t, got %t", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestProviderThrottlePolicy(t *testing.T) {
+	t.Parallel()
+
+	policy := providerThrottlePolicy(cloudavenueProviderModel{
+		MaxConcurrentRequests: types.Int64Value(4),
+		RequestsPerSecond:     types.Float64Value(2.5),
+	})
+
+	if policy.MaxConcurrentRequests != 4 {
+		t.Fatalf("expected max concurrent requests 4, got %d", policy.MaxConcurrentRequests)
+	}
+	if policy.RequestsPerSecond != 2.5 {
+		t.Fatalf("expected requests per second 2.5, got %f", policy.RequestsPerSecond)
+	}
+
+	policy = providerThrottlePolicy(cloudavenueProviderModel{
+		MaxConcurrentRequests: types.Int64Null(),
+		RequestsPerSecond:     types.Float64Null(),
+	})
+	if policy.MaxConcurrentRequests != 0 || policy.RequestsPerSecond != 0 {
+		t.Fatalf("expected no limit when unset, got %+v", policy)
+	}
+}
+
+func TestProviderS3URL(t *testing.T) {
+	t.Parallel()
+
+	getenv := func(key string) string {
+		if key == envS3URL {
+			return "https://s3.example.com"
+		}
+		return ""
+	}
+
+	if got := providerS3URL(cloudavenueProviderModel{S3URL: types.StringNull()}, func(string) string { return "" }); got != "" {
+		t.Fatalf("expected no S3 URL by default, got %s", got)
+	}
+	if got := providerS3URL(cloudavenueProviderModel{S3URL: types.StringNull()}, getenv); got != "https://s3.example.com" {
+		t.Fatalf("expected the S3 URL of the environment variable, got %s", got)
+	}
+	if got := providerS3URL(cloudavenueProviderModel{S3URL: types.StringValue("http://localhost:9000")}, getenv); got != "http://localhost:9000" {
+		t.Fatalf("expected the S3 URL of the attribute, got %s", got)
+	}
+}
+
+func TestProviderTLSConfig(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewTLSServer(http.NotFoundHandler())
+	defer server.Close()
+
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	if err := os.WriteFile(caFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	invalidFile := filepath.Join(t.TempDir(), "invalid.pem")
+	if err := os.WriteFile(invalidFile, []byte("not a certificate"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name         string
+		config       cloudavenueProviderModel
+		env          map[string]string
+		wantConfig   bool
+		wantInsecure bool
+		wantCA       bool
+		wantErr      bool
+	}{
+		{name: "Default", config: cloudavenueProviderModel{CAFile: types.StringNull(), Insecure: types.BoolNull()}},
+		{name: "CA file", config: cloudavenueProviderModel{CAFile: types.StringValue(caFile), Insecure: types.BoolNull()}, wantConfig: true, wantCA: true},
+		{name: "CA file environment variable", config: cloudavenueProviderModel{CAFile: types.StringNull(), Insecure: types.BoolNull()}, env: map[string]string{envCAFile: caFile}, wantConfig: true, wantCA: true},
+		{name: "Insecure", config: cloudavenueProviderModel{CAFile: types.StringNull(), Insecure: types.BoolValue(true)}, wantConfig: true, wantInsecure: true},
+		{name: "Insecure environment variable", config: cloudavenueProviderModel{CAFile: types.StringNull(), Insecure: types.BoolNull()}, env: map[string]string{envInsecure: "true"}, wantConfig: true, wantInsecure: true},
+		{name: "Attribute over environment variable", config: cloudavenueProviderModel{CAFile: types.StringNull(), Insecure: types.BoolValue(false)}, env: map[string]string{envInsecure: "true"}},
+		{name: "Invalid environment variable", config: cloudavenueProviderModel{CAFile: types.StringNull(), Insecure: types.BoolNull()}, env: map[string]string{envInsecure: "yes"}, wantErr: true},
+		{name: "Missing CA file", config: cloudavenueProviderModel{CAFile: types.StringValue(filepath.Join(t.TempDir(), "missing.pem")), Insecure: types.BoolNull()}, wantErr: true},
+		{name: "Invalid CA file", config: cloudavenueProviderModel{CAFile: types.StringValue(invalidFile), Insecure: types.BoolNull()}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := providerTLSConfig(tt.config, func(key string) string { return tt.env[key] })
+			if diags.HasError() != tt.wantErr {
+				t.Fatalf("unexpected diagnostics: %+v", diags)
+			}
+			if (got != nil) != tt.wantConfig {
+				t.Fatalf("expected a TLS configuration %t, got %+v", tt.wantConfig, got)
+			}
+			if got == nil {
+				return
+			}
+			if got.InsecureSkipVerify != tt.wantInsecure {
+				t.Fatalf("expected insecure %t, got %t", tt.wantInsecure, got.InsecureSkipVerify)
+			}
+			if (got.RootCAs != nil) != tt.wantCA {
+				t.Fatalf("expected root CAs %t, got %v", tt.wantCA, got.RootCAs)
+			}
+		})
+	}
+}
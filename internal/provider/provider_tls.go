@@ -0,0 +1,102 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package provider
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"os"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+const (
+	// envS3URL is the environment variable overriding the S3 endpoint.
+	envS3URL = "CLOUDAVENUE_S3_URL"
+	// envCAFile is the environment variable of the CA certificates file.
+	envCAFile = "CLOUDAVENUE_CA_FILE"
+	// envInsecure is the environment variable disabling the verification of
+	// the certificates.
+	envInsecure = "CLOUDAVENUE_INSECURE"
+)
+
+// providerS3URL returns the S3 endpoint override. The s3_url attribute takes
+// precedence over the CLOUDAVENUE_S3_URL environment variable. An empty
+// string keeps the endpoint of the SDK.
+func providerS3URL(config cloudavenueProviderModel, getenv func(string) string) string {
+	if v := emptyOrValue(config.S3URL); v != "" {
+		return v
+	}
+	return getenv(envS3URL)
+}
+
+// providerTLSConfig returns the TLS configuration of the HTTP clients, or nil
+// to keep the system configuration. The ca_file and insecure attributes take
+// precedence over the CLOUDAVENUE_CA_FILE and CLOUDAVENUE_INSECURE
+// environment variables.
+func providerTLSConfig(config cloudavenueProviderModel, getenv func(string) string) (*tls.Config, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	insecure := false
+	switch {
+	case !config.Insecure.IsNull() && !config.Insecure.IsUnknown():
+		insecure = config.Insecure.ValueBool()
+	case getenv(envInsecure) != "":
+		v := getenv(envInsecure)
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("insecure"),
+				"Invalid "+envInsecure+" environment variable",
+				envInsecure+" must be a boolean (true or false), got "+strconv.Quote(v)+".",
+			)
+			return nil, diags
+		}
+		insecure = b
+	}
+
+	caFile := emptyOrValue(config.CAFile)
+	if caFile == "" {
+		caFile = getenv(envCAFile)
+	}
+
+	if caFile == "" && !insecure {
+		return nil, diags
+	}
+
+	//nolint:gosec // insecure is an explicit choice of the user.
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: insecure,
+	}
+
+	if caFile != "" {
+		pem, err := os.ReadFile(caFile)
+		if err != nil {
+			diags.AddAttributeError(path.Root("ca_file"), "Unable to read CA file", err.Error())
+			return nil, diags
+		}
+
+		// The CA certificates are trusted in addition to the system ones.
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(pem) {
+			diags.AddAttributeError(path.Root("ca_file"), "Invalid CA file", "No PEM certificate found in "+caFile+".")
+			return nil, diags
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	return tlsConfig, diags
+}
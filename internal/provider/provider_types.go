@@ -21,13 +21,49 @@ package provider
 import "github.com/hashicorp/terraform-plugin-framework/types"
 
 type cloudavenueProviderModel struct {
-	URL               types.String `tfsdk:"url"`
-	CoreAPI           types.String `tfsdk:"core_api"`
-	User              types.String `tfsdk:"user"`
-	Password          types.String `tfsdk:"password"`
-	Org               types.String `tfsdk:"org"`
-	VDC               types.String `tfsdk:"vdc"`
-	NetBackupURL      types.String `tfsdk:"netbackup_url"`
-	NetBackupUser     types.String `tfsdk:"netbackup_user"`
-	NetBackupPassword types.String `tfsdk:"netbackup_password"`
+	URL                   types.String                     `tfsdk:"url"`
+	CoreAPI               types.String                     `tfsdk:"core_api"`
+	User                  types.String                     `tfsdk:"user"`
+	Password              types.String                     `tfsdk:"password"`
+	APIToken              types.String                     `tfsdk:"api_token"`
+	Org                   types.String                     `tfsdk:"org"`
+	VDC                   types.String                     `tfsdk:"vdc"`
+	NetBackupURL          types.String                     `tfsdk:"netbackup_url"`
+	NetBackupUser         types.String                     `tfsdk:"netbackup_user"`
+	NetBackupPassword     types.String                     `tfsdk:"netbackup_password"`
+	S3URL                 types.String                     `tfsdk:"s3_url"`
+	CAFile                types.String                     `tfsdk:"ca_file"`
+	Insecure              types.Bool                       `tfsdk:"insecure"`
+	Profile               types.String                     `tfsdk:"profile"`
+	ProfileFile           types.String                     `tfsdk:"profile_file"`
+	MaxConcurrentRequests types.Int64                      `tfsdk:"max_concurrent_requests"`
+	RequestsPerSecond     types.Float64                    `tfsdk:"requests_per_second"`
+	ReadOnly              types.Bool                       `tfsdk:"read_only"`
+	LockTimeout           types.String                     `tfsdk:"lock_timeout"`
+	LockBackend           *cloudavenueProviderLockModel    `tfsdk:"lock_backend"`
+	Retry                 *cloudavenueProviderRetryModel   `tfsdk:"retry"`
+	Metrics               *cloudavenueProviderMetricsModel `tfsdk:"metrics"`
+}
+
+type cloudavenueProviderRetryModel struct {
+	MaxAttempts          types.Int64  `tfsdk:"max_attempts"`
+	MinBackoff           types.String `tfsdk:"min_backoff"`
+	MaxBackoff           types.String `tfsdk:"max_backoff"`
+	RetryableStatusCodes types.List   `tfsdk:"retryable_status_codes"`
+	RetryOnBusyEntity    types.Bool   `tfsdk:"retry_on_busy_entity"`
+}
+
+type cloudavenueProviderLockModel struct {
+	Type      types.String `tfsdk:"type"`
+	Directory types.String `tfsdk:"directory"`
+	Bucket    types.String `tfsdk:"bucket"`
+	Prefix    types.String `tfsdk:"prefix"`
+	TTL       types.String `tfsdk:"ttl"`
+}
+
+type cloudavenueProviderMetricsModel struct {
+	Sink         types.String `tfsdk:"sink"`
+	FilePath     types.String `tfsdk:"file_path"`
+	OTLPEndpoint types.String `tfsdk:"otlp_endpoint"`
+	OTLPHeaders  types.Map    `tfsdk:"otlp_headers"`
 }
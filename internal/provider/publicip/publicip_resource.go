@@ -38,14 +38,16 @@ import (
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/cloudavenue"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/edgegw"
 	cerrs "github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/errors"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/identity"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/org"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &publicIPResource{}
-	_ resource.ResourceWithConfigure   = &publicIPResource{}
-	_ resource.ResourceWithImportState = &publicIPResource{}
+	_ resource.Resource                 = &publicIPResource{}
+	_ resource.ResourceWithConfigure    = &publicIPResource{}
+	_ resource.ResourceWithImportState  = &publicIPResource{}
+	_ identity.ResourceWithIdentitySpec = &publicIPResource{}
 )
 
 // NewPublicIPResource returns a new resource implementing the public_ip resource.
@@ -140,7 +142,10 @@ func (r *publicIPResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
-	cloudavenue.Lock(ctx)
+	if err := cloudavenue.Lock(ctx); err != nil {
+		resp.Diagnostics.AddError("Error acquiring the lock", err.Error())
+		return
+	}
 	defer cloudavenue.Unlock(ctx)
 
 	// * Create the public IP
@@ -296,7 +301,10 @@ func (r *publicIPResource) Delete(ctx context.Context, req resource.DeleteReques
 		return
 	}
 
-	cloudavenue.Lock(ctx)
+	if err := cloudavenue.Lock(ctx); err != nil {
+		resp.Diagnostics.AddError("Error acquiring the lock", err.Error())
+		return
+	}
 	defer cloudavenue.Unlock(ctx)
 
 	// * Delete the public IP
@@ -353,6 +361,24 @@ func (r *publicIPResource) ImportState(ctx context.Context, req resource.ImportS
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("public_ip"), ip.UplinkIP)...)
 }
 
+// IdentitySpec returns the identity of the resource.
+func (r *publicIPResource) IdentitySpec() identity.Spec {
+	return identity.Spec{
+		Attributes: []identity.Attribute{
+			{
+				Name:        "edge_gateway_id",
+				Description: "The ID of the edge gateway.",
+			},
+			{
+				Name:        "public_ip",
+				Description: "The public IP address.",
+				// The import parses the four parts of the IPv4 address.
+				SeparatorAllowed: true,
+			},
+		},
+	}
+}
+
 // * CustomFuncs
 
 func (r *publicIPResource) read(_ context.Context, planOrState *publicIPResourceModel) (stateRefreshed *publicIPResourceModel, found bool, diags diag.Diagnostics) {
@@ -48,7 +48,7 @@ type BucketACLDataSource struct {
 
 // Init Initializes the data source.
 func (d *BucketACLDataSource) Init(_ context.Context, _ *BucketACLModelDatasource) (diags diag.Diagnostics) {
-	d.s3Client = d.client.CAVSDK.V1.S3()
+	d.s3Client = d.client.S3()
 	return diags
 }
 
@@ -33,13 +33,15 @@ import (
 	v1 "github.com/orange-cloudavenue/cloudavenue-sdk-go/v1"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/metrics"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/identity"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &BucketACLResource{}
-	_ resource.ResourceWithConfigure   = &BucketACLResource{}
-	_ resource.ResourceWithImportState = &BucketACLResource{}
+	_ resource.Resource                 = &BucketACLResource{}
+	_ resource.ResourceWithConfigure    = &BucketACLResource{}
+	_ resource.ResourceWithImportState  = &BucketACLResource{}
+	_ identity.ResourceWithIdentitySpec = &BucketACLResource{}
 )
 
 // NewBucketACLResource is a helper function to simplify the provider implementation.
@@ -55,7 +57,7 @@ type BucketACLResource struct {
 
 // Init Initializes the resource.
 func (r *BucketACLResource) Init(_ context.Context, _ *BucketACLModel) (diags diag.Diagnostics) {
-	r.s3Client = r.client.CAVSDK.V1.S3()
+	r.s3Client = r.client.S3()
 	return diags
 }
 
@@ -261,6 +263,18 @@ func (r *BucketACLResource) ImportState(ctx context.Context, req resource.Import
 	resource.ImportStatePassthroughID(ctx, path.Root("bucket"), req, resp)
 }
 
+// IdentitySpec returns the identity of the resource.
+func (r *BucketACLResource) IdentitySpec() identity.Spec {
+	return identity.Spec{
+		Attributes: []identity.Attribute{
+			{
+				Name:        "bucket",
+				Description: "The name of the bucket.",
+			},
+		},
+	}
+}
+
 // * CustomFuncs
 
 // read is a generic read function that can be used by the resource Create, Read and Update functions.
@@ -33,14 +33,17 @@ import (
 	v1 "github.com/orange-cloudavenue/cloudavenue-sdk-go/v1"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/metrics"
+	cerrs "github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/errors"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/identity"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/pkg/utils"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &BucketCorsConfigurationResource{}
-	_ resource.ResourceWithConfigure   = &BucketCorsConfigurationResource{}
-	_ resource.ResourceWithImportState = &BucketCorsConfigurationResource{}
+	_ resource.Resource                 = &BucketCorsConfigurationResource{}
+	_ resource.ResourceWithConfigure    = &BucketCorsConfigurationResource{}
+	_ resource.ResourceWithImportState  = &BucketCorsConfigurationResource{}
+	_ identity.ResourceWithIdentitySpec = &BucketCorsConfigurationResource{}
 )
 
 // NewBucketCorsConfigurationResource is a helper function to simplify the provider implementation.
@@ -56,7 +59,7 @@ type BucketCorsConfigurationResource struct {
 
 // Init Initializes the resource.
 func (r *BucketCorsConfigurationResource) Init(_ context.Context, _ *BucketCorsConfigurationModel) (diags diag.Diagnostics) {
-	r.s3Client = r.client.CAVSDK.V1.S3()
+	r.s3Client = r.client.S3()
 	return diags
 }
 
@@ -268,7 +271,7 @@ func (r *BucketCorsConfigurationResource) Delete(ctx context.Context, req resour
 	if _, err := r.s3Client.DeleteBucketCorsWithContext(ctx, &s3.DeleteBucketCorsInput{
 		Bucket: state.Bucket.GetPtr(),
 	}); err != nil {
-		resp.Diagnostics.AddError("Error deleting CORS policy", err.Error())
+		cerrs.AddError(&resp.Diagnostics, cerrs.ActionDelete, "CORS policy", err)
 		return
 	}
 }
@@ -278,6 +281,18 @@ func (r *BucketCorsConfigurationResource) ImportState(ctx context.Context, req r
 	resource.ImportStatePassthroughID(ctx, path.Root("bucket"), req, resp)
 }
 
+// IdentitySpec returns the identity of the resource.
+func (r *BucketCorsConfigurationResource) IdentitySpec() identity.Spec {
+	return identity.Spec{
+		Attributes: []identity.Attribute{
+			{
+				Name:        "bucket",
+				Description: "The name of the bucket.",
+			},
+		},
+	}
+}
+
 // * CustomFuncs
 
 // read is a generic read function that can be used by the resource Create, Read and Update functions.
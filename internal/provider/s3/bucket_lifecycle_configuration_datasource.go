@@ -49,7 +49,7 @@ type BucketLifecycleConfigurationDataSource struct {
 
 // Init Initializes the data source.
 func (d *BucketLifecycleConfigurationDataSource) Init(_ context.Context, _ *BucketLifecycleConfigurationDatasourceModel) (diags diag.Diagnostics) {
-	d.s3Client = d.client.CAVSDK.V1.S3()
+	d.s3Client = d.client.S3()
 	return diags
 }
 
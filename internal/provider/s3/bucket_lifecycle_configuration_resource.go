@@ -37,14 +37,17 @@ import (
 	v1 "github.com/orange-cloudavenue/cloudavenue-sdk-go/v1"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/metrics"
+	cerrs "github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/errors"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/identity"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/pkg/utils"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &BucketLifecycleConfigurationResource{}
-	_ resource.ResourceWithConfigure   = &BucketLifecycleConfigurationResource{}
-	_ resource.ResourceWithImportState = &BucketLifecycleConfigurationResource{}
+	_ resource.Resource                 = &BucketLifecycleConfigurationResource{}
+	_ resource.ResourceWithConfigure    = &BucketLifecycleConfigurationResource{}
+	_ resource.ResourceWithImportState  = &BucketLifecycleConfigurationResource{}
+	_ identity.ResourceWithIdentitySpec = &BucketLifecycleConfigurationResource{}
 )
 
 // NewBucketLifecycleConfigurationResource is a helper function to simplify the provider implementation.
@@ -60,7 +63,7 @@ type BucketLifecycleConfigurationResource struct {
 
 // Init Initializes the resource.
 func (r *BucketLifecycleConfigurationResource) Init(_ context.Context, _ *BucketLifecycleConfigurationModel) (diags diag.Diagnostics) {
-	r.s3Client = r.client.CAVSDK.V1.S3()
+	r.s3Client = r.client.S3()
 	return diags
 }
 
@@ -258,7 +261,7 @@ func (r *BucketLifecycleConfigurationResource) Delete(ctx context.Context, req r
 	if _, err := r.s3Client.DeleteBucketLifecycleWithContext(ctx, &s3.DeleteBucketLifecycleInput{
 		Bucket: state.Bucket.GetPtr(),
 	}); err != nil {
-		resp.Diagnostics.AddError("Error deleting S3 Bucket Lifecycle Configuration", err.Error())
+		cerrs.AddError(&resp.Diagnostics, cerrs.ActionDelete, "S3 Bucket Lifecycle Configuration", err)
 	}
 }
 
@@ -269,6 +272,18 @@ func (r *BucketLifecycleConfigurationResource) ImportState(ctx context.Context,
 	resource.ImportStatePassthroughID(ctx, path.Root("bucket"), req, resp)
 }
 
+// IdentitySpec returns the identity of the resource.
+func (r *BucketLifecycleConfigurationResource) IdentitySpec() identity.Spec {
+	return identity.Spec{
+		Attributes: []identity.Attribute{
+			{
+				Name:        "bucket",
+				Description: "The name of the bucket.",
+			},
+		},
+	}
+}
+
 // * CustomFuncs
 
 // read is a generic read function that can be used by the resource Create, Read and Update functions.
@@ -0,0 +1,72 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package s3
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/metrics"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/listresource"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ list.ListResource              = &BucketListResource{}
+	_ list.ListResourceWithConfigure = &BucketListResource{}
+)
+
+// NewBucketListResource is a helper function to simplify the provider implementation.
+func NewBucketListResource() list.ListResource {
+	return &BucketListResource{
+		Base: listresource.NewBase(NewBucketResource),
+	}
+}
+
+// BucketListResource is the list resource implementation.
+type BucketListResource struct {
+	listresource.Base
+}
+
+// ListResourceConfigSchema defines the schema of the list resource configuration.
+func (r *BucketListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{
+		MarkdownDescription: "The `cloudavenue_s3_bucket` list resource allows you to list the S3 buckets of the organization.",
+	}
+}
+
+// List lists the S3 buckets of the organization.
+func (r *BucketListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	defer metrics.New("cloudavenue_s3_bucket", r.Client.GetOrgName(), metrics.List)()
+
+	buckets, err := r.Client.S3().ListBucketsWithContext(ctx, &s3.ListBucketsInput{})
+	if err != nil {
+		stream.Results = listresource.Error("Unable to list S3 buckets", err)
+		return
+	}
+
+	items := make([]listresource.Item, 0, len(buckets.Buckets))
+	for _, bucket := range buckets.Buckets {
+		name := aws.StringValue(bucket.Name)
+		items = append(items, listresource.Item{
+			DisplayName: name,
+			Attributes: map[string]string{
+				"name": name,
+			},
+		})
+	}
+
+	stream.Results = r.Results(ctx, req, items)
+}
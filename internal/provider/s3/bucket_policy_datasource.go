@@ -49,7 +49,7 @@ type BucketPolicyDataSource struct {
 
 // Init Initializes the data source.
 func (d *BucketPolicyDataSource) Init(_ context.Context, _ *BucketPolicyModelDatasource) (diags diag.Diagnostics) {
-	d.s3Client = d.client.CAVSDK.V1.S3()
+	d.s3Client = d.client.S3()
 	return diags
 }
 
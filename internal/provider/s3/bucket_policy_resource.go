@@ -36,13 +36,15 @@ import (
 	v1 "github.com/orange-cloudavenue/cloudavenue-sdk-go/v1"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/metrics"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/identity"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &BucketPolicyResource{}
-	_ resource.ResourceWithConfigure   = &BucketPolicyResource{}
-	_ resource.ResourceWithImportState = &BucketPolicyResource{}
+	_ resource.Resource                 = &BucketPolicyResource{}
+	_ resource.ResourceWithConfigure    = &BucketPolicyResource{}
+	_ resource.ResourceWithImportState  = &BucketPolicyResource{}
+	_ identity.ResourceWithIdentitySpec = &BucketPolicyResource{}
 )
 
 // NewBucketPolicyResource is a helper function to simplify the provider implementation.
@@ -58,7 +60,7 @@ type BucketPolicyResource struct {
 
 // Init Initializes the resource.
 func (r *BucketPolicyResource) Init(_ context.Context, _ *BucketPolicyModel) (diags diag.Diagnostics) {
-	r.s3Client = r.client.CAVSDK.V1.S3()
+	r.s3Client = r.client.S3()
 
 	return diags
 }
@@ -239,6 +241,18 @@ func (r *BucketPolicyResource) ImportState(ctx context.Context, req resource.Imp
 	resource.ImportStatePassthroughID(ctx, path.Root("bucket"), req, resp)
 }
 
+// IdentitySpec returns the identity of the resource.
+func (r *BucketPolicyResource) IdentitySpec() identity.Spec {
+	return identity.Spec{
+		Attributes: []identity.Attribute{
+			{
+				Name:        "bucket",
+				Description: "The name of the bucket.",
+			},
+		},
+	}
+}
+
 // genericCreateOrUpdate creates or updates a resource.
 func (r *BucketPolicyResource) genericCreateOrUpdate(ctx context.Context, timeout time.Duration, planOrState *BucketPolicyModel) (stateRefreshed *BucketPolicyModel, diags diag.Diagnostics) {
 	stateRefreshed = planOrState.Copy().(*BucketPolicyModel)
@@ -33,14 +33,19 @@ import (
 	v1 "github.com/orange-cloudavenue/cloudavenue-sdk-go/v1"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/metrics"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/deletionprotection"
+	cerrs "github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/errors"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/identity"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/pkg/utils"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &BucketResource{}
-	_ resource.ResourceWithConfigure   = &BucketResource{}
-	_ resource.ResourceWithImportState = &BucketResource{}
+	_ resource.Resource                 = &BucketResource{}
+	_ resource.ResourceWithConfigure    = &BucketResource{}
+	_ resource.ResourceWithImportState  = &BucketResource{}
+	_ resource.ResourceWithModifyPlan   = &BucketResource{}
+	_ identity.ResourceWithIdentitySpec = &BucketResource{}
 )
 
 // NewBucketResource is a helper function to simplify the provider implementation.
@@ -55,8 +60,8 @@ type BucketResource struct {
 }
 
 // Init Initializes the resource.
-func (r *BucketResource) Init(_ context.Context, _ *BucketModel) (diags diag.Diagnostics) {
-	r.s3Client = r.client.CAVSDK.V1.S3()
+func (r *BucketResource) Init(_ context.Context, _ *BucketResourceModel) (diags diag.Diagnostics) {
+	r.s3Client = r.client.S3()
 	return diags
 }
 
@@ -87,11 +92,16 @@ func (r *BucketResource) Configure(_ context.Context, req resource.ConfigureRequ
 	r.client = client
 }
 
+// ModifyPlan rejects the plans destroying or replacing a protected bucket.
+func (r *BucketResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	deletionprotection.ModifyPlan(ctx, "cloudavenue_s3_bucket", req, resp)
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *BucketResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	defer metrics.New("cloudavenue_s3_bucket", r.client.GetOrgName(), metrics.Create)()
 
-	plan := &BucketModel{}
+	plan := &BucketResourceModel{}
 
 	// Retrieve values from plan
 	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
@@ -113,7 +123,9 @@ func (r *BucketResource) Create(ctx context.Context, req resource.CreateRequest,
 		Bucket:                     plan.Name.GetPtr(),
 		ObjectLockEnabledForBucket: utils.TakeBoolPointer(plan.ObjectLock.Get()),
 	}); err != nil {
-		resp.Diagnostics.AddError("Error creating bucket", fmt.Sprintf("Error creating bucket (name=%s): %s", plan.Name.Get(), err.Error()))
+		cerrs.AddAttributeError(&resp.Diagnostics, cerrs.AttributePaths{
+			cerrs.KindConflict: path.Root("name"),
+		}, cerrs.ActionCreate, "bucket "+plan.Name.Get(), err)
 		return
 	}
 
@@ -137,7 +149,7 @@ func (r *BucketResource) Create(ctx context.Context, req resource.CreateRequest,
 func (r *BucketResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	defer metrics.New("cloudavenue_s3_bucket", r.client.GetOrgName(), metrics.Read)()
 
-	state := &BucketModel{}
+	state := &BucketResourceModel{}
 
 	// Get current state
 	resp.Diagnostics.Append(req.State.Get(ctx, state)...)
@@ -167,16 +179,42 @@ func (r *BucketResource) Read(ctx context.Context, req resource.ReadRequest, res
 }
 
 // Update updates the resource and sets the updated Terraform state on success.
-func (r *BucketResource) Update(_ context.Context, _ resource.UpdateRequest, _ *resource.UpdateResponse) {
+func (r *BucketResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	defer metrics.New("cloudavenue_s3_bucket", r.client.GetOrgName(), metrics.Update)()
-	// All attributes are immutable
+
+	plan := &BucketResourceModel{}
+
+	// Retrieve values from plan
+	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Init the resource
+	resp.Diagnostics.Append(r.Init(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only deletion_protection can be updated, the other attributes
+	// require the replacement of the bucket.
+
+	// Use generic read function to refresh the state
+	stateRefreshed, _, d := r.read(ctx, plan)
+	if d.HasError() {
+		resp.Diagnostics.Append(d...)
+		return
+	}
+
+	// Set state to fully populated data
+	resp.Diagnostics.Append(resp.State.Set(ctx, stateRefreshed)...)
 }
 
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *BucketResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	defer metrics.New("cloudavenue_s3_bucket", r.client.GetOrgName(), metrics.Delete)()
 
-	state := &BucketModel{}
+	state := &BucketResourceModel{}
 
 	// Get current state
 	resp.Diagnostics.Append(req.State.Get(ctx, state)...)
@@ -184,6 +222,11 @@ func (r *BucketResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
+	resp.Diagnostics.Append(deletionprotection.CheckDelete(ctx, "cloudavenue_s3_bucket", req.State)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Init the resource
 	resp.Diagnostics.Append(r.Init(ctx, state)...)
 	if resp.Diagnostics.HasError() {
@@ -198,7 +241,7 @@ func (r *BucketResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		Bucket: state.Name.GetPtr(),
 	})
 	if err != nil {
-		resp.Diagnostics.AddError("Error deleting bucket", fmt.Sprintf("Error deleting bucket: %s", err.Error()))
+		cerrs.AddError(&resp.Diagnostics, cerrs.ActionDelete, "bucket", err)
 		return
 	}
 }
@@ -208,10 +251,22 @@ func (r *BucketResource) ImportState(ctx context.Context, req resource.ImportSta
 	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
 }
 
+// IdentitySpec returns the identity of the resource.
+func (r *BucketResource) IdentitySpec() identity.Spec {
+	return identity.Spec{
+		Attributes: []identity.Attribute{
+			{
+				Name:        "name",
+				Description: "The name of the bucket.",
+			},
+		},
+	}
+}
+
 // * CustomFuncs
 
 // read is a generic read function that can be used by the resource Create, Read and Update functions.
-func (r *BucketResource) read(ctx context.Context, planOrState *BucketModel) (stateRefreshed *BucketModel, found bool, diags diag.Diagnostics) {
+func (r *BucketResource) read(ctx context.Context, planOrState *BucketResourceModel) (stateRefreshed *BucketResourceModel, found bool, diags diag.Diagnostics) {
 	stateRefreshed = planOrState.Copy()
 
 	/*
@@ -243,5 +298,10 @@ func (r *BucketResource) read(ctx context.Context, planOrState *BucketModel) (st
 		stateRefreshed.ID.Set(stateRefreshed.Name.Get())
 	}
 
+	// The states written before the protection existed are not protected.
+	if !stateRefreshed.DeletionProtection.IsKnown() {
+		stateRefreshed.DeletionProtection.Set(false)
+	}
+
 	return stateRefreshed, true, nil
 }
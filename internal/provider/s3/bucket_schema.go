@@ -29,6 +29,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/deletionprotection"
 )
 
 func s3BucketSchema(_ context.Context) superschema.Schema {
@@ -77,6 +79,9 @@ func s3BucketSchema(_ context.Context) superschema.Schema {
 					Computed:            true,
 				},
 			},
+			deletionprotection.AttributeName: superschema.SuperBoolAttribute{
+				Resource: deletionprotection.ResourceAttribute(),
+			},
 		},
 	}
 }
@@ -24,6 +24,14 @@ import (
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/pkg/utils"
 )
 
+type BucketResourceModel struct {
+	ID                 supertypes.StringValue `tfsdk:"id"`
+	Name               supertypes.StringValue `tfsdk:"name"`
+	ObjectLock         supertypes.BoolValue   `tfsdk:"object_lock"`
+	Endpoint           supertypes.StringValue `tfsdk:"endpoint"`
+	DeletionProtection supertypes.BoolValue   `tfsdk:"deletion_protection"`
+}
+
 type BucketModel struct {
 	ID         supertypes.StringValue `tfsdk:"id"`
 	Name       supertypes.StringValue `tfsdk:"name"`
@@ -36,3 +44,9 @@ func (rm *BucketModel) Copy() *BucketModel {
 	utils.ModelCopy(rm, x)
 	return x
 }
+
+func (rm *BucketResourceModel) Copy() *BucketResourceModel {
+	x := &BucketResourceModel{}
+	utils.ModelCopy(rm, x)
+	return x
+}
@@ -50,7 +50,7 @@ type BucketVersioningConfigurationDatasource struct {
 
 // Init Initializes the data source.
 func (d *BucketVersioningConfigurationDatasource) Init(_ context.Context, _ *BucketVersioningConfigurationDatasourceModel) (diags diag.Diagnostics) {
-	d.s3Client = d.client.CAVSDK.V1.S3()
+	d.s3Client = d.client.S3()
 	return diags
 }
 
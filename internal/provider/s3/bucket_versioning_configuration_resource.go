@@ -32,14 +32,17 @@ import (
 	v1 "github.com/orange-cloudavenue/cloudavenue-sdk-go/v1"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/metrics"
+	cerrs "github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/errors"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/identity"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/pkg/utils"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &BucketVersioningConfigurationResource{}
-	_ resource.ResourceWithConfigure   = &BucketVersioningConfigurationResource{}
-	_ resource.ResourceWithImportState = &BucketVersioningConfigurationResource{}
+	_ resource.Resource                 = &BucketVersioningConfigurationResource{}
+	_ resource.ResourceWithConfigure    = &BucketVersioningConfigurationResource{}
+	_ resource.ResourceWithImportState  = &BucketVersioningConfigurationResource{}
+	_ identity.ResourceWithIdentitySpec = &BucketVersioningConfigurationResource{}
 )
 
 // NewBucketVersioningConfigurationResource is a helper function to simplify the provider implementation.
@@ -55,7 +58,7 @@ type BucketVersioningConfigurationResource struct {
 
 // Init Initializes the resource.
 func (r *BucketVersioningConfigurationResource) Init(_ context.Context, _ *BucketVersioningConfigurationModel) (diags diag.Diagnostics) {
-	r.s3Client = r.client.CAVSDK.V1.S3()
+	r.s3Client = r.client.S3()
 	return diags
 }
 
@@ -197,7 +200,7 @@ func (r *BucketVersioningConfigurationResource) Update(ctx context.Context, req
 			Status: plan.Status.GetPtr(),
 		},
 	}); err != nil {
-		resp.Diagnostics.AddError("Error updating bucket versioning", err.Error())
+		cerrs.AddError(&resp.Diagnostics, cerrs.ActionUpdate, "bucket versioning", err)
 	}
 
 	// Use generic read function to refresh the state
@@ -252,6 +255,18 @@ func (r *BucketVersioningConfigurationResource) ImportState(ctx context.Context,
 	resource.ImportStatePassthroughID(ctx, path.Root("bucket"), req, resp)
 }
 
+// IdentitySpec returns the identity of the resource.
+func (r *BucketVersioningConfigurationResource) IdentitySpec() identity.Spec {
+	return identity.Spec{
+		Attributes: []identity.Attribute{
+			{
+				Name:        "bucket",
+				Description: "The name of the bucket.",
+			},
+		},
+	}
+}
+
 // * CustomFuncs
 
 // read is a generic read function that can be used by the resource Create, Read and Update functions.
@@ -48,7 +48,7 @@ type BucketWebsiteConfigurationDataSource struct {
 
 // Init Initializes the data source.
 func (d *BucketWebsiteConfigurationDataSource) Init(_ context.Context, _ *BucketWebsiteConfigurationDataSourceModel) (diags diag.Diagnostics) {
-	d.s3Client = d.client.CAVSDK.V1.S3()
+	d.s3Client = d.client.S3()
 	return diags
 }
 
@@ -37,13 +37,16 @@ import (
 	v1 "github.com/orange-cloudavenue/cloudavenue-sdk-go/v1"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/metrics"
+	cerrs "github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/errors"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/identity"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &BucketWebsiteConfigurationResource{}
-	_ resource.ResourceWithConfigure   = &BucketWebsiteConfigurationResource{}
-	_ resource.ResourceWithImportState = &BucketWebsiteConfigurationResource{}
+	_ resource.Resource                 = &BucketWebsiteConfigurationResource{}
+	_ resource.ResourceWithConfigure    = &BucketWebsiteConfigurationResource{}
+	_ resource.ResourceWithImportState  = &BucketWebsiteConfigurationResource{}
+	_ identity.ResourceWithIdentitySpec = &BucketWebsiteConfigurationResource{}
 )
 
 // NewBucketWebsiteConfigurationResource is a helper function to simplify the provider implementation.
@@ -59,7 +62,7 @@ type BucketWebsiteConfigurationResource struct {
 
 // Init Initializes the resource.
 func (r *BucketWebsiteConfigurationResource) Init(_ context.Context, _ *BucketWebsiteConfigurationModel) (diags diag.Diagnostics) {
-	r.s3Client = r.client.CAVSDK.V1.S3()
+	r.s3Client = r.client.S3()
 
 	return diags
 }
@@ -294,7 +297,7 @@ func (r *BucketWebsiteConfigurationResource) Delete(ctx context.Context, req res
 			return
 		}
 
-		resp.Diagnostics.AddError("Error deleting website configuration", err.Error())
+		cerrs.AddError(&resp.Diagnostics, cerrs.ActionDelete, "website configuration", err)
 		return
 	}
 }
@@ -306,6 +309,18 @@ func (r *BucketWebsiteConfigurationResource) ImportState(ctx context.Context, re
 	resource.ImportStatePassthroughID(ctx, path.Root("bucket"), req, resp)
 }
 
+// IdentitySpec returns the identity of the resource.
+func (r *BucketWebsiteConfigurationResource) IdentitySpec() identity.Spec {
+	return identity.Spec{
+		Attributes: []identity.Attribute{
+			{
+				Name:        "bucket",
+				Description: "The name of the bucket.",
+			},
+		},
+	}
+}
+
 // * CustomFuncs
 
 // read is a generic read function that can be used by the resource Create, Read and Update functions.
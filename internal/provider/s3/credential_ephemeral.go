@@ -0,0 +1,215 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package s3
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+
+	v1 "github.com/orange-cloudavenue/cloudavenue-sdk-go/v1"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/metrics"
+	cerrs "github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/errors"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/readonly"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ ephemeral.EphemeralResource              = &CredentialEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &CredentialEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithClose     = &CredentialEphemeralResource{}
+)
+
+// privateKeyCredential is the key used to store the credential in the ephemeral private data.
+const privateKeyCredential = "credential"
+
+// NewCredentialEphemeralResource is a helper function to simplify the provider implementation.
+func NewCredentialEphemeralResource() ephemeral.EphemeralResource {
+	return &CredentialEphemeralResource{}
+}
+
+// CredentialEphemeralResource is the ephemeral resource implementation.
+type CredentialEphemeralResource struct {
+	client   *client.CloudAvenue
+	s3Client v1.S3Client
+}
+
+// Init Initializes the ephemeral resource.
+func (r *CredentialEphemeralResource) Init(_ context.Context) (diags diag.Diagnostics) {
+	r.s3Client = r.client.S3()
+	return diags
+}
+
+// Metadata returns the ephemeral resource type name.
+func (r *CredentialEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + categoryName + "_credential"
+}
+
+// Schema defines the schema for the ephemeral resource.
+func (r *CredentialEphemeralResource) Schema(ctx context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = credentialEphemeralSchema(ctx)
+}
+
+func (r *CredentialEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.CloudAvenue)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *client.CloudAvenue, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+// Open creates a new access key and secret key and returns them without persisting them.
+func (r *CredentialEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	defer metrics.New("cloudavenue_s3_credential", r.client.GetOrgName(), metrics.Open)()
+
+	// Opening the ephemeral resource creates a credential.
+	if r.client.IsReadOnly() {
+		resp.Diagnostics.Append(readonly.Error("cloudavenue_s3_credential", "opened"))
+		return
+	}
+
+	config := &CredentialEphemeralModel{}
+
+	// Retrieve values from config
+	resp.Diagnostics.Append(req.Config.Get(ctx, config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Init the ephemeral resource
+	resp.Diagnostics.Append(r.Init(ctx)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	user, oseErr := r.s3Client.GetUser(r.client.GetUserName())
+	if oseErr != nil {
+		if oseErr.IsNotFountError() {
+			resp.Diagnostics.AddError("User not found", fmt.Sprintf("The user %s is not found", r.client.GetUserName()))
+			return
+		}
+		resp.Diagnostics.AddError("Error getting user", oseErr.Error())
+		return
+	}
+
+	cred, err := user.NewCredential()
+	if err != nil {
+		cerrs.AddError(&resp.Diagnostics, cerrs.ActionCreate, "credential", err)
+		return
+	}
+
+	r.openCredential(ctx, resp, config, user.GetName(), cred)
+}
+
+// openedCredential is the credential created by Open.
+type openedCredential interface {
+	GetAccessKey() string
+	GetSecretKey() string
+	Delete() error
+}
+
+// openCredential stores the credential in the private data and sets the
+// result. If it fails, the credential is deleted.
+func (r *CredentialEphemeralResource) openCredential(ctx context.Context, resp *ephemeral.OpenResponse, config *CredentialEphemeralModel, username string, cred openedCredential) {
+	// Close is not called when Open fails, delete the credential to avoid leaking it.
+	defer func() {
+		if !resp.Diagnostics.HasError() {
+			return
+		}
+		if err := cred.Delete(); err != nil && !cerrs.IsNotFound(err) {
+			cerrs.AddError(&resp.Diagnostics, cerrs.ActionDelete, "credential", err)
+		}
+	}()
+
+	private, err := json.Marshal(credentialEphemeralPrivate{
+		Username:  username,
+		AccessKey: cred.GetAccessKey(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error marshalling credential private data", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, privateKeyCredential, private)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config.Username.Set(username)
+	config.AccessKey.Set(cred.GetAccessKey())
+	config.SecretKey.Set(cred.GetSecretKey())
+
+	// Set the result
+	resp.Diagnostics.Append(resp.Result.Set(ctx, config)...)
+}
+
+// Close deletes the access key and secret key created by Open. A user or a
+// credential already deleted is ignored.
+func (r *CredentialEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	defer metrics.New("cloudavenue_s3_credential", r.client.GetOrgName(), metrics.Close)()
+
+	raw, d := req.Private.GetKey(ctx, privateKeyCredential)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() || raw == nil {
+		return
+	}
+
+	private := credentialEphemeralPrivate{}
+	if err := json.Unmarshal(raw, &private); err != nil {
+		resp.Diagnostics.AddError("Error unmarshalling credential private data", err.Error())
+		return
+	}
+
+	// Init the ephemeral resource
+	resp.Diagnostics.Append(r.Init(ctx)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	user, oseErr := r.s3Client.GetUser(private.Username)
+	if oseErr != nil {
+		if oseErr.IsNotFountError() {
+			// User already deleted, along with its credentials
+			return
+		}
+		resp.Diagnostics.AddError("Error getting user", oseErr.Error())
+		return
+	}
+
+	cred, err := user.GetCredential(private.AccessKey)
+	if err != nil {
+		if cerrs.IsNotFound(err) {
+			// Credential already deleted
+			return
+		}
+		resp.Diagnostics.AddError("Error getting credential", err.Error())
+		return
+	}
+
+	if err := cred.Delete(); err != nil {
+		if cerrs.IsNotFound(err) {
+			return
+		}
+		cerrs.AddError(&resp.Diagnostics, cerrs.ActionDelete, "credential", err)
+		return
+	}
+}
@@ -0,0 +1,71 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package s3
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+)
+
+type testCredential struct {
+	deleted   bool
+	deleteErr error
+}
+
+func (c *testCredential) GetAccessKey() string { return "access-key" }
+
+func (c *testCredential) GetSecretKey() string { return "secret-key" }
+
+func (c *testCredential) Delete() error {
+	c.deleted = true
+	return c.deleteErr
+}
+
+func TestOpenCredentialDeletesOnError(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		deleteErr error
+		wantErrs  int
+	}{
+		{
+			name:     "Credential deleted",
+			wantErrs: 1,
+		},
+		{
+			name:      "Delete fails",
+			deleteErr: errors.New("delete failed"),
+			wantErrs:  2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			cred := &testCredential{deleteErr: tt.deleteErr}
+			// The private data is not initialized, storing the credential fails.
+			resp := &ephemeral.OpenResponse{}
+
+			r := &CredentialEphemeralResource{}
+			r.openCredential(t.Context(), resp, &CredentialEphemeralModel{}, "user", cred)
+
+			if !cred.deleted {
+				t.Fatal("the credential is not deleted when Open fails")
+			}
+			if got := resp.Diagnostics.ErrorsCount(); got != tt.wantErrs {
+				t.Fatalf("expected %d errors, got %d: %+v", tt.wantErrs, got, resp.Diagnostics)
+			}
+		})
+	}
+}
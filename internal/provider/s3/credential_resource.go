@@ -31,13 +31,16 @@ import (
 	v1 "github.com/orange-cloudavenue/cloudavenue-sdk-go/v1"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/metrics"
+	cerrs "github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/errors"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/identity"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource               = &CredentialResource{}
-	_ resource.ResourceWithConfigure  = &CredentialResource{}
-	_ resource.ResourceWithModifyPlan = &CredentialResource{}
+	_ resource.Resource                 = &CredentialResource{}
+	_ resource.ResourceWithConfigure    = &CredentialResource{}
+	_ resource.ResourceWithModifyPlan   = &CredentialResource{}
+	_ identity.ResourceWithIdentitySpec = &CredentialResource{}
 )
 
 // NewCredentialResource is a helper function to simplify the provider implementation.
@@ -53,7 +56,7 @@ type CredentialResource struct {
 
 // Init Initializes the resource.
 func (r *CredentialResource) Init(_ context.Context, _ *CredentialModel) (diags diag.Diagnostics) {
-	r.s3Client = r.client.CAVSDK.V1.S3()
+	r.s3Client = r.client.S3()
 	return diags
 }
 
@@ -152,7 +155,7 @@ func (r *CredentialResource) Create(ctx context.Context, req resource.CreateRequ
 
 	cred, err := user.NewCredential()
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating credential", err.Error())
+		cerrs.AddError(&resp.Diagnostics, cerrs.ActionCreate, "credential", err)
 		return
 	}
 
@@ -283,11 +286,27 @@ func (r *CredentialResource) Delete(ctx context.Context, req resource.DeleteRequ
 	}
 
 	if err := cred.Delete(); err != nil {
-		resp.Diagnostics.AddError("Error deleting credential", err.Error())
+		cerrs.AddError(&resp.Diagnostics, cerrs.ActionDelete, "credential", err)
 		return
 	}
 }
 
+// IdentitySpec returns the identity of the resource.
+func (r *CredentialResource) IdentitySpec() identity.Spec {
+	return identity.Spec{
+		Attributes: []identity.Attribute{
+			{
+				Name:        "username",
+				Description: "The username of the credential owner.",
+			},
+			{
+				Name:        "id",
+				Description: "The ID of the credential.",
+			},
+		},
+	}
+}
+
 // * CustomFuncs
 
 // read is a generic read function that can be used by the resource Create, Read and Update functions.
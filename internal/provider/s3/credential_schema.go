@@ -22,7 +22,9 @@ import (
 	"context"
 
 	superschema "github.com/orange-cloudavenue/terraform-plugin-framework-superschema"
+	supertypes "github.com/orange-cloudavenue/terraform-plugin-framework-supertypes"
 
+	schemaE "github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
 	schemaR "github.com/hashicorp/terraform-plugin-framework/resource/schema"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
@@ -35,7 +37,7 @@ import (
 func credentialSchema(_ context.Context) superschema.Schema {
 	return superschema.Schema{
 		Resource: superschema.SchemaDetails{
-			MarkdownDescription: "The `cloudavenue_s3_credential` resource allows you to manage an access key and secret key for an S3 user.",
+			MarkdownDescription: "The `cloudavenue_s3_credential` resource allows you to manage an access key and secret key for an S3 user. \n\n -> **Note:** Use the `cloudavenue_s3_credential` ephemeral resource to generate a short-lived access key and secret key without storing them in the state or on disk.",
 		},
 		Attributes: map[string]superschema.Attribute{
 			"id": superschema.SuperStringAttribute{
@@ -121,3 +123,28 @@ func credentialSchema(_ context.Context) superschema.Schema {
 		},
 	}
 }
+
+func credentialEphemeralSchema(_ context.Context) schemaE.Schema {
+	return schemaE.Schema{
+		MarkdownDescription: "The `cloudavenue_s3_credential` ephemeral resource allows you to generate an access key and secret key for the S3 user configured at the provider level during a Terraform run. The credential is never stored in the state or on disk and is deleted when the ephemeral resource is closed.",
+		Attributes: map[string]schemaE.Attribute{
+			"username": schemaE.StringAttribute{
+				MarkdownDescription: "The username is configured at the provider level.",
+				Computed:            true,
+				CustomType:          supertypes.StringType{},
+			},
+			"access_key": schemaE.StringAttribute{
+				MarkdownDescription: "The Access Key.",
+				Computed:            true,
+				Sensitive:           true,
+				CustomType:          supertypes.StringType{},
+			},
+			"secret_key": schemaE.StringAttribute{
+				MarkdownDescription: "The Secret Key.",
+				Computed:            true,
+				Sensitive:           true,
+				CustomType:          supertypes.StringType{},
+			},
+		},
+	}
+}
@@ -21,6 +21,7 @@ package s3_test
 import (
 	"testing"
 
+	fwephemeral "github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
 
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/s3"
@@ -47,3 +48,25 @@ func TestCredentialResourceSchema(t *testing.T) {
 		t.Fatalf("Schema validation diagnostics: %+v", diagnostics)
 	}
 }
+
+// Unit test for the schema of the ephemeral resource cloudavenue_s3_credential.
+func TestCredentialEphemeralResourceSchema(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	schemaResponse := &fwephemeral.SchemaResponse{}
+
+	// Instantiate the ephemeral.EphemeralResource and call its Schema method
+	s3.NewCredentialEphemeralResource().Schema(ctx, fwephemeral.SchemaRequest{}, schemaResponse)
+
+	if schemaResponse.Diagnostics.HasError() {
+		t.Fatalf("Schema method diagnostics: %+v", schemaResponse.Diagnostics)
+	}
+
+	// Validate the schema
+	diagnostics := schemaResponse.Schema.ValidateImplementation(ctx)
+
+	if diagnostics.HasError() {
+		t.Fatalf("Schema validation diagnostics: %+v", diagnostics)
+	}
+}
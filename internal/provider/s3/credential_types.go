@@ -40,3 +40,15 @@ func (rm *CredentialModel) Copy() *CredentialModel {
 	utils.ModelCopy(rm, x)
 	return x
 }
+
+type CredentialEphemeralModel struct {
+	Username  supertypes.StringValue `tfsdk:"username"`
+	AccessKey supertypes.StringValue `tfsdk:"access_key"`
+	SecretKey supertypes.StringValue `tfsdk:"secret_key"`
+}
+
+// credentialEphemeralPrivate is the private data stored between Open and Close.
+type credentialEphemeralPrivate struct {
+	Username  string `json:"username"`
+	AccessKey string `json:"accessKey"`
+}
@@ -49,7 +49,7 @@ type UserDataSource struct {
 
 // Init Initializes the data source.
 func (d *UserDataSource) Init(_ context.Context, _ *UserDataSourceModel) (diags diag.Diagnostics) {
-	d.s3Client = d.client.CAVSDK.V1.S3()
+	d.s3Client = d.client.S3()
 	return diags
 }
 
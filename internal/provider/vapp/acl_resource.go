@@ -35,15 +35,19 @@ import (
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/metrics"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/acl"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/identity"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/movestate"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/vapp"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/vdc"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &aclResource{}
-	_ resource.ResourceWithConfigure   = &aclResource{}
-	_ resource.ResourceWithImportState = &aclResource{}
+	_ resource.Resource                 = &aclResource{}
+	_ resource.ResourceWithConfigure    = &aclResource{}
+	_ resource.ResourceWithImportState  = &aclResource{}
+	_ resource.ResourceWithMoveState    = &aclResource{}
+	_ identity.ResourceWithIdentitySpec = &aclResource{}
 )
 
 // NewaclResource is a helper function to simplify the provider implementation.
@@ -305,6 +309,33 @@ func (r *aclResource) ImportState(ctx context.Context, req resource.ImportStateR
 	}
 }
 
+// IdentitySpec returns the identity of the resource.
+func (r *aclResource) IdentitySpec() identity.Spec {
+	return identity.Spec{
+		Attributes: []identity.Attribute{
+			{
+				Name:        "vdc",
+				Description: "The name of the VDC.",
+				Optional:    true,
+			},
+			{
+				Name:        "vapp_name",
+				Description: "The name of the vApp.",
+			},
+		},
+	}
+}
+
+// MoveState returns the state movers from the equivalent resources of the VMware vcd provider.
+func (r *aclResource) MoveState(_ context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		movestate.FromVCD("vcd_vapp_access_control", movestate.Attributes{
+			"vapp_id": "vapp_id",
+			"vdc":     "vdc",
+		}),
+	}
+}
+
 func (r *aclResource) createOrUpdateACL(ctx context.Context, plan *aclResourceModel) (*aclResourceModel, diag.Diagnostics) {
 	var diags diag.Diagnostics
 	var accessControl govcdtypes.ControlAccessParams
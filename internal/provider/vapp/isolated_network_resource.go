@@ -38,15 +38,20 @@ import (
 	"github.com/orange-cloudavenue/cloudavenue-sdk-go/pkg/urn"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/metrics"
+	cerrs "github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/errors"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/identity"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/movestate"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/vapp"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/vdc"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &isolatedNetworkResource{}
-	_ resource.ResourceWithConfigure   = &isolatedNetworkResource{}
-	_ resource.ResourceWithImportState = &isolatedNetworkResource{}
+	_ resource.Resource                 = &isolatedNetworkResource{}
+	_ resource.ResourceWithConfigure    = &isolatedNetworkResource{}
+	_ resource.ResourceWithImportState  = &isolatedNetworkResource{}
+	_ resource.ResourceWithMoveState    = &isolatedNetworkResource{}
+	_ identity.ResourceWithIdentitySpec = &isolatedNetworkResource{}
 )
 
 // NewIsolatedNetworkResource returns isolated network resource.
@@ -140,7 +145,7 @@ func (r *isolatedNetworkResource) Create(ctx context.Context, req resource.Creat
 	// Create network
 	_, err := r.vapp.CreateVappNetwork(vappNetworkSettings, nil)
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating vApp isolated network", err.Error())
+		cerrs.AddError(&resp.Diagnostics, cerrs.ActionCreate, "vApp isolated network", err)
 		return
 	}
 
@@ -229,7 +234,7 @@ func (r *isolatedNetworkResource) Update(ctx context.Context, req resource.Updat
 	// Update network
 	_, err := r.vapp.UpdateNetwork(vappNetworkSettings, nil)
 	if err != nil {
-		resp.Diagnostics.AddError("Error updating vApp isolated network", err.Error())
+		cerrs.AddError(&resp.Diagnostics, cerrs.ActionUpdate, "vApp isolated network", err)
 		return
 	}
 
@@ -302,6 +307,41 @@ func (r *isolatedNetworkResource) ImportState(ctx context.Context, req resource.
 	}
 }
 
+// IdentitySpec returns the identity of the resource.
+// The isolated network is imported by its name, which can be updated.
+func (r *isolatedNetworkResource) IdentitySpec() identity.Spec {
+	return identity.Spec{
+		Attributes: []identity.Attribute{
+			{
+				Name:        "vdc",
+				Description: "The name of the VDC.",
+				Optional:    true,
+			},
+			{
+				Name:        "vapp_name",
+				Description: "The name of the vApp.",
+			},
+			{
+				Name:        "name",
+				Description: "The name of the isolated network.",
+			},
+		},
+		Mutable: true,
+	}
+}
+
+// MoveState returns the state movers from the equivalent resources of the VMware vcd provider.
+func (r *isolatedNetworkResource) MoveState(_ context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		movestate.FromVCD("vcd_vapp_network", movestate.Attributes{
+			"id":        "id",
+			"name":      "name",
+			"vapp_name": "vapp_name",
+			"vdc":       "vdc",
+		}),
+	}
+}
+
 // * CustomFuncs
 
 // read is a generic read function that can be used by the resource Create, Read and Update functions.
@@ -317,7 +357,7 @@ func (r *isolatedNetworkResource) read(ctx context.Context, planOrState *isolate
 	// Get UUID.
 	networkID, err := govcd.GetUuidFromHref(net.Link.HREF, false)
 	if err != nil {
-		diags.AddError("Error creating vApp network ID", err.Error())
+		cerrs.AddError(&diags, cerrs.ActionCreate, "vApp network ID", err)
 		return stateRefreshed, found, diags
 	}
 
@@ -36,6 +36,8 @@ import (
 	"github.com/orange-cloudavenue/cloudavenue-sdk-go/pkg/urn"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/metrics"
+	cerrs "github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/errors"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/identity"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/network"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/vapp"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/vdc"
@@ -44,9 +46,10 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &orgNetworkResource{}
-	_ resource.ResourceWithConfigure   = &orgNetworkResource{}
-	_ resource.ResourceWithImportState = &orgNetworkResource{}
+	_ resource.Resource                 = &orgNetworkResource{}
+	_ resource.ResourceWithConfigure    = &orgNetworkResource{}
+	_ resource.ResourceWithImportState  = &orgNetworkResource{}
+	_ identity.ResourceWithIdentitySpec = &orgNetworkResource{}
 )
 
 // NewOrgNetworkResource is a helper function to simplify the provider implementation.
@@ -149,7 +152,7 @@ func (r *orgNetworkResource) Create(ctx context.Context, req resource.CreateRequ
 	vappNetworkSettings := &govcd.VappNetworkSettings{RetainIpMacEnabled: utils.TakeBoolPointer(false)}
 	vAppNetworkConfig, err := r.vapp.AddOrgNetwork(vappNetworkSettings, orgNetwork.OrgVDCNetwork, false)
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating vApp network", err.Error())
+		cerrs.AddError(&resp.Diagnostics, cerrs.ActionCreate, "vApp network", err)
 		return
 	}
 
@@ -167,7 +170,7 @@ func (r *orgNetworkResource) Create(ctx context.Context, req resource.CreateRequ
 
 	networkID, err := govcd.GetUuidFromHref(vAppNetwork.Link.HREF, false)
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating vApp network ID", err.Error())
+		cerrs.AddError(&resp.Diagnostics, cerrs.ActionCreate, "vApp network ID", err)
 		return
 	}
 
@@ -360,3 +363,24 @@ func (r *orgNetworkResource) ImportState(ctx context.Context, req resource.Impor
 	// Set state to fully populated data
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
+
+// IdentitySpec returns the identity of the resource.
+func (r *orgNetworkResource) IdentitySpec() identity.Spec {
+	return identity.Spec{
+		Attributes: []identity.Attribute{
+			{
+				Name:        "vdc",
+				Description: "The name of the VDC.",
+				Optional:    true,
+			},
+			{
+				Name:        "vapp_name",
+				Description: "The name of the vApp.",
+			},
+			{
+				Name:        "network_name",
+				Description: "The name of the organization network.",
+			},
+		},
+	}
+}
@@ -0,0 +1,89 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package vapp
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/orange-cloudavenue/cloudavenue-sdk-go/pkg/urn"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/metrics"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/listresource"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/vdc"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ list.ListResource              = &vappListResource{}
+	_ list.ListResourceWithConfigure = &vappListResource{}
+)
+
+// NewVappListResource is a helper function to simplify the provider implementation.
+func NewVappListResource() list.ListResource {
+	return &vappListResource{
+		Base: listresource.NewBase(NewVappResource),
+	}
+}
+
+// vappListResource is the list resource implementation.
+type vappListResource struct {
+	listresource.Base
+}
+
+type vappListResourceModel struct {
+	VDC types.String `tfsdk:"vdc"`
+}
+
+// ListResourceConfigSchema defines the schema of the list resource configuration.
+func (r *vappListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{
+		MarkdownDescription: "The `cloudavenue_vapp` list resource allows you to list the vApps of a VDC.",
+		Attributes: map[string]listschema.Attribute{
+			"vdc": listschema.StringAttribute{
+				MarkdownDescription: "The name of vDC to use, optional if defined at provider level.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+// List lists the vApps of the VDC.
+func (r *vappListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	defer metrics.New("cloudavenue_vapp", r.Client.GetOrgName(), metrics.List)()
+
+	config := &vappListResourceModel{}
+	if diags := req.Config.Get(ctx, config); diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	v, diags := vdc.Init(r.Client, config.VDC)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	refs := v.GetVappList()
+	items := make([]listresource.Item, 0, len(refs))
+	for _, ref := range refs {
+		items = append(items, listresource.Item{
+			DisplayName: ref.Name,
+			Attributes: map[string]string{
+				"vdc": v.GetName(),
+				"id":  urn.Normalize(urn.VAPP, urn.ExtractUUID(ref.HREF)).String(),
+			},
+		})
+	}
+
+	stream.Results = r.Results(ctx, req, items)
+}
@@ -0,0 +1,154 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package vapp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	actionschema "github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/metrics"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/readonly"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/vapp"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/vdc"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/vm"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ action.Action              = &vappPowerAction{}
+	_ action.ActionWithConfigure = &vappPowerAction{}
+)
+
+// NewVAppPowerAction is a helper function to simplify the provider implementation.
+func NewVAppPowerAction() action.Action {
+	return &vappPowerAction{}
+}
+
+// vappPowerAction is the action implementation.
+type vappPowerAction struct {
+	client *client.CloudAvenue
+}
+
+type vappPowerActionModel struct {
+	VDC       types.String `tfsdk:"vdc"`
+	ID        types.String `tfsdk:"id"`
+	Name      types.String `tfsdk:"name"`
+	Operation types.String `tfsdk:"operation"`
+}
+
+// Metadata returns the action type name.
+func (a *vappPowerAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + categoryName + "_power"
+}
+
+// Schema defines the schema for the action.
+func (a *vappPowerAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = actionschema.Schema{
+		MarkdownDescription: "The `cloudavenue_vapp_power` action allows you to run a power operation on a vApp and all its VMs, for example from the `action_trigger` of a `lifecycle` block.",
+		Attributes: map[string]actionschema.Attribute{
+			"vdc": actionschema.StringAttribute{
+				MarkdownDescription: "The name of vDC to use, optional if defined at provider level.",
+				Optional:            true,
+			},
+			"id": actionschema.StringAttribute{
+				MarkdownDescription: "The ID of the vApp.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("id"), path.MatchRoot("name")),
+				},
+			},
+			"name": actionschema.StringAttribute{
+				MarkdownDescription: "The name of the vApp.",
+				Optional:            true,
+			},
+			"operation": actionschema.StringAttribute{
+				MarkdownDescription: "The power operation to run. The `power_on`, `power_off`, `shutdown_guest` and `suspend` operations do nothing if the vApp is already in the status they lead to. `power_off` undeploys the vApp and `shutdown_guest` shuts down the guest OS of the VMs before undeploying the vApp.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(vm.PowerOperationsString()...),
+				},
+			},
+		},
+	}
+}
+
+func (a *vappPowerAction) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.CloudAvenue)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *client.CloudAvenue, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	a.client = client
+}
+
+// Invoke runs the power operation on the vApp.
+func (a *vappPowerAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	defer metrics.New("cloudavenue_vapp_power", a.client.GetOrgName(), metrics.Invoke)()
+
+	if a.client.IsReadOnly() {
+		resp.Diagnostics.Append(readonly.Error("cloudavenue_vapp_power", "invoked"))
+		return
+	}
+
+	config := &vappPowerActionModel{}
+	resp.Diagnostics.Append(req.Config.Get(ctx, config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	v, diags := vdc.Init(a.client, config.VDC)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	vApp, err := vapp.Init(a.client, v, config.ID, config.Name)
+	if err != nil {
+		resp.Diagnostics.AddError("Error retrieving vApp", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(vApp.LockVAPP(ctx)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer vApp.UnlockVAPP(ctx)
+
+	operation := vm.PowerOperation(config.Operation.ValueString())
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Running %s on vApp %s", operation, vApp.GetName()),
+	})
+
+	if err := vm.PowerVAPP(ctx, vApp, operation); err != nil {
+		resp.Diagnostics.AddError("Error running vApp power operation", err.Error())
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("%s on vApp %s completed", operation, vApp.GetName()),
+	})
+}
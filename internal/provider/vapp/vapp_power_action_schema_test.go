@@ -0,0 +1,40 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package vapp_test
+
+import (
+	"testing"
+
+	fwaction "github.com/hashicorp/terraform-plugin-framework/action"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/vapp"
+)
+
+func TestVAppPowerActionSchema(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	schemaRequest := fwaction.SchemaRequest{}
+	schemaResponse := &fwaction.SchemaResponse{}
+
+	// Instantiate the action.Action and call its Schema method
+	vapp.NewVAppPowerAction().Schema(ctx, schemaRequest, schemaResponse)
+
+	if schemaResponse.Diagnostics.HasError() {
+		t.Fatalf("Schema method diagnostics: %+v", schemaResponse.Diagnostics)
+	}
+
+	// Validate the schema
+	diagnostics := schemaResponse.Schema.ValidateImplementation(ctx)
+
+	if diagnostics.HasError() {
+		t.Fatalf("Schema validation diagnostics: %+v", diagnostics)
+	}
+}
@@ -43,6 +43,7 @@ import (
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/metrics"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/adminorg"
 	cerrs "github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/errors"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/identity"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/vapp"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/vdc"
 )
@@ -51,9 +52,10 @@ const vappSubsystem = "vapp"
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &vappResource{}
-	_ resource.ResourceWithConfigure   = &vappResource{}
-	_ resource.ResourceWithImportState = &vappResource{}
+	_ resource.Resource                 = &vappResource{}
+	_ resource.ResourceWithConfigure    = &vappResource{}
+	_ resource.ResourceWithImportState  = &vappResource{}
+	_ identity.ResourceWithIdentitySpec = &vappResource{}
 )
 
 // NewVappResource is a helper function to simplify the provider implementation.
@@ -140,9 +142,14 @@ func (r *vappResource) Create(ctx context.Context, req resource.CreateRequest, r
 		tflog.SubsystemDebug(ctx, vappSubsystem, "Creating vApp", map[string]interface{}{attrVappName: plan.VAppName.ValueString(), attrVDC: plan.VDC.ValueString()})
 	}
 
-	// Wait for job to complete
+	// Wait for job to complete. The wait is bounded by its own timeout, the
+	// retry policy of the client only retries the transient API errors.
 	errRetry := retry.RetryContext(ctx, 90*time.Second, func() *retry.RetryError {
-		currentStatus, errGetStatus := r.vapp.GetStatus()
+		var currentStatus string
+		errGetStatus := r.client.Retry(ctx, func() (err error) {
+			currentStatus, err = r.vapp.GetStatus()
+			return err
+		})
 		if errGetStatus != nil {
 			tflog.SubsystemError(ctx, vappSubsystem, "vApp status read failed", map[string]interface{}{attrVappName: plan.VAppName.ValueString()})
 			return retry.NonRetryableError(errGetStatus)
@@ -371,6 +378,23 @@ func (r *vappResource) ImportState(ctx context.Context, req resource.ImportState
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("vdc"), r.vdc.GetName())...)
 }
 
+// IdentitySpec returns the identity of the resource.
+func (r *vappResource) IdentitySpec() identity.Spec {
+	return identity.Spec{
+		Attributes: []identity.Attribute{
+			{
+				Name:        "vdc",
+				Description: "The name of the VDC.",
+				Optional:    true,
+			},
+			{
+				Name:        "id",
+				Description: "The ID of the vApp.",
+			},
+		},
+	}
+}
+
 // tryUndeploy try to undeploy a vApp, but do not throw an error if the vApp is powered off.
 // Very often the vApp is powered off at this point and Undeploy() would fail with error:
 // "The requested operation could not be executed since vApp vApp_name is not running"
@@ -424,7 +448,7 @@ func (r *vappResource) updateVapp(ctx context.Context, plan, state *vappResource
 	// Update description if needed
 	if !plan.Description.Equal(state.Description) {
 		if err := r.vapp.UpdateDescription(plan.Description.ValueString()); err != nil {
-			diags.AddError("Error updating vApp description", err.Error())
+			cerrs.AddError(&diags, cerrs.ActionUpdate, "vApp description", err)
 			return diags
 		}
 	}
@@ -459,7 +483,7 @@ func (r *vappResource) updateVapp(ctx context.Context, plan, state *vappResource
 			}
 		}
 		if _, err := r.vapp.SetProductSectionList(x); err != nil {
-			diags.AddError("Error updating vApp guest properties", err.Error())
+			cerrs.AddError(&diags, cerrs.ActionUpdate, "vApp guest properties", err)
 			return diags
 		}
 	}
@@ -34,14 +34,16 @@ import (
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/metrics"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/cloudavenue"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/identity"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/pkg/utils"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &vcdaIPResource{}
-	_ resource.ResourceWithConfigure   = &vcdaIPResource{}
-	_ resource.ResourceWithImportState = &vcdaIPResource{}
+	_ resource.Resource                 = &vcdaIPResource{}
+	_ resource.ResourceWithConfigure    = &vcdaIPResource{}
+	_ resource.ResourceWithImportState  = &vcdaIPResource{}
+	_ identity.ResourceWithIdentitySpec = &vcdaIPResource{}
 )
 
 // NewVcdaIPResource is a helper function to simplify the provider implementation.
@@ -98,7 +100,10 @@ func (r *vcdaIPResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	cloudavenue.Lock(ctx)
+	if err := cloudavenue.Lock(ctx); err != nil {
+		resp.Diagnostics.AddError("Error acquiring the lock", err.Error())
+		return
+	}
 	defer cloudavenue.Unlock(ctx)
 
 	listOfIPS, err := r.vcda.List()
@@ -171,7 +176,10 @@ func (r *vcdaIPResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
-	cloudavenue.Lock(ctx)
+	if err := cloudavenue.Lock(ctx); err != nil {
+		resp.Diagnostics.AddError("Error acquiring the lock", err.Error())
+		return
+	}
 	defer cloudavenue.Unlock(ctx)
 
 	listOfIPs, err := r.vcda.List()
@@ -198,6 +206,18 @@ func (r *vcdaIPResource) ImportState(ctx context.Context, req resource.ImportSta
 	).String()))...)
 }
 
+// IdentitySpec returns the identity of the resource.
+func (r *vcdaIPResource) IdentitySpec() identity.Spec {
+	return identity.Spec{
+		Attributes: []identity.Attribute{
+			{
+				Name:        "ip_address",
+				Description: "The IP address allowed to access VCDA.",
+			},
+		},
+	}
+}
+
 // read is a generic read function that can be used by the resource Create, Read and Update functions.
 func (r *vcdaIPResource) read(_ context.Context, planOrState *vcdaIPResourceModel) (stateRefreshed *vcdaIPResourceModel, found bool, diags diag.Diagnostics) {
 	stateRefreshed = planOrState.Copy()
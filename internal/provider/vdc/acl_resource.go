@@ -34,14 +34,19 @@ import (
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/metrics"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/acl"
+	cerrs "github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/errors"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/identity"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/movestate"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/vdc"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &aclResource{}
-	_ resource.ResourceWithConfigure   = &aclResource{}
-	_ resource.ResourceWithImportState = &aclResource{}
+	_ resource.Resource                 = &aclResource{}
+	_ resource.ResourceWithConfigure    = &aclResource{}
+	_ resource.ResourceWithImportState  = &aclResource{}
+	_ resource.ResourceWithMoveState    = &aclResource{}
+	_ identity.ResourceWithIdentitySpec = &aclResource{}
 )
 
 // NewACLResource is a helper function to simplify the provider implementation.
@@ -258,7 +263,7 @@ func (r *aclResource) Delete(ctx context.Context, req resource.DeleteRequest, re
 
 	// Delete vDC access control
 	if _, err := r.vdc.DeleteControlAccess(true); err != nil {
-		resp.Diagnostics.AddError("Error deleting control access", err.Error())
+		cerrs.AddError(&resp.Diagnostics, cerrs.ActionDelete, "control access", err)
 		return
 	}
 }
@@ -269,6 +274,27 @@ func (r *aclResource) ImportState(ctx context.Context, req resource.ImportStateR
 	resource.ImportStatePassthroughID(ctx, path.Root("vdc"), req, resp)
 }
 
+// IdentitySpec returns the identity of the resource.
+func (r *aclResource) IdentitySpec() identity.Spec {
+	return identity.Spec{
+		Attributes: []identity.Attribute{
+			{
+				Name:        "vdc",
+				Description: "The name of the VDC.",
+			},
+		},
+	}
+}
+
+// MoveState returns the state movers from the equivalent resources of the VMware vcd provider.
+func (r *aclResource) MoveState(_ context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		movestate.FromVCD("vcd_org_vdc_access_control", movestate.Attributes{
+			"vdc": "vdc",
+		}),
+	}
+}
+
 func (r *aclResource) createOrUpdateACL(ctx context.Context, plan *aclResourceModel) (*aclResourceModel, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
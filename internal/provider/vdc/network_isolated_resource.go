@@ -34,14 +34,18 @@ import (
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/metrics"
 	cerrs "github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/errors"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/identity"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/movestate"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/vdc"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &NetworkIsolatedResource{}
-	_ resource.ResourceWithConfigure   = &NetworkIsolatedResource{}
-	_ resource.ResourceWithImportState = &NetworkIsolatedResource{}
+	_ resource.Resource                 = &NetworkIsolatedResource{}
+	_ resource.ResourceWithConfigure    = &NetworkIsolatedResource{}
+	_ resource.ResourceWithImportState  = &NetworkIsolatedResource{}
+	_ resource.ResourceWithMoveState    = &NetworkIsolatedResource{}
+	_ identity.ResourceWithIdentitySpec = &NetworkIsolatedResource{}
 )
 
 // NewNetworkIsolatedResource is a helper function to simplify the provider implementation.
@@ -118,7 +122,7 @@ func (r *NetworkIsolatedResource) Create(ctx context.Context, req resource.Creat
 
 	networkIsolated, err := r.vdc.CreateNetworkIsolated(values)
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating isolated network", err.Error())
+		cerrs.AddError(&resp.Diagnostics, cerrs.ActionCreate, "isolated network", err)
 		return
 	}
 
@@ -215,7 +219,7 @@ func (r *NetworkIsolatedResource) Update(ctx context.Context, req resource.Updat
 
 	// Update the network
 	if err := net.Update(values); err != nil {
-		resp.Diagnostics.AddError("Error updating isolated network", err.Error())
+		cerrs.AddError(&resp.Diagnostics, cerrs.ActionUpdate, "isolated network", err)
 		return
 	}
 
@@ -265,7 +269,7 @@ func (r *NetworkIsolatedResource) Delete(ctx context.Context, req resource.Delet
 
 	// Delete the network
 	if err := net.Delete(); err != nil {
-		resp.Diagnostics.AddError("Error deleting isolated network", err.Error())
+		cerrs.AddError(&resp.Diagnostics, cerrs.ActionDelete, "isolated network", err)
 		return
 	}
 }
@@ -317,6 +321,33 @@ func (r *NetworkIsolatedResource) ImportState(ctx context.Context, req resource.
 	resp.Diagnostics.Append(resp.State.Set(ctx, stateRefreshed)...)
 }
 
+// IdentitySpec returns the identity of the resource.
+func (r *NetworkIsolatedResource) IdentitySpec() identity.Spec {
+	return identity.Spec{
+		Attributes: []identity.Attribute{
+			{
+				Name:        "vdc",
+				Description: "The name of the VDC.",
+			},
+			{
+				Name:        "id",
+				Description: "The ID of the isolated network.",
+			},
+		},
+	}
+}
+
+// MoveState returns the state movers from the equivalent resources of the VMware vcd provider.
+func (r *NetworkIsolatedResource) MoveState(_ context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		movestate.FromVCD("vcd_network_isolated_v2", movestate.Attributes{
+			"id":   "id",
+			"name": "name",
+			"vdc":  "vdc",
+		}),
+	}
+}
+
 // * CustomFuncs
 
 // read is a generic read function that can be used by the resource Create, Read and Update functions.
@@ -0,0 +1,68 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package vdc
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/metrics"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/listresource"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ list.ListResource              = &vdcListResource{}
+	_ list.ListResourceWithConfigure = &vdcListResource{}
+)
+
+// NewVDCListResource is a helper function to simplify the provider implementation.
+func NewVDCListResource() list.ListResource {
+	return &vdcListResource{
+		Base: listresource.NewBase(NewVDCResource),
+	}
+}
+
+// vdcListResource is the list resource implementation.
+type vdcListResource struct {
+	listresource.Base
+}
+
+// ListResourceConfigSchema defines the schema of the list resource configuration.
+func (r *vdcListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{
+		MarkdownDescription: "The `cloudavenue_vdc` list resource allows you to list the VDCs of the organization.",
+	}
+}
+
+// List lists the VDCs of the organization.
+func (r *vdcListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	defer metrics.New("cloudavenue_vdc", r.Client.GetOrgName(), metrics.List)()
+
+	vdcs, err := r.Client.CAVSDK.V1.Querier().List().VDC()
+	if err != nil {
+		stream.Results = listresource.Error("Unable to list VDCs", err)
+		return
+	}
+
+	items := make([]listresource.Item, 0, len(vdcs))
+	for _, v := range vdcs {
+		items = append(items, listresource.Item{
+			DisplayName: v.Name,
+			Attributes: map[string]string{
+				"name": v.Name,
+			},
+		})
+	}
+
+	stream.Results = r.Results(ctx, req, items)
+}
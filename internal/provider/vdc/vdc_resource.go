@@ -34,12 +34,16 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 
+	v1 "github.com/orange-cloudavenue/cloudavenue-sdk-go/v1"
 	"github.com/orange-cloudavenue/cloudavenue-sdk-go/v1/infrapi"
 	"github.com/orange-cloudavenue/cloudavenue-sdk-go/v1/infrapi/rules"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/metrics"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/cloudavenue"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/deletionprotection"
 	cerrs "github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/errors"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/identity"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/movestate"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
@@ -49,10 +53,18 @@ var (
 	_ resource.ResourceWithImportState    = &vdcResource{}
 	_ resource.ResourceWithValidateConfig = &vdcResource{}
 	_ resource.ResourceWithModifyPlan     = &vdcResource{}
+	_ resource.ResourceWithMoveState      = &vdcResource{}
+	_ identity.ResourceWithIdentitySpec   = &vdcResource{}
 )
 
 var validationDisabled = os.Getenv(envVarValidation) == "false"
 
+// vdcAttributePaths are the attributes causing the API errors of a VDC.
+var vdcAttributePaths = cerrs.AttributePaths{
+	cerrs.KindConflict:         path.Root("name"),
+	cerrs.KindInvalidReference: path.Root("storage_profiles"),
+}
+
 const (
 	envVarValidation = "CLOUDAVENUE_VDC_VALIDATION"
 )
@@ -176,6 +188,8 @@ func (r *vdcResource) ValidateConfig(ctx context.Context, req resource.ValidateC
 }
 
 func (r *vdcResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	defer deletionprotection.ModifyPlan(ctx, "cloudavenue_vdc", req, resp)
+
 	var (
 		plan  = new(vdcResourceModel)
 		state = new(vdcResourceModel)
@@ -223,7 +237,10 @@ func (r *vdcResource) Create(ctx context.Context, req resource.CreateRequest, re
 		}
 	}
 
-	cloudavenue.Lock(ctx)
+	if err := cloudavenue.Lock(ctx); err != nil {
+		resp.Diagnostics.AddError("Error acquiring the lock", err.Error())
+		return
+	}
 	defer cloudavenue.Unlock(ctx)
 
 	// Create() is passed a default timeout to use if no value
@@ -248,7 +265,7 @@ func (r *vdcResource) Create(ctx context.Context, req resource.CreateRequest, re
 
 	_, err := r.client.CAVSDK.V1.VDC().New(ctx, body)
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating VDC", fmt.Sprintf("error creating VDC %s: %s", plan.Name.Get(), err.Error()))
+		cerrs.AddAttributeError(&resp.Diagnostics, vdcAttributePaths, cerrs.ActionCreate, "VDC "+plan.Name.Get(), err)
 		return
 	}
 
@@ -331,8 +348,21 @@ func (r *vdcResource) Update(ctx context.Context, req resource.UpdateRequest, re
 		}
 	}
 
-	cloudavenue.Lock(ctx)
-	defer cloudavenue.Unlock(ctx)
+	// The deletion protection is only stored in the state, the VDC is not
+	// updated, nor locked, when it is the only change.
+	updateVDC := !plan.Description.Equal(state.Description) ||
+		!plan.VCPUInMhz.Equal(state.VCPUInMhz) ||
+		!plan.CPUAllocated.Equal(state.CPUAllocated) ||
+		!plan.MemoryAllocated.Equal(state.MemoryAllocated) ||
+		!plan.StorageProfiles.Equal(state.StorageProfiles)
+
+	if updateVDC {
+		if err := cloudavenue.Lock(ctx); err != nil {
+			resp.Diagnostics.AddError("Error acquiring the lock", err.Error())
+			return
+		}
+		defer cloudavenue.Unlock(ctx)
+	}
 
 	// Update() is passed a default timeout to use if no value
 	// has been supplied in the Terraform configuration.
@@ -348,48 +378,46 @@ func (r *vdcResource) Update(ctx context.Context, req resource.UpdateRequest, re
 	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
 	defer cancel()
 
-	vdc, err := r.client.CAVSDK.V1.VDC().GetVDC(plan.Name.Get())
-	if err != nil {
-		resp.Diagnostics.AddError("Error reading VDC", fmt.Sprintf("error reading VDC %s: %s", plan.Name.Get(), err.Error()))
-		return
-	}
-
-	if vdc == nil {
-		resp.Diagnostics.AddError("Error updating VDC", fmt.Sprintf("VDC %q was returned as nil by the API without an error, this is likely a transient issue, please retry", plan.Name.Get()))
-		return
-	}
+	if updateVDC {
+		vdc, err := r.getVDC(ctx, plan.Name.Get())
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading VDC", fmt.Sprintf("error reading VDC %s: %s", plan.Name.Get(), err.Error()))
+			return
+		}
 
-	vdc.SetDescription(plan.Description.Get())
-	vdc.SetVCPUInMhz(plan.VCPUInMhz.GetInt())
-	vdc.SetCPUAllocated(plan.CPUAllocated.GetInt())
-	vdc.SetMemoryAllocated(plan.MemoryAllocated.GetInt())
+		vdc.SetDescription(plan.Description.Get())
+		vdc.SetVCPUInMhz(plan.VCPUInMhz.GetInt())
+		vdc.SetCPUAllocated(plan.CPUAllocated.GetInt())
+		vdc.SetMemoryAllocated(plan.MemoryAllocated.GetInt())
 
-	storageProfiles, d := plan.StorageProfiles.Get(ctx)
-	resp.Diagnostics.Append(d...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
+		storageProfiles, d := plan.StorageProfiles.Get(ctx)
+		resp.Diagnostics.Append(d...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
 
-	vdcStorageProfiles := make([]infrapi.StorageProfile, 0)
+		vdcStorageProfiles := make([]infrapi.StorageProfile, 0)
 
-	for _, storageProfile := range storageProfiles {
-		vdcStorageProfiles = append(vdcStorageProfiles, infrapi.StorageProfile{
-			Class:   infrapi.StorageProfileClass(storageProfile.Class.Get()),
-			Limit:   storageProfile.Limit.GetInt(),
-			Default: storageProfile.Default.Get(),
-		})
-	}
+		for _, storageProfile := range storageProfiles {
+			vdcStorageProfiles = append(vdcStorageProfiles, infrapi.StorageProfile{
+				Class:   infrapi.StorageProfileClass(storageProfile.Class.Get()),
+				Limit:   storageProfile.Limit.GetInt(),
+				Default: storageProfile.Default.Get(),
+			})
+		}
 
-	vdc.SetStorageProfiles(vdcStorageProfiles)
+		vdc.SetStorageProfiles(vdcStorageProfiles)
 
-	if err := vdc.Update(ctx); err != nil {
-		resp.Diagnostics.AddError("Error updating VDC", fmt.Sprintf("error updating VDC %s: %s", plan.Name.Get(), err.Error()))
-		return
+		if err := vdc.Update(ctx); err != nil {
+			cerrs.AddAttributeError(&resp.Diagnostics, vdcAttributePaths, cerrs.ActionUpdate, "VDC "+plan.Name.Get(), err)
+			return
+		}
 	}
 
 	if !plan.Timeouts.Equal(state.Timeouts) {
 		state.Timeouts = plan.Timeouts
 	}
+	state.DeletionProtection = plan.DeletionProtection
 
 	stateRefreshed, found, d := r.read(ctx, state)
 	if !found {
@@ -417,7 +445,15 @@ func (r *vdcResource) Delete(ctx context.Context, req resource.DeleteRequest, re
 		return
 	}
 
-	cloudavenue.Lock(ctx)
+	resp.Diagnostics.Append(deletionprotection.CheckDelete(ctx, "cloudavenue_vdc", req.State)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := cloudavenue.Lock(ctx); err != nil {
+		resp.Diagnostics.AddError("Error acquiring the lock", err.Error())
+		return
+	}
 	defer cloudavenue.Unlock(ctx)
 
 	// Delete() is passed a default timeout to use if no value
@@ -436,7 +472,7 @@ func (r *vdcResource) Delete(ctx context.Context, req resource.DeleteRequest, re
 
 	name := strings.TrimSpace(state.Name.Get())
 
-	vdc, err := r.client.CAVSDK.V1.VDC().GetVDC(name)
+	vdc, err := r.getVDC(ctx, name)
 	if err != nil {
 		if cerrs.IsNotFound(err) {
 			return // VDC already deleted, nothing to do
@@ -445,11 +481,6 @@ func (r *vdcResource) Delete(ctx context.Context, req resource.DeleteRequest, re
 		return
 	}
 
-	if vdc == nil {
-		resp.Diagnostics.AddError("Error deleting VDC", fmt.Sprintf("VDC %q was returned as nil by the API without an error, this is likely a transient issue, please retry", state.Name.Get()))
-		return
-	}
-
 	errRetry := retry.RetryContext(ctx, deleteTimeout, func() *retry.RetryError {
 		if !r.vdcStillExists(name) {
 			return nil // VDC is gone, deletion is complete
@@ -485,7 +516,7 @@ func (r *vdcResource) Delete(ctx context.Context, req resource.DeleteRequest, re
 	})
 
 	if errRetry != nil {
-		resp.Diagnostics.AddError("Error deleting vdc", fmt.Sprintf("error deleting vdc %s: %s", state.Name.Get(), errRetry.Error()))
+		cerrs.AddError(&resp.Diagnostics, cerrs.ActionDelete, "VDC "+state.Name.Get(), errRetry)
 		return
 	}
 }
@@ -501,6 +532,26 @@ func (r *vdcResource) vdcStillExists(name string) bool {
 	return vdc != nil
 }
 
+// getVDC returns the VDC with the given name. The API may transiently return
+// a nil VDC without an error, the call is retried according to the retry
+// policy of the provider in that case.
+func (r *vdcResource) getVDC(ctx context.Context, name string) (vdc *v1.VDC, err error) {
+	err = r.client.Retry(ctx, func() error {
+		vdc, err = r.client.CAVSDK.V1.VDC().GetVDC(name)
+		if err != nil {
+			return err
+		}
+		if vdc == nil {
+			return client.RetryableError(fmt.Errorf("VDC %q was returned as nil by the API without an error", name))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return vdc, nil
+}
+
 func (r *vdcResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	defer metrics.New("cloudavenue_vdc", r.client.GetOrgName(), metrics.Import)()
 
@@ -508,12 +559,34 @@ func (r *vdcResource) ImportState(ctx context.Context, req resource.ImportStateR
 	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
 }
 
+// IdentitySpec returns the identity of the resource.
+func (r *vdcResource) IdentitySpec() identity.Spec {
+	return identity.Spec{
+		Attributes: []identity.Attribute{
+			{
+				Name:        "name",
+				Description: "The name of the VDC.",
+			},
+		},
+	}
+}
+
+// MoveState returns the state movers from the equivalent resources of the VMware vcd provider.
+func (r *vdcResource) MoveState(_ context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		movestate.FromVCD("vcd_org_vdc", movestate.Attributes{
+			"id":   "id",
+			"name": "name",
+		}),
+	}
+}
+
 // * Custom Functions.
 // read is a generic function to read a resource.
 func (r *vdcResource) read(ctx context.Context, planOrState *vdcResourceModel) (stateRefreshed *vdcResourceModel, found bool, diags diag.Diagnostics) {
 	stateRefreshed = planOrState.Copy()
 
-	vdc, err := r.client.CAVSDK.V1.VDC().GetVDC(planOrState.Name.Get())
+	vdc, err := r.getVDC(ctx, planOrState.Name.Get())
 	if err != nil {
 		if cerrs.IsNotFound(err) {
 			return nil, false, nil
@@ -522,11 +595,6 @@ func (r *vdcResource) read(ctx context.Context, planOrState *vdcResourceModel) (
 		return nil, true, diags
 	}
 
-	if vdc == nil {
-		diags.AddError("Error reading VDC", fmt.Sprintf("VDC %q was returned as nil by the API without an error, this is likely a transient issue, please retry", planOrState.Name.Get()))
-		return nil, true, diags
-	}
-
 	stateRefreshed.ID.Set(vdc.GetID())
 
 	// The SDK's GetName only returns a value when the VDC is resolved through
@@ -545,6 +613,11 @@ func (r *vdcResource) read(ctx context.Context, planOrState *vdcResourceModel) (
 	stateRefreshed.CPUAllocated.SetInt(vdc.GetCPUAllocated())
 	stateRefreshed.MemoryAllocated.SetInt(vdc.GetMemoryAllocated())
 
+	// The states written before the protection existed are not protected.
+	if !stateRefreshed.DeletionProtection.IsKnown() {
+		stateRefreshed.DeletionProtection.Set(false)
+	}
+
 	storageProfiles := make([]*vdcResourceModelVDCStorageProfile, 0)
 	for _, storageProfile := range vdc.GetStorageProfiles() {
 		p := new(vdcResourceModelVDCStorageProfile)
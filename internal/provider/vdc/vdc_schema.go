@@ -35,6 +35,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 
 	"github.com/orange-cloudavenue/cloudavenue-sdk-go/v1/infrapi/rules"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/deletionprotection"
 )
 
 const seeVDCRules = "See [Rules](https://registry.terraform.io/providers/orange-cloudavenue/cloudavenue/latest/docs/resources/vdc#rules) for more information."
@@ -229,6 +231,9 @@ func vdcSchema() superschema.Schema {
 					Computed: true,
 				},
 			},
+			deletionprotection.AttributeName: superschema.SuperBoolAttribute{
+				Resource: deletionprotection.ResourceAttribute(),
+			},
 			"storage_profiles": superschema.SuperSetNestedAttributeOf[vdcResourceModelVDCStorageProfile]{
 				Common: &schemaR.SetNestedAttribute{
 					MarkdownDescription: "List of storage profiles for this vDC.",
@@ -46,6 +46,7 @@ type (
 		MemoryAllocated     supertypes.Int64Value                                                `tfsdk:"memory_allocated"`
 		StorageBillingModel supertypes.StringValue                                               `tfsdk:"storage_billing_model"`
 		StorageProfiles     supertypes.SetNestedObjectValueOf[vdcResourceModelVDCStorageProfile] `tfsdk:"storage_profiles"`
+		DeletionProtection  supertypes.BoolValue                                                 `tfsdk:"deletion_protection"`
 	}
 
 	vdcResourceModelVDCStorageProfile struct {
@@ -33,6 +33,7 @@ import (
 	v1 "github.com/orange-cloudavenue/cloudavenue-sdk-go/v1"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/metrics"
+	cerrs "github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/errors"
 )
 
 var (
@@ -128,7 +129,7 @@ func (d *AppPortProfileDataSource) Read(ctx context.Context, req datasource.Read
 			resp.Diagnostics.AddError("App Port Profile not found", err.Error())
 			return
 		}
-		resp.Diagnostics.AddError("Error reading App Port Profile", err.Error())
+		cerrs.AddError(&resp.Diagnostics, cerrs.ActionRead, "App Port Profile", err)
 		return
 	}
 
@@ -35,14 +35,17 @@ import (
 	v1 "github.com/orange-cloudavenue/cloudavenue-sdk-go/v1"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/metrics"
+	cerrs "github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/errors"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/identity"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/mutex"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &AppPortProfileResource{}
-	_ resource.ResourceWithConfigure   = &AppPortProfileResource{}
-	_ resource.ResourceWithImportState = &AppPortProfileResource{}
+	_ resource.Resource                 = &AppPortProfileResource{}
+	_ resource.ResourceWithConfigure    = &AppPortProfileResource{}
+	_ resource.ResourceWithImportState  = &AppPortProfileResource{}
+	_ identity.ResourceWithIdentitySpec = &AppPortProfileResource{}
 )
 
 // NewAppPortProfileResource is a helper function to simplify the provider implementation.
@@ -123,7 +126,10 @@ func (r *AppPortProfileResource) Create(ctx context.Context, req resource.Create
 		Implement the resource creation logic here.
 	*/
 
-	mutex.GlobalMutex.KvLock(ctx, r.vdcGroup.GetID())
+	if err := mutex.GlobalMutex.KvLock(ctx, r.vdcGroup.GetID()); err != nil {
+		resp.Diagnostics.AddError("Error acquiring the lock", err.Error())
+		return
+	}
 	defer mutex.GlobalMutex.KvUnlock(ctx, r.vdcGroup.GetID())
 
 	appPortProfileModel, d := plan.toSDKAppPortProfile(ctx)
@@ -135,7 +141,7 @@ func (r *AppPortProfileResource) Create(ctx context.Context, req resource.Create
 	// Create the application port profile
 	appPortProfile, err := r.vdcGroup.CreateFirewallAppPortProfile(appPortProfileModel)
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating application port profile", err.Error())
+		cerrs.AddError(&resp.Diagnostics, cerrs.ActionCreate, "application port profile", err)
 		return
 	}
 
@@ -216,7 +222,10 @@ func (r *AppPortProfileResource) Update(ctx context.Context, req resource.Update
 		Implement the resource update here
 	*/
 
-	mutex.GlobalMutex.KvLock(ctx, r.vdcGroup.GetID())
+	if err := mutex.GlobalMutex.KvLock(ctx, r.vdcGroup.GetID()); err != nil {
+		resp.Diagnostics.AddError("Error acquiring the lock", err.Error())
+		return
+	}
 	defer mutex.GlobalMutex.KvUnlock(ctx, r.vdcGroup.GetID())
 
 	appPortProfile, err := r.vdcGroup.GetFirewallAppPortProfile(state.ID.Get())
@@ -233,7 +242,7 @@ func (r *AppPortProfileResource) Update(ctx context.Context, req resource.Update
 
 	// Update the application port profile
 	if err := appPortProfile.Update(appPortProfileModel); err != nil {
-		resp.Diagnostics.AddError("Error updating application port profile", err.Error())
+		cerrs.AddError(&resp.Diagnostics, cerrs.ActionUpdate, "application port profile", err)
 		return
 	}
 
@@ -274,7 +283,10 @@ func (r *AppPortProfileResource) Delete(ctx context.Context, req resource.Delete
 		Implement the resource deletion here
 	*/
 
-	mutex.GlobalMutex.KvLock(ctx, r.vdcGroup.GetID())
+	if err := mutex.GlobalMutex.KvLock(ctx, r.vdcGroup.GetID()); err != nil {
+		resp.Diagnostics.AddError("Error acquiring the lock", err.Error())
+		return
+	}
 	defer mutex.GlobalMutex.KvUnlock(ctx, r.vdcGroup.GetID())
 
 	appPortProfile, err := r.vdcGroup.GetFirewallAppPortProfile(state.ID.Get())
@@ -285,7 +297,7 @@ func (r *AppPortProfileResource) Delete(ctx context.Context, req resource.Delete
 
 	// Delete the application port profile
 	if err := appPortProfile.Delete(); err != nil {
-		resp.Diagnostics.AddError("Error deleting application port profile", err.Error())
+		cerrs.AddError(&resp.Diagnostics, cerrs.ActionDelete, "application port profile", err)
 		return
 	}
 }
@@ -339,6 +351,22 @@ func (r *AppPortProfileResource) ImportState(ctx context.Context, req resource.I
 	resp.Diagnostics.Append(resp.State.Set(ctx, stateRefreshed)...)
 }
 
+// IdentitySpec returns the identity of the resource.
+func (r *AppPortProfileResource) IdentitySpec() identity.Spec {
+	return identity.Spec{
+		Attributes: []identity.Attribute{
+			{
+				Name:        "vdc_group_id",
+				Description: "The ID of the VDC group.",
+			},
+			{
+				Name:        "id",
+				Description: "The ID of the app port profile.",
+			},
+		},
+	}
+}
+
 // * CustomFuncs
 
 // read is a generic read function that can be used by the resource Create, Read and Update functions.
@@ -361,7 +389,7 @@ func (r *AppPortProfileResource) read(ctx context.Context, planOrState *AppPortP
 		if govcd.IsNotFound(err) {
 			return nil, false, nil
 		}
-		diags.AddError("Error reading App Port Profile", err.Error())
+		cerrs.AddError(&diags, cerrs.ActionRead, "App Port Profile", err)
 		return stateRefreshed, found, diags
 	}
 
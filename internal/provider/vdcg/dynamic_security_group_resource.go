@@ -35,14 +35,17 @@ import (
 	v1 "github.com/orange-cloudavenue/cloudavenue-sdk-go/v1"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/metrics"
+	cerrs "github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/errors"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/identity"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/mutex"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &DynamicSecurityGroupResource{}
-	_ resource.ResourceWithConfigure   = &DynamicSecurityGroupResource{}
-	_ resource.ResourceWithImportState = &DynamicSecurityGroupResource{}
+	_ resource.Resource                 = &DynamicSecurityGroupResource{}
+	_ resource.ResourceWithConfigure    = &DynamicSecurityGroupResource{}
+	_ resource.ResourceWithImportState  = &DynamicSecurityGroupResource{}
+	_ identity.ResourceWithIdentitySpec = &DynamicSecurityGroupResource{}
 )
 
 // NewDynamicSecurityGroupResource is a helper function to simplify the provider implementation.
@@ -123,7 +126,10 @@ func (r *DynamicSecurityGroupResource) Create(ctx context.Context, req resource.
 		Implement the resource creation logic here.
 	*/
 
-	mutex.GlobalMutex.KvLock(ctx, r.vdcGroup.GetID())
+	if err := mutex.GlobalMutex.KvLock(ctx, r.vdcGroup.GetID()); err != nil {
+		resp.Diagnostics.AddError("Error acquiring the lock", err.Error())
+		return
+	}
 	defer mutex.GlobalMutex.KvUnlock(ctx, r.vdcGroup.GetID())
 
 	values, d := plan.ToSDKDynamicSecurityGroupModel(ctx)
@@ -134,7 +140,7 @@ func (r *DynamicSecurityGroupResource) Create(ctx context.Context, req resource.
 
 	fwsg, err := r.vdcGroup.CreateFirewallDynamicSecurityGroup(values)
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating dynamic security group", err.Error())
+		cerrs.AddError(&resp.Diagnostics, cerrs.ActionCreate, "dynamic security group", err)
 		return
 	}
 
@@ -215,7 +221,10 @@ func (r *DynamicSecurityGroupResource) Update(ctx context.Context, req resource.
 		Implement the resource update here
 	*/
 
-	mutex.GlobalMutex.KvLock(ctx, r.vdcGroup.GetID())
+	if err := mutex.GlobalMutex.KvLock(ctx, r.vdcGroup.GetID()); err != nil {
+		resp.Diagnostics.AddError("Error acquiring the lock", err.Error())
+		return
+	}
 	defer mutex.GlobalMutex.KvUnlock(ctx, r.vdcGroup.GetID())
 
 	fwsg, err := r.vdcGroup.GetFirewallDynamicSecurityGroup(state.ID.Get())
@@ -231,7 +240,7 @@ func (r *DynamicSecurityGroupResource) Update(ctx context.Context, req resource.
 	}
 
 	if err := fwsg.Update(values); err != nil {
-		resp.Diagnostics.AddError("Error updating dynamic security group", err.Error())
+		cerrs.AddError(&resp.Diagnostics, cerrs.ActionUpdate, "dynamic security group", err)
 		return
 	}
 
@@ -272,7 +281,10 @@ func (r *DynamicSecurityGroupResource) Delete(ctx context.Context, req resource.
 		Implement the resource deletion here
 	*/
 
-	mutex.GlobalMutex.KvLock(ctx, r.vdcGroup.GetID())
+	if err := mutex.GlobalMutex.KvLock(ctx, r.vdcGroup.GetID()); err != nil {
+		resp.Diagnostics.AddError("Error acquiring the lock", err.Error())
+		return
+	}
 	defer mutex.GlobalMutex.KvUnlock(ctx, r.vdcGroup.GetID())
 
 	fwsg, err := r.vdcGroup.GetFirewallDynamicSecurityGroup(state.ID.Get())
@@ -282,7 +294,7 @@ func (r *DynamicSecurityGroupResource) Delete(ctx context.Context, req resource.
 	}
 
 	if err := fwsg.Delete(); err != nil {
-		resp.Diagnostics.AddError("Error deleting security group", err.Error())
+		cerrs.AddError(&resp.Diagnostics, cerrs.ActionDelete, "security group", err)
 		return
 	}
 }
@@ -342,6 +354,22 @@ func (r *DynamicSecurityGroupResource) ImportState(ctx context.Context, req reso
 	resp.Diagnostics.Append(resp.State.Set(ctx, stateRefreshed)...)
 }
 
+// IdentitySpec returns the identity of the resource.
+func (r *DynamicSecurityGroupResource) IdentitySpec() identity.Spec {
+	return identity.Spec{
+		Attributes: []identity.Attribute{
+			{
+				Name:        "vdc_group_id",
+				Description: "The ID of the VDC group.",
+			},
+			{
+				Name:        "id",
+				Description: "The ID of the dynamic security group.",
+			},
+		},
+	}
+}
+
 // * CustomFuncs
 
 // read is a generic read function that can be used by the resource Create, Read and Update functions.
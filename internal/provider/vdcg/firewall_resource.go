@@ -34,14 +34,17 @@ import (
 	v1 "github.com/orange-cloudavenue/cloudavenue-sdk-go/v1"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/metrics"
+	cerrs "github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/errors"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/identity"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/mutex"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &FirewallResource{}
-	_ resource.ResourceWithConfigure   = &FirewallResource{}
-	_ resource.ResourceWithImportState = &FirewallResource{}
+	_ resource.Resource                 = &FirewallResource{}
+	_ resource.ResourceWithConfigure    = &FirewallResource{}
+	_ resource.ResourceWithImportState  = &FirewallResource{}
+	_ identity.ResourceWithIdentitySpec = &FirewallResource{}
 )
 
 // NewFirewallResource is a helper function to simplify the provider implementation.
@@ -122,7 +125,10 @@ func (r *FirewallResource) Create(ctx context.Context, req resource.CreateReques
 		Implement the resource creation logic here.
 	*/
 
-	mutex.GlobalMutex.KvLock(ctx, r.vdcGroup.GetID())
+	if err := mutex.GlobalMutex.KvLock(ctx, r.vdcGroup.GetID()); err != nil {
+		resp.Diagnostics.AddError("Error acquiring the lock", err.Error())
+		return
+	}
 	defer mutex.GlobalMutex.KvUnlock(ctx, r.vdcGroup.GetID())
 
 	rules, d := plan.rulesToSDKRules(ctx)
@@ -133,7 +139,7 @@ func (r *FirewallResource) Create(ctx context.Context, req resource.CreateReques
 
 	_, err := r.vdcGroup.CreateFirewall(rules)
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating VDC Group Firewall", err.Error())
+		cerrs.AddError(&resp.Diagnostics, cerrs.ActionCreate, "VDC Group Firewall", err)
 		return
 	}
 
@@ -211,7 +217,10 @@ func (r *FirewallResource) Update(ctx context.Context, req resource.UpdateReques
 		Implement the resource update here
 	*/
 
-	mutex.GlobalMutex.KvLock(ctx, r.vdcGroup.GetID())
+	if err := mutex.GlobalMutex.KvLock(ctx, r.vdcGroup.GetID()); err != nil {
+		resp.Diagnostics.AddError("Error acquiring the lock", err.Error())
+		return
+	}
 	defer mutex.GlobalMutex.KvUnlock(ctx, r.vdcGroup.GetID())
 
 	vdcgfw, err := r.vdcGroup.GetFirewall()
@@ -227,7 +236,7 @@ func (r *FirewallResource) Update(ctx context.Context, req resource.UpdateReques
 	}
 
 	if err := vdcgfw.UpdateFirewall(rules); err != nil {
-		resp.Diagnostics.AddError("Error updating VDC Group Firewall rules", err.Error())
+		cerrs.AddError(&resp.Diagnostics, cerrs.ActionUpdate, "VDC Group Firewall rules", err)
 		return
 	}
 
@@ -268,7 +277,10 @@ func (r *FirewallResource) Delete(ctx context.Context, req resource.DeleteReques
 		Implement the resource deletion here
 	*/
 
-	mutex.GlobalMutex.KvLock(ctx, r.vdcGroup.GetID())
+	if err := mutex.GlobalMutex.KvLock(ctx, r.vdcGroup.GetID()); err != nil {
+		resp.Diagnostics.AddError("Error acquiring the lock", err.Error())
+		return
+	}
 	defer mutex.GlobalMutex.KvUnlock(ctx, r.vdcGroup.GetID())
 
 	vdcgfw, err := r.vdcGroup.GetFirewall()
@@ -278,7 +290,7 @@ func (r *FirewallResource) Delete(ctx context.Context, req resource.DeleteReques
 	}
 
 	if err := vdcgfw.Delete(); err != nil {
-		resp.Diagnostics.AddError("Error deleting VDC Group Firewall", err.Error())
+		cerrs.AddError(&resp.Diagnostics, cerrs.ActionDelete, "VDC Group Firewall", err)
 		return
 	}
 }
@@ -319,6 +331,18 @@ func (r *FirewallResource) ImportState(ctx context.Context, req resource.ImportS
 	resp.Diagnostics.Append(resp.State.Set(ctx, stateRefreshed)...)
 }
 
+// IdentitySpec returns the identity of the resource.
+func (r *FirewallResource) IdentitySpec() identity.Spec {
+	return identity.Spec{
+		Attributes: []identity.Attribute{
+			{
+				Name:        "vdc_group_id",
+				Description: "The ID of the VDC group.",
+			},
+		},
+	}
+}
+
 // * CustomFuncs
 
 // read is a generic read function that can be used by the resource Create, Read and Update functions.
@@ -35,14 +35,17 @@ import (
 	v1 "github.com/orange-cloudavenue/cloudavenue-sdk-go/v1"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/metrics"
+	cerrs "github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/errors"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/identity"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/mutex"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &IPSetResource{}
-	_ resource.ResourceWithConfigure   = &IPSetResource{}
-	_ resource.ResourceWithImportState = &IPSetResource{}
+	_ resource.Resource                 = &IPSetResource{}
+	_ resource.ResourceWithConfigure    = &IPSetResource{}
+	_ resource.ResourceWithImportState  = &IPSetResource{}
+	_ identity.ResourceWithIdentitySpec = &IPSetResource{}
 )
 
 // NewIPSetResource is a helper function to simplify the provider implementation.
@@ -123,7 +126,10 @@ func (r *IPSetResource) Create(ctx context.Context, req resource.CreateRequest,
 		Implement the resource creation logic here.
 	*/
 
-	mutex.GlobalMutex.KvLock(ctx, r.vdcGroup.GetID())
+	if err := mutex.GlobalMutex.KvLock(ctx, r.vdcGroup.GetID()); err != nil {
+		resp.Diagnostics.AddError("Error acquiring the lock", err.Error())
+		return
+	}
 	defer mutex.GlobalMutex.KvUnlock(ctx, r.vdcGroup.GetID())
 
 	values, d := plan.ToSDKIPSetModel(ctx)
@@ -134,7 +140,7 @@ func (r *IPSetResource) Create(ctx context.Context, req resource.CreateRequest,
 
 	fwipset, err := r.vdcGroup.CreateFirewallIPSet(values)
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating ip set", err.Error())
+		cerrs.AddError(&resp.Diagnostics, cerrs.ActionCreate, "ip set", err)
 		return
 	}
 
@@ -215,7 +221,10 @@ func (r *IPSetResource) Update(ctx context.Context, req resource.UpdateRequest,
 		Implement the resource update here
 	*/
 
-	mutex.GlobalMutex.KvLock(ctx, r.vdcGroup.GetID())
+	if err := mutex.GlobalMutex.KvLock(ctx, r.vdcGroup.GetID()); err != nil {
+		resp.Diagnostics.AddError("Error acquiring the lock", err.Error())
+		return
+	}
 	defer mutex.GlobalMutex.KvUnlock(ctx, r.vdcGroup.GetID())
 
 	fwipset, err := r.vdcGroup.GetFirewallIPSet(state.ID.Get())
@@ -231,7 +240,7 @@ func (r *IPSetResource) Update(ctx context.Context, req resource.UpdateRequest,
 	}
 
 	if err := fwipset.Update(values); err != nil {
-		resp.Diagnostics.AddError("Error updating ip set", err.Error())
+		cerrs.AddError(&resp.Diagnostics, cerrs.ActionUpdate, "ip set", err)
 		return
 	}
 
@@ -272,7 +281,10 @@ func (r *IPSetResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		Implement the resource deletion here
 	*/
 
-	mutex.GlobalMutex.KvLock(ctx, r.vdcGroup.GetID())
+	if err := mutex.GlobalMutex.KvLock(ctx, r.vdcGroup.GetID()); err != nil {
+		resp.Diagnostics.AddError("Error acquiring the lock", err.Error())
+		return
+	}
 	defer mutex.GlobalMutex.KvUnlock(ctx, r.vdcGroup.GetID())
 
 	fwipset, err := r.vdcGroup.GetFirewallIPSet(state.ID.Get())
@@ -282,7 +294,7 @@ func (r *IPSetResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	}
 
 	if err := fwipset.Delete(); err != nil {
-		resp.Diagnostics.AddError("Error deleting ip set", err.Error())
+		cerrs.AddError(&resp.Diagnostics, cerrs.ActionDelete, "ip set", err)
 		return
 	}
 }
@@ -342,6 +354,22 @@ func (r *IPSetResource) ImportState(ctx context.Context, req resource.ImportStat
 	resp.Diagnostics.Append(resp.State.Set(ctx, stateRefreshed)...)
 }
 
+// IdentitySpec returns the identity of the resource.
+func (r *IPSetResource) IdentitySpec() identity.Spec {
+	return identity.Spec{
+		Attributes: []identity.Attribute{
+			{
+				Name:        "vdc_group_id",
+				Description: "The ID of the VDC group.",
+			},
+			{
+				Name:        "id",
+				Description: "The ID of the IP set.",
+			},
+		},
+	}
+}
+
 // * CustomFuncs
 
 // read is a generic read function that can be used by the resource Create, Read and Update functions.
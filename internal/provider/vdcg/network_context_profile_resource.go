@@ -27,13 +27,16 @@ import (
 	sdkv1 "github.com/orange-cloudavenue/cloudavenue-sdk-go/v1"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/metrics"
+	cerrs "github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/errors"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/identity"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/mutex"
 )
 
 var (
-	_ resource.Resource                = &networkContextProfileResource{}
-	_ resource.ResourceWithConfigure   = &networkContextProfileResource{}
-	_ resource.ResourceWithImportState = &networkContextProfileResource{}
+	_ resource.Resource                 = &networkContextProfileResource{}
+	_ resource.ResourceWithConfigure    = &networkContextProfileResource{}
+	_ resource.ResourceWithImportState  = &networkContextProfileResource{}
+	_ identity.ResourceWithIdentitySpec = &networkContextProfileResource{}
 )
 
 // NewNetworkContextProfileResource returns a new network context profile resource.
@@ -100,7 +103,10 @@ func (r *networkContextProfileResource) Create(ctx context.Context, req resource
 		return
 	}
 
-	mutex.GlobalMutex.KvLock(ctx, r.vdcGroup.GetID())
+	if err := mutex.GlobalMutex.KvLock(ctx, r.vdcGroup.GetID()); err != nil {
+		resp.Diagnostics.AddError("Error acquiring the lock", err.Error())
+		return
+	}
 	defer mutex.GlobalMutex.KvUnlock(ctx, r.vdcGroup.GetID())
 
 	profile, d := plan.toSDKProfile(ctx)
@@ -111,7 +117,7 @@ func (r *networkContextProfileResource) Create(ctx context.Context, req resource
 
 	created, err := r.vdcGroup.CreateNetworkContextProfile(profile)
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating Network Context Profile", err.Error())
+		cerrs.AddError(&resp.Diagnostics, cerrs.ActionCreate, "Network Context Profile", err)
 		return
 	}
 
@@ -176,7 +182,10 @@ func (r *networkContextProfileResource) Update(ctx context.Context, req resource
 		return
 	}
 
-	mutex.GlobalMutex.KvLock(ctx, r.vdcGroup.GetID())
+	if err := mutex.GlobalMutex.KvLock(ctx, r.vdcGroup.GetID()); err != nil {
+		resp.Diagnostics.AddError("Error acquiring the lock", err.Error())
+		return
+	}
 	defer mutex.GlobalMutex.KvUnlock(ctx, r.vdcGroup.GetID())
 
 	plan.ID.Set(state.ID.Get())
@@ -188,7 +197,7 @@ func (r *networkContextProfileResource) Update(ctx context.Context, req resource
 	}
 
 	if _, err := r.vdcGroup.UpdateNetworkContextProfile(profile); err != nil {
-		resp.Diagnostics.AddError("Error updating Network Context Profile", err.Error())
+		cerrs.AddError(&resp.Diagnostics, cerrs.ActionUpdate, "Network Context Profile", err)
 		return
 	}
 
@@ -219,11 +228,14 @@ func (r *networkContextProfileResource) Delete(ctx context.Context, req resource
 		return
 	}
 
-	mutex.GlobalMutex.KvLock(ctx, r.vdcGroup.GetID())
+	if err := mutex.GlobalMutex.KvLock(ctx, r.vdcGroup.GetID()); err != nil {
+		resp.Diagnostics.AddError("Error acquiring the lock", err.Error())
+		return
+	}
 	defer mutex.GlobalMutex.KvUnlock(ctx, r.vdcGroup.GetID())
 
 	if err := r.vdcGroup.DeleteNetworkContextProfile(state.ID.Get()); err != nil {
-		resp.Diagnostics.AddError("Error deleting Network Context Profile", err.Error())
+		cerrs.AddError(&resp.Diagnostics, cerrs.ActionDelete, "Network Context Profile", err)
 	}
 }
 
@@ -277,6 +289,22 @@ func (r *networkContextProfileResource) ImportState(ctx context.Context, req res
 	resp.Diagnostics.Append(resp.State.Set(ctx, stateRefreshed)...)
 }
 
+// IdentitySpec returns the identity of the resource.
+func (r *networkContextProfileResource) IdentitySpec() identity.Spec {
+	return identity.Spec{
+		Attributes: []identity.Attribute{
+			{
+				Name:        "vdc_group_id",
+				Description: "The ID of the VDC group.",
+			},
+			{
+				Name:        "id",
+				Description: "The ID of the network context profile.",
+			},
+		},
+	}
+}
+
 func (r *networkContextProfileResource) read(ctx context.Context, planOrState *networkContextProfileModel) (stateRefreshed *networkContextProfileModel, found bool, diags diag.Diagnostics) {
 	stateRefreshed = planOrState.Copy()
 
@@ -295,7 +323,7 @@ func (r *networkContextProfileResource) read(ctx context.Context, planOrState *n
 		if errors.Is(err, govcd.ErrorEntityNotFound) {
 			return stateRefreshed, false, nil
 		}
-		diags.AddError("Error reading Network Context Profile", err.Error())
+		cerrs.AddError(&diags, cerrs.ActionRead, "Network Context Profile", err)
 		return stateRefreshed, true, diags
 	}
 
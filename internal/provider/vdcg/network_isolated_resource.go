@@ -35,14 +35,17 @@ import (
 	v1 "github.com/orange-cloudavenue/cloudavenue-sdk-go/v1"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/metrics"
+	cerrs "github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/errors"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/identity"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/mutex"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &NetworkIsolatedResource{}
-	_ resource.ResourceWithConfigure   = &NetworkIsolatedResource{}
-	_ resource.ResourceWithImportState = &NetworkIsolatedResource{}
+	_ resource.Resource                 = &NetworkIsolatedResource{}
+	_ resource.ResourceWithConfigure    = &NetworkIsolatedResource{}
+	_ resource.ResourceWithImportState  = &NetworkIsolatedResource{}
+	_ identity.ResourceWithIdentitySpec = &NetworkIsolatedResource{}
 )
 
 // NewNetworkIsolatedResource is a helper function to simplify the provider implementation.
@@ -128,12 +131,15 @@ func (r *NetworkIsolatedResource) Create(ctx context.Context, req resource.Creat
 		return
 	}
 
-	mutex.GlobalMutex.KvLock(ctx, r.vdcg.GetID())
+	if err := mutex.GlobalMutex.KvLock(ctx, r.vdcg.GetID()); err != nil {
+		resp.Diagnostics.AddError("Error acquiring the lock", err.Error())
+		return
+	}
 	defer mutex.GlobalMutex.KvUnlock(ctx, r.vdcg.GetID())
 
 	networkIsolated, err := r.vdcg.CreateNetworkIsolated(values)
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating isolated network", err.Error())
+		cerrs.AddError(&resp.Diagnostics, cerrs.ActionCreate, "isolated network", err)
 		return
 	}
 
@@ -220,7 +226,10 @@ func (r *NetworkIsolatedResource) Update(ctx context.Context, req resource.Updat
 		return
 	}
 
-	mutex.GlobalMutex.KvLock(ctx, r.vdcg.GetID())
+	if err := mutex.GlobalMutex.KvLock(ctx, r.vdcg.GetID()); err != nil {
+		resp.Diagnostics.AddError("Error acquiring the lock", err.Error())
+		return
+	}
 	defer mutex.GlobalMutex.KvUnlock(ctx, r.vdcg.GetID())
 
 	net, err := r.vdcg.GetNetworkIsolated(state.ID.Get())
@@ -233,7 +242,7 @@ func (r *NetworkIsolatedResource) Update(ctx context.Context, req resource.Updat
 
 	// Update the network
 	if err := net.Update(values); err != nil {
-		resp.Diagnostics.AddError("Error updating isolated network", err.Error())
+		cerrs.AddError(&resp.Diagnostics, cerrs.ActionUpdate, "isolated network", err)
 		return
 	}
 
@@ -275,7 +284,10 @@ func (r *NetworkIsolatedResource) Delete(ctx context.Context, req resource.Delet
 		Implement the resource deletion here
 	*/
 
-	mutex.GlobalMutex.KvLock(ctx, r.vdcg.GetID())
+	if err := mutex.GlobalMutex.KvLock(ctx, r.vdcg.GetID()); err != nil {
+		resp.Diagnostics.AddError("Error acquiring the lock", err.Error())
+		return
+	}
 	defer mutex.GlobalMutex.KvUnlock(ctx, r.vdcg.GetID())
 
 	net, err := r.vdcg.GetNetworkIsolated(state.ID.Get())
@@ -286,7 +298,7 @@ func (r *NetworkIsolatedResource) Delete(ctx context.Context, req resource.Delet
 
 	// Delete the network
 	if err := net.Delete(); err != nil {
-		resp.Diagnostics.AddError("Error deleting isolated network", err.Error())
+		cerrs.AddError(&resp.Diagnostics, cerrs.ActionDelete, "isolated network", err)
 		return
 	}
 }
@@ -343,6 +355,22 @@ func (r *NetworkIsolatedResource) ImportState(ctx context.Context, req resource.
 	resp.Diagnostics.Append(resp.State.Set(ctx, stateRefreshed)...)
 }
 
+// IdentitySpec returns the identity of the resource.
+func (r *NetworkIsolatedResource) IdentitySpec() identity.Spec {
+	return identity.Spec{
+		Attributes: []identity.Attribute{
+			{
+				Name:        "vdc_group_id",
+				Description: "The ID of the VDC group.",
+			},
+			{
+				Name:        "id",
+				Description: "The ID of the isolated network.",
+			},
+		},
+	}
+}
+
 // * CustomFuncs
 
 // read is a generic read function that can be used by the resource Create, Read and Update functions.
@@ -36,16 +36,18 @@ import (
 	v1 "github.com/orange-cloudavenue/cloudavenue-sdk-go/v1"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/metrics"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/identity"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/mutex"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/network"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &NetworkRoutedResource{}
-	_ resource.ResourceWithConfigure   = &NetworkRoutedResource{}
-	_ resource.ResourceWithImportState = &NetworkRoutedResource{}
-	_ resource.ResourceWithMoveState   = &NetworkRoutedResource{}
+	_ resource.Resource                 = &NetworkRoutedResource{}
+	_ resource.ResourceWithConfigure    = &NetworkRoutedResource{}
+	_ resource.ResourceWithImportState  = &NetworkRoutedResource{}
+	_ resource.ResourceWithMoveState    = &NetworkRoutedResource{}
+	_ identity.ResourceWithIdentitySpec = &NetworkRoutedResource{}
 )
 
 // NewNetworkRoutedResource is a helper function to simplify the provider implementation.
@@ -236,7 +238,10 @@ func (r *NetworkRoutedResource) Create(ctx context.Context, req resource.CreateR
 		Implement the resource creation logic here.
 	*/
 
-	mutex.GlobalMutex.KvLock(ctx, r.vdcg.GetID())
+	if err := mutex.GlobalMutex.KvLock(ctx, r.vdcg.GetID()); err != nil {
+		resp.Diagnostics.AddError("Error acquiring the lock", err.Error())
+		return
+	}
 	defer mutex.GlobalMutex.KvUnlock(ctx, r.vdcg.GetID())
 
 	// Get the edgeGateway after the lock to waiting edgegateway has been connected to the vdcgroup
@@ -335,7 +340,10 @@ func (r *NetworkRoutedResource) Update(ctx context.Context, req resource.UpdateR
 		Implement the resource update here
 	*/
 
-	mutex.GlobalMutex.KvLock(ctx, r.vdcg.GetID())
+	if err := mutex.GlobalMutex.KvLock(ctx, r.vdcg.GetID()); err != nil {
+		resp.Diagnostics.AddError("Error acquiring the lock", err.Error())
+		return
+	}
 	defer mutex.GlobalMutex.KvUnlock(ctx, r.vdcg.GetID())
 
 	// Get the edgeGateway after the lock to waiting edgegateway has been connected to the vdcgroup
@@ -398,7 +406,10 @@ func (r *NetworkRoutedResource) Delete(ctx context.Context, req resource.DeleteR
 		Implement the resource deletion here
 	*/
 
-	mutex.GlobalMutex.KvLock(ctx, r.vdcg.GetID())
+	if err := mutex.GlobalMutex.KvLock(ctx, r.vdcg.GetID()); err != nil {
+		resp.Diagnostics.AddError("Error acquiring the lock", err.Error())
+		return
+	}
 	defer mutex.GlobalMutex.KvUnlock(ctx, r.vdcg.GetID())
 
 	netRouted, err := r.vdcg.GetNetworkRouted(state.ID.Get())
@@ -463,6 +474,22 @@ func (r *NetworkRoutedResource) ImportState(ctx context.Context, req resource.Im
 	resp.Diagnostics.Append(resp.State.Set(ctx, stateRefreshed)...)
 }
 
+// IdentitySpec returns the identity of the resource.
+func (r *NetworkRoutedResource) IdentitySpec() identity.Spec {
+	return identity.Spec{
+		Attributes: []identity.Attribute{
+			{
+				Name:        "vdc_group_id",
+				Description: "The ID of the VDC group.",
+			},
+			{
+				Name:        "id",
+				Description: "The ID of the routed network.",
+			},
+		},
+	}
+}
+
 // * CustomFuncs
 
 // read is a generic read function that can be used by the resource Create, Read and Update functions.
@@ -35,15 +35,18 @@ import (
 	v1 "github.com/orange-cloudavenue/cloudavenue-sdk-go/v1"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/metrics"
+	cerrs "github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/errors"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/identity"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/mutex"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/pkg/utils"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &SecurityGroupResource{}
-	_ resource.ResourceWithConfigure   = &SecurityGroupResource{}
-	_ resource.ResourceWithImportState = &SecurityGroupResource{}
+	_ resource.Resource                 = &SecurityGroupResource{}
+	_ resource.ResourceWithConfigure    = &SecurityGroupResource{}
+	_ resource.ResourceWithImportState  = &SecurityGroupResource{}
+	_ identity.ResourceWithIdentitySpec = &SecurityGroupResource{}
 )
 
 // NewSecurityGroupResource is a helper function to simplify the provider implementation.
@@ -129,7 +132,10 @@ func (r *SecurityGroupResource) Create(ctx context.Context, req resource.CreateR
 		return
 	}
 
-	mutex.GlobalMutex.KvLock(ctx, r.vdcGroup.GetID())
+	if err := mutex.GlobalMutex.KvLock(ctx, r.vdcGroup.GetID()); err != nil {
+		resp.Diagnostics.AddError("Error acquiring the lock", err.Error())
+		return
+	}
 	defer mutex.GlobalMutex.KvUnlock(ctx, r.vdcGroup.GetID())
 
 	values, d := plan.ToSDKSecurityGroupModel(ctx)
@@ -140,7 +146,7 @@ func (r *SecurityGroupResource) Create(ctx context.Context, req resource.CreateR
 
 	fwsg, err := r.vdcGroup.CreateFirewallSecurityGroup(values)
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating security group", err.Error())
+		cerrs.AddError(&resp.Diagnostics, cerrs.ActionCreate, "security group", err)
 		return
 	}
 
@@ -226,7 +232,10 @@ func (r *SecurityGroupResource) Update(ctx context.Context, req resource.UpdateR
 		return
 	}
 
-	mutex.GlobalMutex.KvLock(ctx, r.vdcGroup.GetID())
+	if err := mutex.GlobalMutex.KvLock(ctx, r.vdcGroup.GetID()); err != nil {
+		resp.Diagnostics.AddError("Error acquiring the lock", err.Error())
+		return
+	}
 	defer mutex.GlobalMutex.KvUnlock(ctx, r.vdcGroup.GetID())
 
 	fwsg, err := r.vdcGroup.GetFirewallSecurityGroup(state.ID.Get())
@@ -242,7 +251,7 @@ func (r *SecurityGroupResource) Update(ctx context.Context, req resource.UpdateR
 	}
 
 	if err := fwsg.Update(values); err != nil {
-		resp.Diagnostics.AddError("Error updating security group", err.Error())
+		cerrs.AddError(&resp.Diagnostics, cerrs.ActionUpdate, "security group", err)
 		return
 	}
 
@@ -283,7 +292,10 @@ func (r *SecurityGroupResource) Delete(ctx context.Context, req resource.DeleteR
 		Implement the resource deletion here
 	*/
 
-	mutex.GlobalMutex.KvLock(ctx, r.vdcGroup.GetID())
+	if err := mutex.GlobalMutex.KvLock(ctx, r.vdcGroup.GetID()); err != nil {
+		resp.Diagnostics.AddError("Error acquiring the lock", err.Error())
+		return
+	}
 	defer mutex.GlobalMutex.KvUnlock(ctx, r.vdcGroup.GetID())
 
 	fwsg, err := r.vdcGroup.GetFirewallSecurityGroup(state.ID.Get())
@@ -293,7 +305,7 @@ func (r *SecurityGroupResource) Delete(ctx context.Context, req resource.DeleteR
 	}
 
 	if err := fwsg.Delete(); err != nil {
-		resp.Diagnostics.AddError("Error deleting security group", err.Error())
+		cerrs.AddError(&resp.Diagnostics, cerrs.ActionDelete, "security group", err)
 		return
 	}
 }
@@ -353,6 +365,22 @@ func (r *SecurityGroupResource) ImportState(ctx context.Context, req resource.Im
 	resp.Diagnostics.Append(resp.State.Set(ctx, stateRefreshed)...)
 }
 
+// IdentitySpec returns the identity of the resource.
+func (r *SecurityGroupResource) IdentitySpec() identity.Spec {
+	return identity.Spec{
+		Attributes: []identity.Attribute{
+			{
+				Name:        "vdc_group_id",
+				Description: "The ID of the VDC group.",
+			},
+			{
+				Name:        "id",
+				Description: "The ID of the security group.",
+			},
+		},
+	}
+}
+
 // read is a generic read function that can be used by the resource Create, Read and Update functions.
 func (r *SecurityGroupResource) read(ctx context.Context, planOrState *SecurityGroupModel) (stateRefreshed *SecurityGroupModel, found bool, diags diag.Diagnostics) {
 	stateRefreshed = planOrState.Copy()
@@ -33,13 +33,16 @@ import (
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/metrics"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/adminorg"
+	cerrs "github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/errors"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/identity"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &vdcgResource{}
-	_ resource.ResourceWithConfigure   = &vdcgResource{}
-	_ resource.ResourceWithImportState = &vdcgResource{}
+	_ resource.Resource                 = &vdcgResource{}
+	_ resource.ResourceWithConfigure    = &vdcgResource{}
+	_ resource.ResourceWithImportState  = &vdcgResource{}
+	_ identity.ResourceWithIdentitySpec = &vdcgResource{}
 )
 
 // NewvdcgResource is a helper function to simplify the provider implementation.
@@ -116,7 +119,7 @@ func (r *vdcgResource) Create(ctx context.Context, req resource.CreateRequest, r
 
 	vdcGroup, err := r.adminOrg.CreateNsxtVdcGroup(plan.Name.Get(), plan.Description.Get(), vdcIDs[0], vdcIDs)
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating VDC Group", err.Error())
+		cerrs.AddError(&resp.Diagnostics, cerrs.ActionCreate, "VDC Group", err)
 		return
 	}
 
@@ -201,12 +204,12 @@ func (r *vdcgResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	// Here use GetVdcGroupById instead of GetVdcGroupByNameOrID because we want to update the name of VDC Group
 	vdcGroup, err := r.adminOrg.GetVdcGroupById(state.ID.Get())
 	if err != nil {
-		resp.Diagnostics.AddError("Error reading VDC Group", err.Error())
+		cerrs.AddError(&resp.Diagnostics, cerrs.ActionRead, "VDC Group", err)
 		return
 	}
 
 	if _, err := vdcGroup.Update(plan.Name.Get(), plan.Description.Get(), vdcIDs); err != nil {
-		resp.Diagnostics.AddError("Error updating VDC Group", err.Error())
+		cerrs.AddError(&resp.Diagnostics, cerrs.ActionUpdate, "VDC Group", err)
 		return
 	}
 
@@ -249,12 +252,12 @@ func (r *vdcgResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 
 	vdcGroup, err := r.adminOrg.GetVdcGroupById(state.ID.Get())
 	if err != nil {
-		resp.Diagnostics.AddError("Error reading VDC Group", err.Error())
+		cerrs.AddError(&resp.Diagnostics, cerrs.ActionRead, "VDC Group", err)
 		return
 	}
 
 	if err = vdcGroup.Delete(); err != nil {
-		resp.Diagnostics.AddError("Error deleting VDC Group", err.Error())
+		cerrs.AddError(&resp.Diagnostics, cerrs.ActionDelete, "VDC Group", err)
 		return
 	}
 }
@@ -291,6 +294,18 @@ func (r *vdcgResource) ImportState(ctx context.Context, req resource.ImportState
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), vdcGroup.VdcGroup.Name)...)
 }
 
+// IdentitySpec returns the identity of the resource.
+func (r *vdcgResource) IdentitySpec() identity.Spec {
+	return identity.Spec{
+		Attributes: []identity.Attribute{
+			{
+				Name:        "id",
+				Description: "The ID of the VDC group.",
+			},
+		},
+	}
+}
+
 // * CustomFuncs
 
 // read is a generic read function that can be used by the resource Create, Read and Update functions.
@@ -315,7 +330,7 @@ func (r *vdcgResource) read(ctx context.Context, planOrState *vdcgModel) (stateR
 		if govcd.ContainsNotFound(err) {
 			return nil, false, nil
 		}
-		diags.AddError("Error reading VDC Group", err.Error())
+		cerrs.AddError(&diags, cerrs.ActionRead, "VDC Group", err)
 		return nil, true, diags
 	}
 
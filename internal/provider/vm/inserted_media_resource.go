@@ -30,6 +30,7 @@ import (
 
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/metrics"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/identity"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/org"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/vapp"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/vdc"
@@ -38,8 +39,9 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.VAppName.
 var (
-	_ resource.Resource              = &insertedMediaResource{}
-	_ resource.ResourceWithConfigure = &insertedMediaResource{}
+	_ resource.Resource                 = &insertedMediaResource{}
+	_ resource.ResourceWithConfigure    = &insertedMediaResource{}
+	_ identity.ResourceWithIdentitySpec = &insertedMediaResource{}
 )
 
 // NewInsertedMediaResource is a helper function to simplify the provider implementation.
@@ -286,3 +288,19 @@ func (r *insertedMediaResource) Delete(ctx context.Context, req resource.DeleteR
 		resp.Diagnostics.AddError("Error ejecting media", err.Error())
 	}
 }
+
+// IdentitySpec returns the identity of the resource.
+func (r *insertedMediaResource) IdentitySpec() identity.Spec {
+	return identity.Spec{
+		Attributes: []identity.Attribute{
+			{
+				Name:        "id",
+				Description: "The ID of the VM where the media is inserted.",
+			},
+			{
+				Name:        "name",
+				Description: "The name of the media.",
+			},
+		},
+	}
+}
@@ -33,14 +33,18 @@ import (
 
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
 	cerrs "github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/errors"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/identity"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/movestate"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/org"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &securityTagResource{}
-	_ resource.ResourceWithConfigure   = &securityTagResource{}
-	_ resource.ResourceWithImportState = &securityTagResource{}
+	_ resource.Resource                 = &securityTagResource{}
+	_ resource.ResourceWithConfigure    = &securityTagResource{}
+	_ resource.ResourceWithImportState  = &securityTagResource{}
+	_ resource.ResourceWithMoveState    = &securityTagResource{}
+	_ identity.ResourceWithIdentitySpec = &securityTagResource{}
 )
 
 // NewSecurityTagResource is a helper function to simplify the provider implementation.
@@ -310,3 +314,24 @@ func (r *securityTagResource) ImportState(ctx context.Context, req resource.Impo
 		return
 	}
 }
+
+// IdentitySpec returns the identity of the resource.
+func (r *securityTagResource) IdentitySpec() identity.Spec {
+	return identity.Spec{
+		Attributes: []identity.Attribute{
+			{
+				Name:        "id",
+				Description: "The name of the security tag.",
+			},
+		},
+	}
+}
+
+// MoveState returns the state movers from the equivalent resources of the VMware vcd provider.
+func (r *securityTagResource) MoveState(_ context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		movestate.FromVCD("vcd_security_tag", movestate.Attributes{
+			"id": "name",
+		}),
+	}
+}
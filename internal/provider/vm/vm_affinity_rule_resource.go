@@ -40,6 +40,7 @@ import (
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/metrics"
 	cerrs "github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/errors"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/identity"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/vdc"
 )
 
@@ -47,9 +48,10 @@ const vmAffinityRuleSubsystem = "vm.affinity_rule"
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &vmAffinityRuleResource{}
-	_ resource.ResourceWithConfigure   = &vmAffinityRuleResource{}
-	_ resource.ResourceWithImportState = &vmAffinityRuleResource{}
+	_ resource.Resource                 = &vmAffinityRuleResource{}
+	_ resource.ResourceWithConfigure    = &vmAffinityRuleResource{}
+	_ resource.ResourceWithImportState  = &vmAffinityRuleResource{}
+	_ identity.ResourceWithIdentitySpec = &vmAffinityRuleResource{}
 )
 
 // NewVMAffinityRuleResource is a helper function to simplify the provider implementation.
@@ -386,6 +388,23 @@ func (r *vmAffinityRuleResource) ImportState(ctx context.Context, req resource.I
 	}
 }
 
+// IdentitySpec returns the identity of the resource.
+func (r *vmAffinityRuleResource) IdentitySpec() identity.Spec {
+	return identity.Spec{
+		Attributes: []identity.Attribute{
+			{
+				Name:        "vdc",
+				Description: "The name of the VDC.",
+				Optional:    true,
+			},
+			{
+				Name:        "id",
+				Description: "The ID of the affinity rule.",
+			},
+		},
+	}
+}
+
 // resourceToAffinityRule prepares a VM affinity rule definition from the data in the resource.
 func resourceToAffinityRule(r *vmAffinityRuleResource, m *vmAffinityRuleResourceModel) (*govcdtypes.VmAffinityRule, error) {
 	name := m.Name.ValueString()
@@ -37,6 +37,7 @@ import (
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/metrics"
 	cerrs "github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/errors"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/identity"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/org"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/vapp"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/vdc"
@@ -47,10 +48,11 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &diskResource{}
-	_ resource.ResourceWithConfigure   = &diskResource{}
-	_ resource.ResourceWithImportState = &diskResource{}
-	_ resource.ResourceWithModifyPlan  = &diskResource{}
+	_ resource.Resource                 = &diskResource{}
+	_ resource.ResourceWithConfigure    = &diskResource{}
+	_ resource.ResourceWithImportState  = &diskResource{}
+	_ resource.ResourceWithModifyPlan   = &diskResource{}
+	_ identity.ResourceWithIdentitySpec = &diskResource{}
 )
 
 // NewDiskResource is a helper function to simplify the provider implementation.
@@ -454,7 +456,7 @@ func (r *diskResource) Create(ctx context.Context, req resource.CreateRequest, r
 
 		diskID, err := r.vm.AddInternalDisk(diskSetting)
 		if err != nil {
-			resp.Diagnostics.AddError("Error creating disk", err.Error())
+			cerrs.AddError(&resp.Diagnostics, cerrs.ActionCreate, "disk", err)
 			return
 		}
 
@@ -1111,3 +1113,31 @@ next:
 		}
 	}
 }
+
+// IdentitySpec returns the identity of the resource.
+// A detachable disk can be attached to another VM.
+func (r *diskResource) IdentitySpec() identity.Spec {
+	return identity.Spec{
+		Attributes: []identity.Attribute{
+			{
+				Name:        "vdc",
+				Description: "The name of the VDC.",
+				Optional:    true,
+			},
+			{
+				Name:        "vapp_id",
+				Description: "The ID of the vApp.",
+			},
+			{
+				Name:        "vm_id",
+				Description: "The ID of the VM the disk is attached to. Omitted for a detached disk.",
+				Optional:    true,
+			},
+			{
+				Name:        "id",
+				Description: "The ID of the disk.",
+			},
+		},
+		Mutable: true,
+	}
+}
@@ -0,0 +1,109 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package vm
+
+import (
+	"context"
+
+	govcdtypes "github.com/vmware/go-vcloud-director/v2/types/v56"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/orange-cloudavenue/cloudavenue-sdk-go/pkg/urn"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/metrics"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/listresource"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/vdc"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ list.ListResource              = &vmListResource{}
+	_ list.ListResourceWithConfigure = &vmListResource{}
+)
+
+// NewVMListResource is a helper function to simplify the provider implementation.
+func NewVMListResource() list.ListResource {
+	return &vmListResource{
+		Base: listresource.NewBase(NewVMResource),
+	}
+}
+
+// vmListResource is the list resource implementation.
+type vmListResource struct {
+	listresource.Base
+}
+
+type vmListResourceModel struct {
+	VDC      types.String `tfsdk:"vdc"`
+	VappName types.String `tfsdk:"vapp_name"`
+}
+
+// ListResourceConfigSchema defines the schema of the list resource configuration.
+func (r *vmListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{
+		MarkdownDescription: "The `cloudavenue_vm` list resource allows you to list the VMs of a VDC.",
+		Attributes: map[string]listschema.Attribute{
+			"vdc": listschema.StringAttribute{
+				MarkdownDescription: "The name of vDC to use, optional if defined at provider level.",
+				Optional:            true,
+			},
+			"vapp_name": listschema.StringAttribute{
+				MarkdownDescription: "The name of the vApp. If set, only the VMs of this vApp are listed.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+// List lists the VMs of the VDC.
+func (r *vmListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	defer metrics.New("cloudavenue_vm", r.Client.GetOrgName(), metrics.List)()
+
+	config := &vmListResourceModel{}
+	if diags := req.Config.Get(ctx, config); diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	v, diags := vdc.Init(r.Client, config.VDC)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	records, err := v.QueryVmList(govcdtypes.VmQueryFilterOnlyDeployed)
+	if err != nil {
+		stream.Results = listresource.Error("Unable to list VMs", err)
+		return
+	}
+
+	items := make([]listresource.Item, 0, len(records))
+	for _, record := range records {
+		if record.VAppTemplate {
+			continue
+		}
+		if config.VappName.ValueString() != "" && record.ContainerName != config.VappName.ValueString() {
+			continue
+		}
+
+		items = append(items, listresource.Item{
+			DisplayName: record.ContainerName + "/" + record.Name,
+			Attributes: map[string]string{
+				"vdc":       v.GetName(),
+				"vapp_name": record.ContainerName,
+				"id":        urn.Normalize(urn.VM, urn.ExtractUUID(record.HREF)).String(),
+			},
+		})
+	}
+
+	stream.Results = r.Results(ctx, req, items)
+}
@@ -0,0 +1,176 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package vm
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	actionschema "github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/metrics"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/readonly"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/vapp"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/vdc"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/vm"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ action.Action              = &vmPowerAction{}
+	_ action.ActionWithConfigure = &vmPowerAction{}
+)
+
+// NewVMPowerAction is a helper function to simplify the provider implementation.
+func NewVMPowerAction() action.Action {
+	return &vmPowerAction{}
+}
+
+// vmPowerAction is the action implementation.
+type vmPowerAction struct {
+	client *client.CloudAvenue
+}
+
+type vmPowerActionModel struct {
+	VDC       types.String `tfsdk:"vdc"`
+	VappID    types.String `tfsdk:"vapp_id"`
+	VappName  types.String `tfsdk:"vapp_name"`
+	ID        types.String `tfsdk:"id"`
+	Name      types.String `tfsdk:"name"`
+	Operation types.String `tfsdk:"operation"`
+}
+
+// Metadata returns the action type name.
+func (a *vmPowerAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + categoryName + "_power"
+}
+
+// Schema defines the schema for the action.
+func (a *vmPowerAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = actionschema.Schema{
+		MarkdownDescription: "The `cloudavenue_vm_power` action allows you to run a power operation on a VM, for example from the `action_trigger` of a `lifecycle` block.",
+		Attributes: map[string]actionschema.Attribute{
+			"vdc": actionschema.StringAttribute{
+				MarkdownDescription: "The name of vDC to use, optional if defined at provider level.",
+				Optional:            true,
+			},
+			"vapp_id": actionschema.StringAttribute{
+				MarkdownDescription: "The ID of the vApp of the VM.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("vapp_id"), path.MatchRoot("vapp_name")),
+				},
+			},
+			"vapp_name": actionschema.StringAttribute{
+				MarkdownDescription: "The name of the vApp of the VM.",
+				Optional:            true,
+			},
+			"id": actionschema.StringAttribute{
+				MarkdownDescription: "The ID of the VM.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("id"), path.MatchRoot("name")),
+				},
+			},
+			"name": actionschema.StringAttribute{
+				MarkdownDescription: "The name of the VM.",
+				Optional:            true,
+			},
+			"operation": actionschema.StringAttribute{
+				MarkdownDescription: "The power operation to run. The `power_on`, `power_off`, `shutdown_guest` and `suspend` operations do nothing if the VM is already in the status they lead to. `power_off` undeploys the VM and `shutdown_guest` shuts down the guest OS before undeploying the VM.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(vm.PowerOperationsString()...),
+				},
+			},
+		},
+	}
+}
+
+func (a *vmPowerAction) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.CloudAvenue)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *client.CloudAvenue, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	a.client = client
+}
+
+// Invoke runs the power operation on the VM.
+func (a *vmPowerAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	defer metrics.New("cloudavenue_vm_power", a.client.GetOrgName(), metrics.Invoke)()
+
+	if a.client.IsReadOnly() {
+		resp.Diagnostics.Append(readonly.Error("cloudavenue_vm_power", "invoked"))
+		return
+	}
+
+	config := &vmPowerActionModel{}
+	resp.Diagnostics.Append(req.Config.Get(ctx, config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	v, diags := vdc.Init(a.client, config.VDC)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	vApp, err := vapp.Init(a.client, v, config.VappID, config.VappName)
+	if err != nil {
+		resp.Diagnostics.AddError("Error retrieving vApp", err.Error())
+		return
+	}
+
+	vmOut, err := vm.Init(a.client, vApp, vm.GetVMOpts{
+		ID:   config.ID,
+		Name: config.Name,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error retrieving VM", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(vmOut.LockVM(ctx)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer vmOut.UnlockVM(ctx)
+
+	operation := vm.PowerOperation(config.Operation.ValueString())
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Running %s on VM %s", operation, vmOut.GetName()),
+	})
+
+	if err := vmOut.Power(ctx, a.client, operation); err != nil {
+		resp.Diagnostics.AddError("Error running VM power operation", err.Error())
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("%s on VM %s completed", operation, vmOut.GetName()),
+	})
+}
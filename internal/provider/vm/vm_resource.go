@@ -37,7 +37,11 @@ import (
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/metrics"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/adminvdc"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/deletionprotection"
 	cerrs "github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/errors"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/identity"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/movestate"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/stateupgrade"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/vapp"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/vdc"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/vm"
@@ -46,9 +50,13 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &vmResource{}
-	_ resource.ResourceWithConfigure   = &vmResource{}
-	_ resource.ResourceWithImportState = &vmResource{}
+	_ resource.Resource                 = &vmResource{}
+	_ resource.ResourceWithConfigure    = &vmResource{}
+	_ resource.ResourceWithImportState  = &vmResource{}
+	_ resource.ResourceWithModifyPlan   = &vmResource{}
+	_ resource.ResourceWithMoveState    = &vmResource{}
+	_ resource.ResourceWithUpgradeState = &vmResource{}
+	_ identity.ResourceWithIdentitySpec = &vmResource{}
 )
 
 // NewVMResource is a helper function to simplify the provider implementation.
@@ -109,6 +117,7 @@ func (r *vmResource) Metadata(_ context.Context, req resource.MetadataRequest, r
 // Schema defines the schema for the resource.
 func (r *vmResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = vmSuperSchema(ctx).GetResource(ctx)
+	resp.Schema.Version = vmStateUpgrade.Version()
 }
 
 func (r *vmResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -129,6 +138,11 @@ func (r *vmResource) Configure(_ context.Context, req resource.ConfigureRequest,
 	r.client = client
 }
 
+// ModifyPlan rejects the plans destroying or replacing a protected VM.
+func (r *vmResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	deletionprotection.ModifyPlan(ctx, "cloudavenue_vm", req, resp)
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *vmResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	defer metrics.New("cloudavenue_vm", r.client.GetOrgName(), metrics.Create)()
@@ -763,6 +777,11 @@ func (r *vmResource) Delete(ctx context.Context, req resource.DeleteRequest, res
 		return
 	}
 
+	resp.Diagnostics.Append(deletionprotection.CheckDelete(ctx, "cloudavenue_vm", req.State)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Init the resource
 	resp.Diagnostics.Append(r.Init(ctx, state)...)
 	if resp.Diagnostics.HasError() {
@@ -857,6 +876,76 @@ func (r *vmResource) ImportState(ctx context.Context, req resource.ImportStateRe
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), urn.Normalize(urn.VM, id).String())...)
 }
 
+// IdentitySpec returns the identity of the resource.
+func (r *vmResource) IdentitySpec() identity.Spec {
+	return identity.Spec{
+		Attributes: []identity.Attribute{
+			{
+				Name:        "vdc",
+				Description: "The name of the VDC.",
+				Optional:    true,
+			},
+			{
+				Name:        "vapp_name",
+				Description: "The name of the vApp.",
+			},
+			{
+				Name:        "id",
+				Description: "The ID of the VM.",
+			},
+		},
+	}
+}
+
+// MoveState returns the state movers from the equivalent resources of the VMware vcd provider.
+func (r *vmResource) MoveState(_ context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		movestate.FromVCD("vcd_vapp_vm", movestate.Attributes{
+			"id":        "id",
+			"name":      "name",
+			"vapp_name": "vapp_name",
+			"vdc":       "vdc",
+		}),
+		movestate.FromVCD("vcd_vm", movestate.Attributes{
+			"id":        "id",
+			"name":      "name",
+			"vapp_name": "vapp_name",
+			"vdc":       "vdc",
+		}),
+	}
+}
+
+// vmStateUpgrade are the upgrade steps of the VM state.
+var vmStateUpgrade = stateupgrade.Steps{
+	// 0 -> 1: the flat layout of the releases before v0.3.0 is nested in
+	// deploy_os, state, resource and settings.
+	stateupgrade.Chain(
+		stateupgrade.Move("vapp_template_id", "deploy_os.vapp_template_id"),
+		stateupgrade.Move("vm_name_in_template", "deploy_os.vm_name_in_template"),
+		stateupgrade.Move("boot_image_id", "deploy_os.boot_image_id"),
+		stateupgrade.Move("accept_all_eulas", "deploy_os.accept_all_eulas"),
+		stateupgrade.Move("power_on", "state.power_on"),
+		stateupgrade.Move("status", "state.status"),
+		stateupgrade.Move("cpus", "resource.cpus"),
+		stateupgrade.Move("cpu_cores", "resource.cpus_cores"),
+		stateupgrade.Move("cpu_hot_add_enabled", "resource.cpu_hot_add_enabled"),
+		stateupgrade.Move("memory", "resource.memory"),
+		stateupgrade.Move("memory_hot_add_enabled", "resource.memory_hot_add_enabled"),
+		stateupgrade.Move("network", "resource.networks"),
+		stateupgrade.Move("expose_hardware_virtualization", "settings.expose_hardware_virtualization"),
+		stateupgrade.Move("os_type", "settings.os_type"),
+		stateupgrade.Move("storage_profile", "settings.storage_profile"),
+		stateupgrade.Move("guest_properties", "settings.guest_properties"),
+		stateupgrade.Unwrap("customization"),
+		stateupgrade.Move("customization", "settings.customization"),
+	),
+}
+
+// UpgradeState returns the state upgraders of the prior schema versions.
+func (r *vmResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return vmStateUpgrade.Upgraders()
+}
+
 func (r *vmResource) createVMWithTemplate(ctx context.Context, rm vm.VMResourceModel) (vmCreated vm.VM, diags diag.Diagnostics) {
 	var (
 		err             error
@@ -974,7 +1063,10 @@ func (r *vmResource) createVMWithTemplate(ctx context.Context, rm vm.VMResourceM
 	// * Create VM
 	x, err := r.vapp.AddRawVM(vmFromTemplateParams)
 	if err != nil {
-		diags.AddError("Error creating VM", fmt.Sprintf("error creating VM %s: %s", rm.Name.ValueString(), err))
+		cerrs.AddAttributeError(&diags, cerrs.AttributePaths{
+			cerrs.KindConflict:         path.Root("name"),
+			cerrs.KindInvalidReference: path.Root("deploy_os").AtName("vapp_template_id"),
+		}, cerrs.ActionCreate, "VM "+rm.Name.ValueString(), err)
 		return vm.VM{}, diags
 	}
 
@@ -1099,7 +1191,10 @@ func (r *vmResource) createVMWithBootImage(ctx context.Context, rm vm.VMResource
 
 	x, err := r.vapp.AddEmptyVm(vmParams)
 	if err != nil {
-		diags.AddError("Error creating VM", fmt.Sprintf("error creating VM %s: %s", rm.Name.ValueString(), err))
+		cerrs.AddAttributeError(&diags, cerrs.AttributePaths{
+			cerrs.KindConflict:         path.Root("name"),
+			cerrs.KindInvalidReference: path.Root("deploy_os").AtName("boot_image_id"),
+		}, cerrs.ActionCreate, "VM "+rm.Name.ValueString(), err)
 		return vm.VM{}, diags
 	}
 
@@ -1320,16 +1415,23 @@ func (r *vmResource) read(ctx context.Context, rm, rmPlan *vm.VMResourceModel) (
 		return plan, diags
 	}
 
+	// The states written before the protection existed are not protected.
+	deletionProtection := rmPlan.DeletionProtection
+	if deletionProtection.IsNull() || deletionProtection.IsUnknown() {
+		deletionProtection = types.BoolValue(false)
+	}
+
 	return &vm.VMResourceModel{
-		ID:          types.StringValue(r.vm.GetID()),
-		VDC:         types.StringValue(r.vdc.GetName()),
-		Name:        types.StringValue(r.vm.GetName()),
-		VappID:      types.StringValue(r.vapp.GetID()),
-		VappName:    types.StringValue(r.vapp.GetName()),
-		Description: rm.Description,
-		State:       stateStruct.ToPlan(ctx),
-		Resource:    r.vm.ResourceRead(ctx).ToPlan(ctx, networks),
-		Settings:    settings.ToPlan(ctx),
-		DeployOS:    rm.DeployOS,
+		ID:                 types.StringValue(r.vm.GetID()),
+		VDC:                types.StringValue(r.vdc.GetName()),
+		Name:               types.StringValue(r.vm.GetName()),
+		VappID:             types.StringValue(r.vapp.GetID()),
+		VappName:           types.StringValue(r.vapp.GetName()),
+		Description:        rm.Description,
+		State:              stateStruct.ToPlan(ctx),
+		Resource:           r.vm.ResourceRead(ctx).ToPlan(ctx, networks),
+		Settings:           settings.ToPlan(ctx),
+		DeployOS:           rm.DeployOS,
+		DeletionProtection: deletionProtection,
 	}, nil
 }
@@ -48,6 +48,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/deletionprotection"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/storageprofile"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/vdc"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/vm"
@@ -152,6 +153,9 @@ func vmSuperSchema(_ context.Context) superschema.Schema {
 					Computed: true,
 				},
 			},
+			deletionprotection.AttributeName: superschema.BoolAttribute{
+				Resource: deletionprotection.ResourceAttribute(),
+			},
 			"deploy_os": superschema.SingleNestedAttribute{
 				Resource: &schemaR.SingleNestedAttribute{
 					MarkdownDescription: "Settings for deploying the operating system on the VM.",
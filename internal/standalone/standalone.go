@@ -0,0 +1,361 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+// Package standalone runs the resources of the provider outside of
+// Terraform, for the command line tools of the repository.
+//
+// The provider is configured like with an empty provider block, from the
+// environment variables and the profiles. The resources are then listed and
+// read with the same code as during a Terraform run.
+package standalone
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	schemaR "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/identity"
+)
+
+// Provider is a configured provider.
+type Provider struct {
+	orgName   string
+	resources map[string]resource.Resource
+	lists     map[string]list.ListResource
+}
+
+// Resource is a resource read from the API.
+type Resource struct {
+	// TypeName is the type of the resource, e.g. cloudavenue_vm.
+	TypeName string
+	// DisplayName is the name of the resource displayed to the user.
+	DisplayName string
+	// State is the state of the resource.
+	State tfsdk.State
+	// ImportID is the ID importing the resource, empty if the resource has
+	// no identity.
+	ImportID string
+}
+
+// StringAttribute returns the value of a root string attribute of the
+// resource. ok is false if the attribute is not a string or is null.
+func (r Resource) StringAttribute(name string) (value string, ok bool) {
+	values := map[string]tftypes.Value{}
+	if err := r.State.Raw.As(&values); err != nil {
+		return "", false
+	}
+
+	v, exists := values[name]
+	if !exists || !v.Type().Is(tftypes.String) || v.IsNull() || !v.IsKnown() {
+		return "", false
+	}
+
+	if err := v.As(&value); err != nil {
+		return "", false
+	}
+	return value, true
+}
+
+// New configures the provider and its resources.
+func New(ctx context.Context, p provider.Provider) (*Provider, error) {
+	metadataResp := &provider.MetadataResponse{}
+	p.Metadata(ctx, provider.MetadataRequest{}, metadataResp)
+
+	schemaResp := &provider.SchemaResponse{}
+	p.Schema(ctx, provider.SchemaRequest{}, schemaResp)
+	if schemaResp.Diagnostics.HasError() {
+		return nil, fmt.Errorf("provider schema: %w", diagsError(schemaResp.Diagnostics))
+	}
+
+	// An empty provider block: every attribute is null.
+	configureResp := &provider.ConfigureResponse{}
+	p.Configure(ctx, provider.ConfigureRequest{
+		Config: tfsdk.Config{
+			Schema: schemaResp.Schema,
+			Raw:    nullAttributes(schemaResp.Schema.Type().TerraformType(ctx)),
+		},
+	}, configureResp)
+	if configureResp.Diagnostics.HasError() {
+		return nil, fmt.Errorf("provider configuration: %w", diagsError(configureResp.Diagnostics))
+	}
+
+	sp := &Provider{
+		resources: map[string]resource.Resource{},
+		lists:     map[string]list.ListResource{},
+	}
+	if c, ok := configureResp.ResourceData.(interface{ GetOrgName() string }); ok {
+		sp.orgName = c.GetOrgName()
+	}
+
+	for _, newResource := range p.Resources(ctx) {
+		r := newResource()
+
+		resp := &resource.MetadataResponse{}
+		r.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: metadataResp.TypeName}, resp)
+
+		if rc, ok := r.(resource.ResourceWithConfigure); ok {
+			configResp := &resource.ConfigureResponse{}
+			rc.Configure(ctx, resource.ConfigureRequest{ProviderData: configureResp.ResourceData}, configResp)
+			if configResp.Diagnostics.HasError() {
+				return nil, fmt.Errorf("%s configuration: %w", resp.TypeName, diagsError(configResp.Diagnostics))
+			}
+		}
+		sp.resources[resp.TypeName] = r
+	}
+
+	if pl, ok := p.(provider.ProviderWithListResources); ok {
+		for _, newList := range pl.ListResources(ctx) {
+			l := newList()
+
+			resp := &resource.MetadataResponse{}
+			l.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: metadataResp.TypeName}, resp)
+
+			if lc, ok := l.(list.ListResourceWithConfigure); ok {
+				configResp := &resource.ConfigureResponse{}
+				lc.Configure(ctx, resource.ConfigureRequest{ProviderData: configureResp.ListResourceData}, configResp)
+				if configResp.Diagnostics.HasError() {
+					return nil, fmt.Errorf("%s list configuration: %w", resp.TypeName, diagsError(configResp.Diagnostics))
+				}
+			}
+			sp.lists[resp.TypeName] = l
+		}
+	}
+
+	return sp, nil
+}
+
+// OrgName returns the name of the organization of the provider.
+func (p *Provider) OrgName() string {
+	return p.orgName
+}
+
+// ListTypes returns the sorted types of the resources that can be listed.
+func (p *Provider) ListTypes() []string {
+	types := make([]string, 0, len(p.lists))
+	for typeName := range p.lists {
+		types = append(types, typeName)
+	}
+	sort.Strings(types)
+	return types
+}
+
+// Schema returns the schema of a resource.
+func (p *Provider) Schema(ctx context.Context, typeName string) (schemaR.Schema, error) {
+	r, ok := p.resources[typeName]
+	if !ok {
+		return schemaR.Schema{}, fmt.Errorf("unknown resource type %s", typeName)
+	}
+
+	resp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, resp)
+	if resp.Diagnostics.HasError() {
+		return schemaR.Schema{}, fmt.Errorf("%s schema: %w", typeName, diagsError(resp.Diagnostics))
+	}
+	return resp.Schema, nil
+}
+
+// identitySchema returns the identity schema of a resource.
+func (p *Provider) identitySchema(ctx context.Context, typeName string) identityschema.Schema {
+	ri, ok := p.resources[typeName].(resource.ResourceWithIdentity)
+	if !ok {
+		return identityschema.Schema{Attributes: map[string]identityschema.Attribute{}}
+	}
+
+	resp := &resource.IdentitySchemaResponse{}
+	ri.IdentitySchema(ctx, resource.IdentitySchemaRequest{}, resp)
+	return resp.IdentitySchema
+}
+
+// List lists and reads the resources of a type. The config holds the string
+// attributes of the list resource configuration.
+//
+// The resources that cannot be read are reported in the returned error,
+// along with the resources that could be read.
+func (p *Provider) List(ctx context.Context, typeName string, config map[string]string) ([]Resource, error) {
+	l, ok := p.lists[typeName]
+	if !ok {
+		return nil, fmt.Errorf("no list resource for the type %s", typeName)
+	}
+
+	configSchemaResp := &list.ListResourceSchemaResponse{}
+	l.ListResourceConfigSchema(ctx, list.ListResourceSchemaRequest{}, configSchemaResp)
+	if configSchemaResp.Diagnostics.HasError() {
+		return nil, fmt.Errorf("%s list schema: %w", typeName, diagsError(configSchemaResp.Diagnostics))
+	}
+
+	configType := configSchemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	configValues := make(map[string]tftypes.Value, len(configType.AttributeTypes))
+	for name, t := range configType.AttributeTypes {
+		configValues[name] = tftypes.NewValue(t, nil)
+	}
+	for name, value := range config {
+		if _, ok := configType.AttributeTypes[name]; !ok {
+			return nil, fmt.Errorf("%s list resource has no attribute %s", typeName, name)
+		}
+		configValues[name] = tftypes.NewValue(tftypes.String, value)
+	}
+
+	resourceSchema, err := p.Schema(ctx, typeName)
+	if err != nil {
+		return nil, err
+	}
+
+	stream := &list.ListResultsStream{}
+	l.List(ctx, list.ListRequest{
+		Config: tfsdk.Config{
+			Schema: configSchemaResp.Schema,
+			Raw:    tftypes.NewValue(configType, configValues),
+		},
+		IncludeResource:        true,
+		ResourceSchema:         resourceSchema,
+		ResourceIdentitySchema: p.identitySchema(ctx, typeName),
+	}, stream)
+	if stream.Results == nil {
+		return nil, nil
+	}
+
+	var (
+		resources []Resource
+		errs      []error
+	)
+	for result := range stream.Results {
+		if result.Diagnostics.HasError() {
+			name := typeName
+			if result.DisplayName != "" {
+				name += " " + result.DisplayName
+			}
+			errs = append(errs, fmt.Errorf("%s: %w", name, diagsError(result.Diagnostics)))
+			continue
+		}
+
+		r, err := p.newResource(ctx, typeName, result.DisplayName, tfsdk.State{Schema: resourceSchema, Raw: result.Resource.Raw}, result.Identity)
+		if err != nil {
+			errs = append(errs, err)
+		}
+		resources = append(resources, r)
+	}
+
+	return resources, errors.Join(errs...)
+}
+
+// StateFromJSON returns the state of a resource from its attributes in the
+// JSON format of the Terraform state files. The attributes unknown to the
+// schema are ignored.
+func (p *Provider) StateFromJSON(ctx context.Context, typeName string, schemaVersion int64, attributes json.RawMessage) (tfsdk.State, error) {
+	s, err := p.Schema(ctx, typeName)
+	if err != nil {
+		return tfsdk.State{}, err
+	}
+
+	if schemaVersion != s.Version {
+		return tfsdk.State{}, fmt.Errorf("%s state has the schema version %d, the provider has the version %d: refresh the state with this provider version first", typeName, schemaVersion, s.Version)
+	}
+
+	raw, err := tftypes.ValueFromJSONWithOpts(attributes, s.Type().TerraformType(ctx), tftypes.ValueFromJSONOpts{
+		IgnoreUndefinedAttributes: true,
+	})
+	if err != nil {
+		return tfsdk.State{}, fmt.Errorf("%s state: %w", typeName, err)
+	}
+
+	return tfsdk.State{Schema: s, Raw: raw}, nil
+}
+
+// Read reads a resource with the Read method of the resource, from its
+// state. found is false if the resource has been deleted.
+func (p *Provider) Read(ctx context.Context, typeName string, state tfsdk.State) (r Resource, found bool, err error) {
+	rs, ok := p.resources[typeName]
+	if !ok {
+		return Resource{}, false, fmt.Errorf("unknown resource type %s", typeName)
+	}
+
+	// The identity is computed by the Read method from the state.
+	identitySchema := p.identitySchema(ctx, typeName)
+	nullIdentity := tftypes.NewValue(identitySchema.Type().TerraformType(ctx), nil)
+
+	resp := &resource.ReadResponse{
+		State:    tfsdk.State{Schema: state.Schema, Raw: state.Raw.Copy()},
+		Identity: &tfsdk.ResourceIdentity{Schema: identitySchema, Raw: nullIdentity},
+	}
+	rs.Read(ctx, resource.ReadRequest{
+		State:    state,
+		Identity: &tfsdk.ResourceIdentity{Schema: identitySchema, Raw: nullIdentity},
+	}, resp)
+	if resp.Diagnostics.HasError() {
+		return Resource{}, false, fmt.Errorf("%s: %w", typeName, diagsError(resp.Diagnostics))
+	}
+
+	if resp.State.Raw.IsNull() {
+		return Resource{TypeName: typeName, State: resp.State}, false, nil
+	}
+
+	r, err = p.newResource(ctx, typeName, "", resp.State, resp.Identity)
+	return r, true, err
+}
+
+// newResource returns the resource of a state and its identity.
+func (p *Provider) newResource(ctx context.Context, typeName, displayName string, state tfsdk.State, ri *tfsdk.ResourceIdentity) (Resource, error) {
+	r := Resource{
+		TypeName:    typeName,
+		DisplayName: displayName,
+		State:       state,
+	}
+
+	spec, ok := p.resources[typeName].(identity.ResourceWithIdentitySpec)
+	if !ok || ri == nil {
+		return r, nil
+	}
+
+	importID, diags := spec.IdentitySpec().ImportID(ctx, p.orgName, ri)
+	if diags.HasError() {
+		return r, fmt.Errorf("%s %s import ID: %w", typeName, displayName, diagsError(diags))
+	}
+	r.ImportID = importID
+
+	return r, nil
+}
+
+// nullAttributes returns an object whose attributes are all null.
+func nullAttributes(t tftypes.Type) tftypes.Value {
+	objectType, ok := t.(tftypes.Object)
+	if !ok {
+		return tftypes.NewValue(t, nil)
+	}
+
+	values := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, attributeType := range objectType.AttributeTypes {
+		values[name] = tftypes.NewValue(attributeType, nil)
+	}
+	return tftypes.NewValue(objectType, values)
+}
+
+// diagsError returns the error diagnostics as an error.
+func diagsError(diags diag.Diagnostics) error {
+	messages := make([]string, 0, diags.ErrorsCount())
+	for _, d := range diags.Errors() {
+		message := d.Summary()
+		if d.Detail() != "" {
+			message += ": " + d.Detail()
+		}
+		messages = append(messages, message)
+	}
+	return errors.New(strings.Join(messages, "; "))
+}
@@ -0,0 +1,246 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package standalone
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	providerschema "github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	schemaR "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/identity"
+)
+
+const testOrg = "cav01ev01ocb0001234"
+
+// testClient is the provider data.
+type testClient struct{}
+
+func (testClient) GetOrgName() string { return testOrg }
+
+type testProvider struct{}
+
+func (p *testProvider) Metadata(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
+	resp.TypeName = "cloudavenue"
+}
+
+func (p *testProvider) Schema(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {
+	resp.Schema = providerschema.Schema{
+		Attributes: map[string]providerschema.Attribute{
+			"org": providerschema.StringAttribute{Optional: true},
+		},
+	}
+}
+
+func (p *testProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+	var org types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("org"), &org)...)
+	if !org.IsNull() {
+		resp.Diagnostics.AddError("Unexpected configuration", "The org attribute is set.")
+		return
+	}
+
+	resp.ResourceData = testClient{}
+	resp.ListResourceData = testClient{}
+}
+
+func (p *testProvider) Resources(context.Context) []func() resource.Resource {
+	return identity.Wrap([]func() resource.Resource{newTestResource})
+}
+
+func (p *testProvider) DataSources(context.Context) []func() datasource.DataSource {
+	return nil
+}
+
+func (p *testProvider) ListResources(context.Context) []func() list.ListResource {
+	return []func() list.ListResource{func() list.ListResource { return &testListResource{} }}
+}
+
+type testResource struct{}
+
+func newTestResource() resource.Resource { return &testResource{} }
+
+func (r *testResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_test"
+}
+
+func (r *testResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schemaR.Schema{
+		Attributes: map[string]schemaR.Attribute{
+			"id":          schemaR.StringAttribute{Computed: true},
+			"name":        schemaR.StringAttribute{Required: true},
+			"description": schemaR.StringAttribute{Computed: true},
+		},
+	}
+}
+
+func (r *testResource) Create(context.Context, resource.CreateRequest, *resource.CreateResponse) {}
+
+// Read removes the resource named "deleted" and sets the description.
+func (r *testResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var name types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("name"), &name)...)
+
+	if name.ValueString() == "deleted" {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("description"), "read")...)
+}
+
+func (r *testResource) Update(context.Context, resource.UpdateRequest, *resource.UpdateResponse) {}
+
+func (r *testResource) Delete(context.Context, resource.DeleteRequest, *resource.DeleteResponse) {}
+
+func (r *testResource) IdentitySpec() identity.Spec {
+	return identity.Spec{
+		Attributes: []identity.Attribute{
+			{Name: "name"},
+		},
+	}
+}
+
+// testListResource lists the resources a and b of the configured prefix,
+// and fails to read the resource "broken".
+type testListResource struct{}
+
+func (l *testListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_test"
+}
+
+func (l *testListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{
+		Attributes: map[string]listschema.Attribute{
+			"prefix": listschema.StringAttribute{Optional: true},
+		},
+	}
+}
+
+func (l *testListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var prefix types.String
+	if diags := req.Config.GetAttribute(ctx, path.Root("prefix"), &prefix); diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	spec := newTestResource().(identity.ResourceWithIdentitySpec).IdentitySpec()
+	stream.Results = func(push func(list.ListResult) bool) {
+		for _, name := range []string{"a", "broken", "b"} {
+			result := req.NewListResult(ctx)
+			result.DisplayName = prefix.ValueString() + name
+			if name == "broken" {
+				result.Diagnostics.AddError("Error reading the resource", "broken")
+			} else {
+				result.Diagnostics.Append(result.Resource.SetAttribute(ctx, path.Root("id"), "urn:vcloud:test:"+name)...)
+				result.Diagnostics.Append(result.Resource.SetAttribute(ctx, path.Root("name"), result.DisplayName)...)
+				result.Diagnostics.Append(spec.SetFromState(ctx, testOrg, tfsdk.State(*result.Resource), result.Identity)...)
+			}
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
+
+func TestProviderList(t *testing.T) {
+	ctx := t.Context()
+
+	p, err := New(ctx, &testProvider{})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	if p.OrgName() != testOrg {
+		t.Errorf("OrgName() = %q, want %q", p.OrgName(), testOrg)
+	}
+	if types := p.ListTypes(); len(types) != 1 || types[0] != "cloudavenue_test" {
+		t.Errorf("ListTypes() = %v", types)
+	}
+
+	resources, err := p.List(ctx, "cloudavenue_test", map[string]string{"prefix": "web-"})
+	if err == nil || !strings.Contains(err.Error(), "cloudavenue_test web-broken: Error reading the resource: broken") {
+		t.Errorf("List() error = %v, want the error of the broken resource", err)
+	}
+	if len(resources) != 2 {
+		t.Fatalf("List() = %d resources, want 2", len(resources))
+	}
+
+	for _, r := range resources {
+		var name types.String
+		if diags := r.State.GetAttribute(ctx, path.Root("name"), &name); diags.HasError() {
+			t.Fatalf("state diagnostics: %+v", diags)
+		}
+		if name.ValueString() != r.DisplayName {
+			t.Errorf("name = %q, want %q", name.ValueString(), r.DisplayName)
+		}
+		if r.ImportID != r.DisplayName {
+			t.Errorf("ImportID = %q, want %q", r.ImportID, r.DisplayName)
+		}
+	}
+
+	if _, err := p.List(ctx, "cloudavenue_test", map[string]string{"unknown": "x"}); err == nil {
+		t.Error("List() with an unknown attribute: error = nil")
+	}
+	if _, err := p.List(ctx, "cloudavenue_unknown", nil); err == nil {
+		t.Error("List() of an unknown type: error = nil")
+	}
+}
+
+func TestProviderRead(t *testing.T) {
+	ctx := t.Context()
+
+	p, err := New(ctx, &testProvider{})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	if _, err := p.StateFromJSON(ctx, "cloudavenue_test", 1, []byte(`{"name":"web"}`)); err == nil {
+		t.Error("StateFromJSON() with another schema version: error = nil")
+	}
+
+	// The attributes unknown to the schema are ignored.
+	state, err := p.StateFromJSON(ctx, "cloudavenue_test", 0, []byte(`{"id":"urn:vcloud:test:web","name":"web","description":"old","removed":true}`))
+	if err != nil {
+		t.Fatalf("StateFromJSON() error = %v", err)
+	}
+
+	r, found, err := p.Read(ctx, "cloudavenue_test", state)
+	if err != nil || !found {
+		t.Fatalf("Read() = %t, %v, want found", found, err)
+	}
+	var description types.String
+	if diags := r.State.GetAttribute(ctx, path.Root("description"), &description); diags.HasError() {
+		t.Fatalf("state diagnostics: %+v", diags)
+	}
+	if description.ValueString() != "read" {
+		t.Errorf("description = %q, want %q", description.ValueString(), "read")
+	}
+	if r.ImportID != "web" {
+		t.Errorf("ImportID = %q, want %q", r.ImportID, "web")
+	}
+
+	state, err = p.StateFromJSON(ctx, "cloudavenue_test", 0, []byte(`{"id":"urn:vcloud:test:deleted","name":"deleted"}`))
+	if err != nil {
+		t.Fatalf("StateFromJSON() error = %v", err)
+	}
+	if _, found, err := p.Read(ctx, "cloudavenue_test", state); err != nil || found {
+		t.Errorf("Read() of a deleted resource = %t, %v, want not found", found, err)
+	}
+}
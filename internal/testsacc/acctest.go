@@ -22,6 +22,8 @@ package testsacc
 import (
 	"log"
 	"os"
+	"path/filepath"
+	"sync"
 	"testing"
 
 	"github.com/google/uuid"
@@ -30,6 +32,8 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/helpers/fakeapi"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/helpers/testsacc"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/metrics"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider"
 )
@@ -42,10 +46,53 @@ var TestAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServe
 	"cloudavenue": providerserver.NewProtocol6WithError(provider.New("test")()),
 }
 
+var (
+	fakeAPIOnce sync.Once
+	fakeAPIErr  error
+)
+
+// startFakeAPI starts the fake Cloud Avenue API server once for all the tests
+// and points the provider to it. The server is never closed, it lives as long
+// as the test binary.
+func startFakeAPI() error {
+	fakeAPIOnce.Do(func() {
+		var server *fakeapi.Server
+		server, fakeAPIErr = fakeapi.New(fakeapi.Config{})
+		if fakeAPIErr != nil {
+			return
+		}
+
+		env := server.Env()
+		for key, value := range env {
+			if fakeAPIErr = os.Setenv(key, value); fakeAPIErr != nil {
+				return
+			}
+		}
+		// The provider trusts the server with CLOUDAVENUE_CA_FILE, the
+		// clients of the SDK authenticated before the provider configures
+		// them trust it with the system roots.
+		if fakeAPIErr = testsacc.TrustCertDir(filepath.Dir(env["CLOUDAVENUE_CA_FILE"])); fakeAPIErr != nil {
+			return
+		}
+		log.Default().Printf("TestACC: fake API server started on %s", server.URL)
+	})
+
+	return fakeAPIErr
+}
+
 // You can add code here to run prior to any test case execution, for example assertions
 // about the appropriate environment variables being set are common to see in a pre-check
 // function.
 func TestAccPreCheck(t *testing.T) {
+	if os.Getenv("TF_ACC_FAKE_API") == "true" {
+		if err := startFakeAPI(); err != nil {
+			t.Fatalf("Unable to start the fake API server: %s", err)
+		}
+	}
+
+	// In replay mode, the cassette sets the credentials of the recording.
+	testsacc.StartCassette(t)
+
 	if v := os.Getenv("CLOUDAVENUE_USERNAME"); v == "" {
 		t.Fatal("CLOUDAVENUE_USERNAME must be set for acceptance tests")
 	}
@@ -0,0 +1,131 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package testsacc
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/orange-cloudavenue/cloudavenue-sdk-go/pkg/urn"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/helpers/fakeapi"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/helpers/testsacc"
+)
+
+// testAccOfflinePreCheck skips the offline tests when they don't run
+// against the fake API server. They are run by the CI without credentials.
+func testAccOfflinePreCheck(t *testing.T) {
+	t.Helper()
+
+	if os.Getenv("TF_ACC_FAKE_API") != "true" {
+		t.Skip("TF_ACC_FAKE_API must be set to true for the offline tests")
+	}
+	TestAccPreCheck(t)
+}
+
+// testAccOfflineEdgeGatewayNATRuleConfig returns the configuration of a VDC,
+// an edge gateway and a NAT rule on the fake API server.
+func testAccOfflineEdgeGatewayNATRuleConfig(snatDestination string, priority int) string {
+	return fmt.Sprintf(`
+data "cloudavenue_tier0_vrf" "example" {
+  name = "prvrf01e%[1]sstd01"
+}
+
+resource "cloudavenue_vdc" "example" {
+  name                  = "offline-vdc"
+  description           = "Offline acceptance test"
+  cpu_allocated         = 22000
+  memory_allocated      = 30
+  cpu_speed_in_mhz      = 2200
+  billing_model         = "PAYG"
+  disponibility_class   = "ONE-ROOM"
+  service_class         = "STD"
+  storage_billing_model = "PAYG"
+
+  storage_profiles = [{
+    class   = "gold"
+    default = true
+    limit   = 500
+  }]
+}
+
+resource "cloudavenue_edgegateway" "example" {
+  owner_name     = cloudavenue_vdc.example.name
+  tier0_vrf_name = data.cloudavenue_tier0_vrf.example.name
+  bandwidth      = 25
+}
+
+resource "cloudavenue_edgegateway_nat_rule" "example" {
+  edge_gateway_id = cloudavenue_edgegateway.example.id
+
+  name        = "offline-snat"
+  rule_type   = "SNAT"
+  description = "Offline acceptance test"
+
+  external_address         = "89.32.25.10"
+  internal_address         = "11.11.11.0/24"
+  snat_destination_address = %[2]q
+
+  priority = %[3]d
+}
+`, fakeapi.DefaultOrg, snatDestination, priority)
+}
+
+// TestAccOfflineEdgeGatewayNATRule creates, updates, imports and deletes an
+// edge gateway and a NAT rule on the fake API server.
+func TestAccOfflineEdgeGatewayNATRule(t *testing.T) {
+	const (
+		edgeGateway = "cloudavenue_edgegateway.example"
+		natRule     = "cloudavenue_edgegateway_nat_rule.example"
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccOfflinePreCheck(t) },
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// * Create
+			{
+				Config: testAccOfflineEdgeGatewayNATRuleConfig("8.8.8.8", 10),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrWith(edgeGateway, "id", urn.TestIsType(urn.Gateway)),
+					resource.TestCheckResourceAttrSet(edgeGateway, "name"),
+					resource.TestCheckResourceAttr(edgeGateway, "bandwidth", "25"),
+					resource.TestCheckResourceAttrSet(natRule, "id"),
+					resource.TestCheckResourceAttrPair(natRule, "edge_gateway_id", edgeGateway, "id"),
+					resource.TestCheckResourceAttr(natRule, "rule_type", "SNAT"),
+					resource.TestCheckResourceAttr(natRule, "snat_destination_address", "8.8.8.8"),
+					resource.TestCheckResourceAttr(natRule, "priority", "10"),
+				),
+			},
+			// * Update
+			{
+				Config: testAccOfflineEdgeGatewayNATRuleConfig("9.9.9.9", 0),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(natRule, "snat_destination_address", "9.9.9.9"),
+					resource.TestCheckResourceAttr(natRule, "priority", "0"),
+				),
+			},
+			// * Import
+			{
+				ResourceName:      edgeGateway,
+				ImportState:       true,
+				ImportStateIdFunc: testsacc.ImportStateIDBuilder(edgeGateway, []string{"name"}),
+			},
+			{
+				ResourceName:      natRule,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testsacc.ImportStateIDBuilder(natRule, []string{"edge_gateway_id", "id"}),
+			},
+		},
+	})
+}
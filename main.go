@@ -23,11 +23,15 @@ import (
 	"context"
 	"flag"
 	"log"
+	"time"
 
 	"github.com/google/uuid"
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6/tf6server"
+
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/metrics"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider"
 
@@ -38,6 +42,11 @@ import (
 // Example version string that can be overwritten by a release process.
 var version = "dev"
 
+// metricsFlushTimeout is the maximum time to send the pending metrics when
+// the provider exits. Terraform kills the provider 2 seconds after asking it
+// to exit, the interrupted runs send them earlier on StopProvider.
+const metricsFlushTimeout = 1500 * time.Millisecond
+
 // Provider documentation generation.
 //go:generate go run github.com/hashicorp/terraform-plugin-docs/cmd/tfplugindocs generate --provider-name cloudavenue
 //go:generate go run github.com/orange-cloudavenue/terraform-provider-cloudavenue/cmd/vdc-doc
@@ -59,12 +68,25 @@ func main() {
 	x, _ := uuid.NewUUID()
 	metrics.GlobalExecutionID = x.String()
 
-	opts := providerserver.ServeOpts{
-		Address: "registry.terraform.io/orange-cloudavenue/cloudavenue",
-		Debug:   debug,
+	var opts []tf6server.ServeOpt
+	if debug {
+		opts = append(opts, tf6server.WithManagedDebug())
 	}
 
-	err := providerserver.Serve(context.Background(), provider.New(version), opts)
+	err := tf6server.Serve(
+		"registry.terraform.io/orange-cloudavenue/cloudavenue",
+		func() tfprotov6.ProviderServer {
+			return metrics.FlushOnStop(providerserver.NewProtocol6(provider.New(version)())())
+		},
+		opts...,
+	)
+
+	// Serve returns when Terraform shuts the provider down. Send the pending
+	// metrics before exiting, within the grace period given by Terraform.
+	ctx, cancel := context.WithTimeout(context.Background(), metricsFlushTimeout)
+	_ = metrics.Flush(ctx)
+	cancel()
+
 	if err != nil {
 		log.Fatal(err.Error())
 	}